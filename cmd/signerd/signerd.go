@@ -0,0 +1,68 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/tokenized/smart-contract/internal/app/logger"
+	"github.com/tokenized/smart-contract/internal/signer"
+	"github.com/tokenized/smart-contract/pkg/netparams"
+	"github.com/tokenized/smart-contract/pkg/txbuilder"
+
+	"github.com/btcsuite/btcutil"
+)
+
+var (
+	buildVersion = "unknown"
+	buildDate    = "unknown"
+	buildUser    = "unknown"
+)
+
+// Signer Daemon
+//
+// Holds the contract key, and signs for the contract node over a Unix
+// socket.
+func main() {
+	// Logger
+	ctx, log := logger.NewLoggerWithContext()
+
+	params, err := netparams.ByName(os.Getenv("NETWORK"))
+	if err != nil {
+		panic(err)
+	}
+
+	wif, err := btcutil.DecodeWIF(os.Getenv("PRIV_KEY"))
+	if err != nil {
+		panic(err)
+	}
+
+	secret := os.Getenv("SIGNER_SECRET")
+	if len(secret) == 0 {
+		panic(errors.New("SIGNER_SECRET is not set"))
+	}
+
+	path := os.Getenv("SIGNER_SOCKET")
+	if len(path) == 0 {
+		panic(errors.New("SIGNER_SOCKET is not set"))
+	}
+
+	l, err := signer.Listen(path)
+	if err != nil {
+		panic(err)
+	}
+
+	s := signer.NewServer(txbuilder.NewKeySigner(wif.PrivKey), params.Chain, []byte(secret))
+
+	// Log startup sequence
+	log.Infof("Started %v on %s", buildDetails(), path)
+
+	if err := s.Serve(ctx, l); err != nil {
+		panic(err)
+	}
+}
+
+// buildDetails returns a string that describes the details of the build.
+func buildDetails() string {
+	return fmt.Sprintf("%v (%v on %v)", buildVersion, buildUser, buildDate)
+}
@@ -1,54 +1,222 @@
 package main
 
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/tokenized/smart-contract/internal/app/logger"
+	"github.com/tokenized/smart-contract/internal/app/rpcnode"
+	"github.com/tokenized/smart-contract/internal/outbox"
+	"github.com/tokenized/smart-contract/internal/pool"
+	"github.com/tokenized/smart-contract/pkg/netparams"
+	"github.com/tokenized/smart-contract/pkg/protocol"
+	"github.com/tokenized/smart-contract/pkg/storage"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"go.uber.org/zap"
+)
+
+const usage = `Usage:
+  smartcontract decode <OP_RETURN script hex>
+  smartcontract outbox list
+  smartcontract outbox rebroadcast <hash>|all
+  smartcontract pool balance [address]
+  smartcontract pool list <address>
+`
+
 // Smart Contract CLI
 //
 func main() {
-	//TBA
-
-	// ctx, log := logger.NewLoggerWithContext()
-
-	// config, err := contract.NewConfig()
-	// if err != nil {
-	// 	panic(err)
-	// }
-
-	// address, err := btcutil.DecodeAddress(config.ContractAddress, &chaincfg.MainNetParams)
-	// if err != nil {
-	// 	panic(err)
-	// }
-
-	// if config.Fee.Value == 0 {
-	// 	panic("Fee is set to 0 sats")
-	// }
-
-	// logger.Infof("Started with config %s", *config)
-
-	// client, err := contract.NewNode(config.RPC)
-	// if err != nil {
-	// 	panic(err)
-	// }
-
-	// peerRepo := contract.NewRPCNode(client)
-
-	// docStore := storage.NewFilesystemStorage(config.Storage)
-
-	// contractState := contract.NewStateService(docStore)
-
-	// txService := contract.NewParser(peerRepo)
-
-	// cs := contract.NewContractService(contractState, config.Fee)
-
-	// builder := contract.NewContractBuilder(cs, peerRepo, txService)
+	if len(os.Args) < 3 {
+		fmt.Print(usage)
+		os.Exit(1)
+	}
+
+	ctx, log := logger.NewLoggerWithContext()
+
+	// decoding a message needs no network or storage
+	if os.Args[1] == "decode" {
+		runDecode(log, os.Args[2])
+		return
+	}
+
+	params, err := netparams.ByName(os.Getenv("NETWORK"))
+	if err != nil {
+		log.Fatalf("%v : %v", err, os.Getenv("NETWORK"))
+	}
+
+	// Contract Storage
+	storeConfig := storage.NewConfig(os.Getenv("CONTRACT_STORAGE_REGION"),
+		os.Getenv("CONTRACT_STORAGE_ACCESS_KEY"),
+		os.Getenv("CONTRACT_STORAGE_SECRET"),
+		os.Getenv("CONTRACT_STORAGE_BUCKET"),
+		os.Getenv("CONTRACT_STORAGE_ROOT"))
+
+	var store storage.Storage
+	if strings.ToLower(storeConfig.Bucket) == "standalone" {
+		store = storage.NewFilesystemStorage(storeConfig)
+	} else {
+		store = storage.NewS3Storage(storeConfig)
+	}
+
+	switch os.Args[1] {
+	case "outbox":
+		runOutbox(ctx, log, store, params)
+
+	case "pool":
+		runPool(ctx, log, store, params)
+
+	default:
+		fmt.Print(usage)
+		os.Exit(1)
+	}
+}
 
-	// contract, err := builder.Rebuild(ctx, address)
-	// if err != nil {
-	// 	panic(err)
-	// }
+// runOutbox runs the outbox commands.
+func runOutbox(ctx context.Context,
+	log *zap.SugaredLogger,
+	store storage.Storage,
+	params *netparams.Params) {
+
+	// Responses are sent with RPC, as there is no peer connection.
+	rpcConfig := rpcnode.NewConfig(os.Getenv("RPC_HOST"),
+		os.Getenv("RPC_USERNAME"),
+		os.Getenv("RPC_PASSWORD"),
+		params)
+
+	rpcNode, err := rpcnode.NewNode(rpcConfig)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	box := outbox.NewOutboxService(store, rpcNode)
+
+	switch os.Args[2] {
+	case "list":
+		entries, err := box.List(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for _, e := range entries {
+			if e.IsDone() {
+				continue
+			}
+
+			fmt.Printf("%s %-9s attempts=%d next=%s request=%s %s\n",
+				e.Hash,
+				e.Status,
+				e.Attempts,
+				time.Unix(0, e.NextAttempt).Format(time.RFC3339),
+				e.Request,
+				e.Error)
+		}
+
+	case "rebroadcast":
+		if len(os.Args) < 4 {
+			fmt.Print(usage)
+			os.Exit(1)
+		}
+
+		if os.Args[3] == "all" {
+			if err := box.SendAll(ctx); err != nil {
+				log.Fatal(err)
+			}
+			return
+		}
+
+		hash, err := chainhash.NewHashFromStr(os.Args[3])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if err := box.Send(ctx, *hash); err != nil {
+			log.Fatal(err)
+		}
+
+		log.Infof("Sent %s", hash)
+
+	default:
+		fmt.Print(usage)
+		os.Exit(1)
+	}
+}
 
-	// b, err := json.Marshal(*contract)
-	// if err != nil {
-	// 	panic(err)
-	// }
+// runPool runs the pool commands.
+func runPool(ctx context.Context,
+	log *zap.SugaredLogger,
+	store storage.Storage,
+	params *netparams.Params) {
+
+	utxoPool := pool.NewPoolService(store, params.Chain)
+
+	switch os.Args[2] {
+	case "balance":
+		pools, err := utxoPool.List(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for _, p := range pools {
+			if len(os.Args) > 3 && p.Address != os.Args[3] {
+				continue
+			}
+
+			b := p.Balance()
+
+			fmt.Printf("%s available=%d reserved=%d utxos=%d small=%d\n",
+				b.Address,
+				b.Available,
+				b.Reserved,
+				b.Count,
+				b.Small)
+		}
+
+	case "list":
+		if len(os.Args) < 4 {
+			fmt.Print(usage)
+			os.Exit(1)
+		}
+
+		p, err := utxoPool.Read(ctx, os.Args[3])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for _, u := range p.UTXOs {
+			fmt.Printf("%s:%d %d %s\n", u.Hash, u.Index, u.Value, u.ReservedBy)
+		}
+
+	default:
+		fmt.Print(usage)
+		os.Exit(1)
+	}
+}
 
-	// fmt.Printf("%s\n", b)
+// runDecode prints the message in the OP_RETURN script as JSON.
+func runDecode(log *zap.SugaredLogger, script string) {
+	b, err := hex.DecodeString(script)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	m, err := protocol.New(b)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	out, err := json.MarshalIndent(struct {
+		Type    string                   `json:"type"`
+		Message protocol.OpReturnMessage `json:"message"`
+	}{m.Type(), m}, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(string(out))
 }
@@ -5,43 +5,38 @@ import (
 	"errors"
 
 	"github.com/tokenized/smart-contract/internal/app/config"
-	"github.com/tokenized/smart-contract/internal/app/inspector"
 	"github.com/tokenized/smart-contract/internal/app/logger"
-	"github.com/tokenized/smart-contract/internal/app/network"
+	"github.com/tokenized/smart-contract/internal/app/wallet"
 	"github.com/tokenized/smart-contract/internal/broadcaster"
-	"github.com/tokenized/smart-contract/internal/request"
-	"github.com/tokenized/smart-contract/internal/response"
-	"github.com/tokenized/smart-contract/internal/validator"
+	"github.com/tokenized/smart-contract/internal/outbox"
+	"github.com/tokenized/smart-contract/internal/pool"
+	"github.com/tokenized/smart-contract/pkg/txscript"
 	"github.com/tokenized/smart-contract/pkg/wire"
+
+	"github.com/btcsuite/btcutil"
 )
 
 // BlockHandler exists to handle the Block command.
 type BlockHandler struct {
 	Config      config.Config
-	Network     network.NetworkInterface
-	Inspector   inspector.InspectorService
+	Wallet      wallet.Wallet
+	Outbox      outbox.OutboxService
+	Pool        pool.PoolService
 	Broadcaster broadcaster.BroadcastService
-	Validator   validator.ValidatorService
-	Request     request.RequestService
-	Response    response.ResponseService
 }
 
 // NewBlockHandler returns a new BlockHandler with the given Config.
 func NewBlockHandler(config config.Config,
-	network network.NetworkInterface,
-	inspector inspector.InspectorService,
-	broadcaster broadcaster.BroadcastService,
-	validator validator.ValidatorService,
-	request request.RequestService,
-	response response.ResponseService) TXHandler {
-	return TXHandler{
+	wallet wallet.Wallet,
+	outbox outbox.OutboxService,
+	pool pool.PoolService,
+	broadcaster broadcaster.BroadcastService) BlockHandler {
+	return BlockHandler{
 		Config:      config,
-		Network:     network,
-		Inspector:   inspector,
+		Wallet:      wallet,
+		Outbox:      outbox,
+		Pool:        pool,
 		Broadcaster: broadcaster,
-		Validator:   validator,
-		Request:     request,
-		Response:    response,
 	}
 }
 
@@ -59,24 +54,125 @@ func (h BlockHandler) Handle(ctx context.Context, m wire.Message) error {
 }
 
 // handle processes the MsgBlock
+//
+// Any responses in the block are marked as confirmed in the outbox. Outputs
+// of the responses that pay to a contract join the pool of the contract,
+// and UTXOs spent in the block leave it.
 func (h BlockHandler) handle(ctx context.Context, b *wire.MsgBlock) error {
 	log := logger.NewLoggerFromContext(ctx).Sugar()
 	log.Infof("Received block : %s", b.BlockHash())
 
-	for _, tx := range b.Transactions {
+	confirmed, err := h.Outbox.ConfirmBlock(ctx, b.Transactions)
+	if err != nil {
+		return err
+	}
+
+	// outputs are added before spends are removed, as a tx in the block
+	// may spend an output of another.
+	for _, tx := range confirmed {
+		if err := h.addToPool(ctx, tx); err != nil {
+			return err
+		}
+	}
+
+	if err := h.Pool.Spend(ctx, b.Transactions); err != nil {
+		return err
+	}
+
+	return h.checkPools(ctx)
+}
+
+// addToPool adds the outputs of a tx that pay to a contract address held
+// by the Wallet.
+func (h BlockHandler) addToPool(ctx context.Context, tx *wire.MsgTx) error {
+	added := map[string]bool{}
+
+	for _, out := range tx.TxOut {
+		_, addresses, _, err := txscript.ExtractPkScriptAddrs(out.PkScript,
+			h.Config.Net.Chain)
+		if err != nil || len(addresses) != 1 {
+			continue
+		}
+
+		address := addresses[0]
+		a := address.EncodeAddress()
+
+		if added[a] {
+			continue
+		}
+
+		if _, err := h.Wallet.Get(a); err != nil {
+			continue
+		}
+
+		if err := h.Pool.Add(ctx, address, tx); err != nil {
+			return err
+		}
+
+		added[a] = true
+	}
+
+	return nil
+}
+
+// checkPools writes the balance of each pool to the log, and consolidates
+// any pool holding too many small UTXOs.
+func (h BlockHandler) checkPools(ctx context.Context) error {
+	pools, err := h.Pool.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range pools {
+		b := p.Balance()
 
-		// Inspector: Does this transaction concern the protocol?
-		itx, err := h.Inspector.MakeTransaction(tx)
-		if err != nil || itx == nil {
-			// log.Error(err)
-			return nil
+		labels := map[string]string{
+			"address": b.Address,
 		}
 
-		// if _, err := h.Service.ProcessMessage(ctx, itx); err != nil {
-		// 	logger.Errorw("Failed to process message", zap.Error(err))
-		// 	return err
-		// }
+		logger.Gauge(ctx, "pool.available", b.Available, labels)
+		logger.Gauge(ctx, "pool.reserved", b.Reserved, labels)
+		logger.Gauge(ctx, "pool.utxos", uint64(b.Count), labels)
+
+		if b.Small < pool.ConsolidateCount {
+			continue
+		}
+
+		h.consolidate(ctx, b.Address)
 	}
 
 	return nil
 }
+
+// consolidate sends a tx that spends the small UTXOs in the pool of an
+// address back to it.
+func (h BlockHandler) consolidate(ctx context.Context, a string) {
+	log := logger.NewLoggerFromContext(ctx).Sugar()
+
+	address, err := btcutil.DecodeAddress(a, h.Config.Net.Chain)
+	if err != nil {
+		log.Errorf("Failed to consolidate pool %s : %v", a, err)
+		return
+	}
+
+	key, err := h.Wallet.Get(a)
+	if err != nil {
+		log.Errorf("Failed to consolidate pool %s : %v", a, err)
+		return
+	}
+
+	tx, err := h.Pool.Consolidate(ctx, address, key, h.Wallet.FeePolicy)
+	if err != nil {
+		log.Errorf("Failed to consolidate pool %s : %v", a, err)
+		return
+	}
+
+	if tx == nil {
+		return
+	}
+
+	// If sending fails the outbox sends it later
+	if _, err := h.Broadcaster.Announce(ctx, tx); err != nil {
+		log.Errorf("Failed to send consolidation %s : %v", tx.TxHash(), err)
+	}
+}
@@ -0,0 +1,56 @@
+package node
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tokenized/smart-contract/internal/app/logger"
+	"github.com/tokenized/smart-contract/pkg/wire"
+)
+
+// DoubleSpendHandler exists to handle txs that are seen to be double
+// spent.
+type DoubleSpendHandler struct {
+	TXHandler TXHandler
+}
+
+// NewDoubleSpendHandler returns a new DoubleSpendHandler that revokes the
+// responses sent by the given TXHandler.
+func NewDoubleSpendHandler(txHandler TXHandler) DoubleSpendHandler {
+	return DoubleSpendHandler{
+		TXHandler: txHandler,
+	}
+}
+
+// Handle implments the Handler interface.
+//
+// This function handles type conversion and delegates the the concrete
+// handler.
+func (h DoubleSpendHandler) Handle(ctx context.Context, m wire.Message) error {
+	msg, ok := m.(*wire.MsgTx)
+	if !ok {
+		return errors.New("Could not assert as *wire.MsgTx")
+	}
+
+	return h.handle(ctx, msg)
+}
+
+// handle processes a double spent MsgTx.
+//
+// If the tx is a request that has been responded to, the response is
+// revoked. Other txs are ignored.
+func (h DoubleSpendHandler) handle(ctx context.Context, tx *wire.MsgTx) error {
+	ctx = logger.ContextWithTXHash(ctx, tx.TxHash().String())
+	log := logger.NewLoggerFromContext(ctx).Sugar()
+	log.Infof("Received double spent transaction : %s", tx.TxHash())
+
+	// hold the contract lock, so a response is not being processed while
+	// the contract state is restored.
+	mtx := h.TXHandler.mapLock.get(h.TXHandler.Wallet.PublicAddress)
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	h.TXHandler.revoke(ctx, tx.TxHash())
+
+	return nil
+}
@@ -5,23 +5,22 @@ import (
 
 	"github.com/tokenized/smart-contract/internal/app/config"
 	"github.com/tokenized/smart-contract/internal/app/inspector"
+	"github.com/tokenized/smart-contract/internal/app/logger"
 	"github.com/tokenized/smart-contract/internal/app/network"
 	"github.com/tokenized/smart-contract/internal/app/state"
 	"github.com/tokenized/smart-contract/internal/app/wallet"
 	"github.com/tokenized/smart-contract/internal/broadcaster"
+	"github.com/tokenized/smart-contract/internal/outbox"
+	"github.com/tokenized/smart-contract/internal/pool"
 	"github.com/tokenized/smart-contract/internal/request"
 	"github.com/tokenized/smart-contract/internal/response"
 	"github.com/tokenized/smart-contract/internal/validator"
 	"github.com/tokenized/smart-contract/pkg/storage"
+	"github.com/tokenized/smart-contract/pkg/txbuilder"
+	"github.com/tokenized/smart-contract/pkg/txscript"
 	"github.com/tokenized/smart-contract/pkg/wire"
 )
 
-const (
-	MainNetBch wire.BitcoinNet = 0xe8f3e1e3
-	TestNetBch wire.BitcoinNet = 0xf4f3e5f4
-	RegTestBch wire.BitcoinNet = 0xfabfb5da
-)
-
 type Node struct {
 	Config   config.Config
 	Network  network.NetworkInterface
@@ -52,25 +51,44 @@ func NewNode(config config.Config,
 }
 
 func (n Node) Start() error {
-	inspector := inspector.NewInspectorService(n.Network)
-	broadcaster := broadcaster.NewBroadcastService(n.Network)
-	validator := validator.NewValidatorService(n.Config, n.Wallet, n.State)
-	request := request.NewRequestService(n.Config, n.Wallet, n.State, inspector)
+	// Parent txs and signatures are shared by everything that verifies a
+	// tx, so each is fetched and checked once.
+	txCache := txbuilder.NewTxCache(n.Network, n.Config.TxCacheSize)
+	sigCache := txscript.NewSigCache(n.Config.SigCacheSize)
+
+	inspector := inspector.NewInspectorService(n.Network, txCache, sigCache, n.Config.Net.Chain)
+	outbox := outbox.NewOutboxService(n.storage, n.Network)
+	broadcaster := broadcaster.NewBroadcastService(n.Network, outbox, txCache, sigCache)
+	pool := pool.NewPoolService(n.storage, n.Config.Net.Chain)
+	validator := validator.NewValidatorService(n.Config, n.Wallet, n.State, pool)
+	request := request.NewRequestService(n.Config, n.Wallet, n.State, inspector, pool)
 	response := response.NewResponseService(n.Config, n.State)
 
 	txHandler := NewTXHandler(n.Config,
 		n.Network,
+		n.State,
 		n.Wallet,
 		inspector,
 		broadcaster,
+		pool,
+		txCache,
 		validator,
 		request,
-		response)
+		response,
+		newMapLock(),
+		newPendingResponses())
 
 	n.Network.RegisterTxListener(txHandler)
 
-	// blockHandler := contract.NewBlockHandler(n.Config, service)
-	// network.RegisterBlockListener(blockHandler)
+	doubleSpendHandler := NewDoubleSpendHandler(txHandler)
+	n.Network.RegisterDoubleSpendListener(doubleSpendHandler)
+
+	blockHandler := NewBlockHandler(n.Config, n.Wallet, outbox, pool, broadcaster)
+	n.Network.RegisterBlockListener(blockHandler)
+
+	// Retry responses that have not been accepted by the network
+	ctx := logger.NewContextWithNamedLogger("outbox")
+	go outbox.Run(ctx)
 
 	return n.Network.Start()
 }
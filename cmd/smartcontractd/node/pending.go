@@ -0,0 +1,88 @@
+package node
+
+import (
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/tokenized/smart-contract/internal/app/state/contract"
+)
+
+const (
+	// maxPendingAge is how long a response is held as revocable after it
+	// was sent.
+	maxPendingAge = time.Hour * 72
+)
+
+// pendingResponse is the response to a request that has not been
+// confirmed, along with the state of the Contract before and after the
+// response was applied. The prior state is nil if the Contract did not
+// exist.
+//
+// A response may be more than one tx, such as a Message relayed to holders
+// in batches.
+type pendingResponse struct {
+	responses []chainhash.Hash
+	prior     *contract.Contract
+	post      *contract.Contract
+	added     time.Time
+}
+
+// pendingResponses tracks the responses that may need to be revoked if
+// the request they answered is double spent.
+type pendingResponses struct {
+	mu    *sync.Mutex
+	items map[chainhash.Hash]pendingResponse
+}
+
+// newPendingResponses returns a new pendingResponses.
+func newPendingResponses() pendingResponses {
+	return pendingResponses{
+		mu:    &sync.Mutex{},
+		items: map[chainhash.Hash]pendingResponse{},
+	}
+}
+
+// add records the response txs to a request, and the Contract state
+// before and after them.
+func (p pendingResponses) add(request chainhash.Hash,
+	responses []chainhash.Hash,
+	prior *contract.Contract,
+	post *contract.Contract) {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+
+	p.items[request] = pendingResponse{
+		responses: responses,
+		prior:     prior,
+		post:      post,
+		added:     now,
+	}
+
+	// drop anything that is too old to be revoked
+	cutoff := now.Add(-maxPendingAge)
+
+	for k, v := range p.items {
+		if v.added.Before(cutoff) {
+			delete(p.items, k)
+		}
+	}
+}
+
+// take removes and returns the pending response to a request, if any.
+func (p pendingResponses) take(request chainhash.Hash) (*pendingResponse, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	r, ok := p.items[request]
+	if !ok {
+		return nil, false
+	}
+
+	delete(p.items, request)
+
+	return &r, true
+}
@@ -3,52 +3,72 @@ package node
 import (
 	"context"
 	"errors"
+	"reflect"
 	"time"
 
 	"github.com/tokenized/smart-contract/internal/app/config"
 	"github.com/tokenized/smart-contract/internal/app/inspector"
 	"github.com/tokenized/smart-contract/internal/app/logger"
 	"github.com/tokenized/smart-contract/internal/app/network"
+	"github.com/tokenized/smart-contract/internal/app/state"
+	"github.com/tokenized/smart-contract/internal/app/state/contract"
 	"github.com/tokenized/smart-contract/internal/app/wallet"
 	"github.com/tokenized/smart-contract/internal/broadcaster"
+	"github.com/tokenized/smart-contract/internal/pool"
 	"github.com/tokenized/smart-contract/internal/request"
 	"github.com/tokenized/smart-contract/internal/response"
 	"github.com/tokenized/smart-contract/internal/validator"
+	"github.com/tokenized/smart-contract/pkg/txbuilder"
 	"github.com/tokenized/smart-contract/pkg/wire"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 )
 
 // TXHandler exists to handle the TX command.
 type TXHandler struct {
 	Config      config.Config
 	Network     network.NetworkInterface
+	State       state.StateInterface
 	Wallet      wallet.Wallet
 	Inspector   inspector.InspectorService
 	Broadcaster broadcaster.BroadcastService
+	Pool        pool.PoolService
+	TxCache     *txbuilder.TxCache
 	Validator   validator.ValidatorService
 	Request     request.RequestService
 	Response    response.ResponseService
 	mapLock     mapLock
+	pending     pendingResponses
 }
 
 // NewTXHandler returns a new TXHandler with the given Config.
 func NewTXHandler(config config.Config,
 	network network.NetworkInterface,
+	state state.StateInterface,
 	wallet wallet.Wallet,
 	inspector inspector.InspectorService,
 	broadcaster broadcaster.BroadcastService,
+	pool pool.PoolService,
+	txCache *txbuilder.TxCache,
 	validator validator.ValidatorService,
 	request request.RequestService,
-	response response.ResponseService) TXHandler {
+	response response.ResponseService,
+	mapLock mapLock,
+	pending pendingResponses) TXHandler {
 	return TXHandler{
 		Config:      config,
 		Network:     network,
+		State:       state,
 		Wallet:      wallet,
 		Inspector:   inspector,
 		Broadcaster: broadcaster,
+		Pool:        pool,
+		TxCache:     txCache,
 		Validator:   validator,
 		Request:     request,
 		Response:    response,
-		mapLock:     newMapLock(),
+		mapLock:     mapLock,
+		pending:     pending,
 	}
 }
 
@@ -76,6 +96,10 @@ func (h TXHandler) handle(ctx context.Context, tx *wire.MsgTx) error {
 	log.Infof("Received transaction : %s", tx.TxHash())
 	ts := time.Now()
 
+	// Any tx seen may be spent by a request, so keep it to save fetching it
+	// again
+	h.TxCache.Add(tx)
+
 	// Inspector: Does this transaction concern the protocol?
 	itx, err := h.Inspector.MakeTransaction(tx)
 	if err != nil || itx == nil {
@@ -115,35 +139,211 @@ func (h TXHandler) handle(ctx context.Context, tx *wire.MsgTx) error {
 
 	// Validator: Message is a reject
 	if rejectTx != nil {
-		_, _ = h.Broadcaster.Announce(ctx, rejectTx)
+		if _, err := h.Broadcaster.Announce(ctx, rejectTx); err != nil {
+			log.Error(err)
+		}
 		return nil
 	}
 	if contract == nil {
 		return nil
 	}
 
-	// Request: Grab me a response
-	resItx, err := h.Request.Process(ctx, itx, contract)
+	// Withhold the response if the request has been double spent.
+	hash := tx.TxHash()
+	if h.Network.IsDoubleSpent(ctx, &hash) {
+		log.Warnf("Withholding response : request was double spent")
+		return nil
+	}
+
+	// Keep the current state of the Contract, so the response can be
+	// revoked if the request is double spent later.
+	prior, err := h.read(ctx, contract.ID)
 	if err != nil {
 		log.Error(err)
 		return nil
 	}
 
-	// Response: Process response
-	err = h.Response.Process(ctx, resItx, contract)
+	// Request: Grab me a response. A response may be more than one tx.
+	resItxs, err := h.Request.Process(ctx, itx, contract)
 	if err != nil {
 		log.Error(err)
+		h.rejectFailure(ctx, itx, err)
 		return nil
 	}
 
-	// Broadcaster: Broadcast response
-	_, err = h.Broadcaster.Announce(ctx, resItx.MsgTx)
+	// Broadcaster: Record the responses before any state is written, so
+	// they are not lost if sending them fails.
+	resHashes := make([]chainhash.Hash, len(resItxs))
+	for i, resItx := range resItxs {
+		resHashes[i] = resItx.MsgTx.TxHash()
+	}
+
+	for i, resItx := range resItxs {
+		if err := h.Broadcaster.Record(ctx, hash, contract.ID, resItx.MsgTx); err != nil {
+			log.Error(err)
+			h.cancelAll(ctx, resHashes[:i])
+
+			// the responses not recorded hold reservations as well
+			for _, response := range resHashes[i:] {
+				h.release(ctx, response)
+			}
+			return nil
+		}
+
+		// a later response may spend this one
+		h.TxCache.Add(resItx.MsgTx)
+	}
+
+	// Response: Process responses
+	for _, resItx := range resItxs {
+		if err := h.Response.Process(ctx, resItx, contract); err != nil {
+			log.Error(err)
+			h.cancelAll(ctx, resHashes)
+			return nil
+		}
+	}
+
+	post, err := h.read(ctx, contract.ID)
 	if err != nil {
 		log.Error(err)
 		return nil
 	}
 
+	h.pending.add(hash, resHashes, prior, post)
+
+	// The request may have been double spent while it was processed.
+	if h.Network.IsDoubleSpent(ctx, &hash) {
+		log.Warnf("Withholding response : request was double spent")
+		h.revoke(ctx, hash)
+		return nil
+	}
+
+	// Broadcaster: Broadcast responses, in order. If this fails the outbox
+	// sends them later.
+	for _, resItx := range resItxs {
+		if _, err := h.Broadcaster.Announce(ctx, resItx.MsgTx); err != nil {
+			log.Error(err)
+			return nil
+		}
+	}
+
 	// there is nothing to return, because this handler doesn't return
 	// messages back to the peer. Any messaging was handled by the Service.
 	return nil
 }
+
+// read returns the stored state of a Contract, or nil if the Contract has
+// not been stored yet.
+func (h TXHandler) read(ctx context.Context,
+	id string) (*contract.Contract, error) {
+
+	c, err := h.State.Read(ctx, id)
+	if err == state.ErrContractNotFound {
+		return nil, nil
+	}
+
+	return c, err
+}
+
+// revoke restores the Contract to the state it was in before the response
+// to a request was applied.
+//
+// The caller must hold the lock for the contract.
+func (h TXHandler) revoke(ctx context.Context, request chainhash.Hash) {
+	log := logger.NewLoggerFromContext(ctx).Sugar()
+
+	p, ok := h.pending.take(request)
+	if !ok {
+		return
+	}
+
+	// the response must never be sent
+	h.cancelAll(ctx, p.responses)
+
+	// any further txs of the response follow the first
+	first := p.responses[0]
+
+	if p.post == nil {
+		// the response did not store any state
+		return
+	}
+
+	current, err := h.read(ctx, p.post.ID)
+	if err != nil {
+		log.Errorf("Failed to revoke response %s : %v", first, err)
+		return
+	}
+
+	// later responses have changed the contract, and would be lost.
+	if !reflect.DeepEqual(current, p.post) {
+		log.Errorf("Cannot revoke response %s : contract has changed since request %s",
+			first, request)
+		return
+	}
+
+	if p.prior == nil {
+		log.Errorf("Cannot revoke response %s : contract did not exist before request %s",
+			first, request)
+		return
+	}
+
+	if err := h.State.Write(ctx, *p.prior); err != nil {
+		log.Errorf("Failed to revoke response %s : %v", first, err)
+		return
+	}
+
+	log.Warnf("Revoked response %s to double spent request %s",
+		first, request)
+}
+
+// rejectFailure sends a rejection for a request that could not be responded
+// to, if the Validator has a rejection reason for the failure.
+func (h TXHandler) rejectFailure(ctx context.Context,
+	itx *inspector.Transaction,
+	failure error) {
+
+	log := logger.NewLoggerFromContext(ctx).Sugar()
+
+	rejectTx, err := h.Validator.RejectFailure(ctx, itx, failure)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	if rejectTx == nil {
+		return
+	}
+
+	if _, err := h.Broadcaster.Announce(ctx, rejectTx); err != nil {
+		log.Error(err)
+	}
+}
+
+// cancelAll cancels each of the recorded responses to a request.
+func (h TXHandler) cancelAll(ctx context.Context, responses []chainhash.Hash) {
+	for _, response := range responses {
+		h.cancel(ctx, response)
+	}
+}
+
+// cancel stops a recorded response from being sent, and frees any UTXOs
+// it reserved from the pool.
+func (h TXHandler) cancel(ctx context.Context, response chainhash.Hash) {
+	log := logger.NewLoggerFromContext(ctx).Sugar()
+
+	if err := h.Broadcaster.Cancel(ctx, response); err != nil {
+		log.Errorf("Failed to cancel response %s : %v", response, err)
+	}
+
+	h.release(ctx, response)
+}
+
+// release frees any UTXOs a response reserved from the pool, when it will
+// not be sent.
+func (h TXHandler) release(ctx context.Context, response chainhash.Hash) {
+	log := logger.NewLoggerFromContext(ctx).Sugar()
+
+	if err := h.Pool.Release(ctx, response); err != nil {
+		log.Errorf("Failed to release pool for response %s : %v", response, err)
+	}
+}
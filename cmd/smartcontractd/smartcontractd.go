@@ -11,8 +11,10 @@ import (
 	"github.com/tokenized/smart-contract/internal/app/network"
 	"github.com/tokenized/smart-contract/internal/app/rpcnode"
 	"github.com/tokenized/smart-contract/internal/app/wallet"
+	"github.com/tokenized/smart-contract/internal/signer"
 	"github.com/tokenized/smart-contract/pkg/spvnode"
 	"github.com/tokenized/smart-contract/pkg/storage"
+	"github.com/tokenized/smart-contract/pkg/txbuilder"
 )
 
 var (
@@ -48,22 +50,25 @@ func main() {
 	}
 
 	spvConfig := spvnode.NewConfig(os.Getenv("NODE_ADDRESS"),
-		os.Getenv("NODE_USER_AGENT"))
+		os.Getenv("NODE_USER_AGENT"),
+		config.Net)
 
 	spvNode := spvnode.NewNode(spvConfig, spvStorage)
 
 	// Network
 	rpcConfig := rpcnode.NewConfig(os.Getenv("RPC_HOST"),
 		os.Getenv("RPC_USERNAME"),
-		os.Getenv("RPC_PASSWORD"))
+		os.Getenv("RPC_PASSWORD"),
+		config.Net)
 
-	network, err := network.NewNetwork(rpcConfig, spvNode)
+	network, err := network.NewNetwork(rpcConfig, &spvNode)
 	if err != nil {
 		panic(err)
 	}
 
-	// Wallet
-	wallet, err := wallet.NewWallet(os.Getenv("PRIV_KEY"))
+	// Wallet, signing with the signer daemon if there is one, so the key
+	// is not held here
+	wallet, err := newWallet(config)
 	if err != nil {
 		panic(err)
 	}
@@ -93,6 +98,24 @@ func main() {
 	}
 }
 
+// newWallet returns a Wallet that signs with the signer daemon listening
+// on SIGNER_SOCKET, or with PRIV_KEY if there is no signer daemon.
+func newWallet(config *config.Config) (*wallet.Wallet, error) {
+	policy := txbuilder.NewFeePolicy(config.FeeRate)
+
+	path := os.Getenv("SIGNER_SOCKET")
+	if len(path) == 0 {
+		return wallet.NewWallet(os.Getenv("PRIV_KEY"), config.Net.Chain, policy)
+	}
+
+	client, err := signer.Dial(path, []byte(os.Getenv("SIGNER_SECRET")))
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.NewWalletWithSigner(client, config.Net.Chain, policy)
+}
+
 // buildDetails returns a string that describes the details of the build.
 func buildDetails() string {
 	return fmt.Sprintf("%v (%v on %v)", buildVersion, buildUser, buildDate)
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/tokenized/smart-contract/internal/app/logger"
+	"github.com/tokenized/smart-contract/pkg/netparams"
 	"github.com/tokenized/smart-contract/pkg/spvnode"
 	"github.com/tokenized/smart-contract/pkg/storage"
 )
@@ -35,8 +36,27 @@ func main() {
 		spvStorage = storage.NewS3Storage(storeConfig)
 	}
 
+	params, err := netparams.ByName(os.Getenv("NETWORK"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Recent blocks to sync headers from, as well as those known
+	if cs := os.Getenv("CHECKPOINTS"); len(cs) > 0 {
+		checkpoints, err := netparams.ParseCheckpoints(cs)
+		if err != nil {
+			panic(err)
+		}
+
+		params, err = params.WithCheckpoints(checkpoints)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	config := spvnode.NewConfig(os.Getenv("NODE_ADDRESS"),
-		os.Getenv("NODE_USER_AGENT"))
+		os.Getenv("NODE_USER_AGENT"),
+		params)
 
 	// Log startup sequence
 	log.Infof("Started %v with config %s", buildDetails(), config)
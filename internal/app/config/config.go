@@ -15,15 +15,40 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/tokenized/smart-contract/pkg/netparams"
+	"github.com/tokenized/smart-contract/pkg/txbuilder"
+
 	"github.com/btcsuite/btcutil"
 )
 
+// DefaultPoolMaxTopUp is the most a response draws from the pool of a
+// contract, in sats, if POOL_MAX_TOPUP is not set.
+const DefaultPoolMaxTopUp = uint64(10000)
+
+// DefaultMaxMessageRecipients is the most holders a relayed Message is sent
+// to in one tx, if MAX_MESSAGE_RECIPIENTS is not set.
+const DefaultMaxMessageRecipients = 100
+
+// DefaultTxCacheSize is the number of parent txs held in memory, if
+// TX_CACHE_SIZE is not set.
+const DefaultTxCacheSize = txbuilder.DefaultTxCacheSize
+
+// DefaultSigCacheSize is the number of verified signatures held in memory,
+// if SIG_CACHE_SIZE is not set.
+const DefaultSigCacheSize = txbuilder.DefaultSigCacheSize
+
 // Config holds all configuration for the running service.
 type Config struct {
-	ContractProviderID string
-	Version            string
-	Fee                Fee
+	ContractProviderID   string
+	Version              string
+	Fee                  Fee
+	FeeRate              uint64
+	ResponseFeeRates     map[string]uint64
+	PoolMaxTopUp         uint64
+	MaxMessageRecipients int
+	TxCacheSize          int
+	SigCacheSize         uint
+	Net                  *netparams.Params
 }
 
 // NewConfig returns a new Config populated from environment variables.
@@ -33,9 +58,30 @@ func NewConfig() (*Config, error) {
 		Version:            os.Getenv("VERSION"),
 	}
 
+	// Network to run on, defaulting to mainnet
+	params, err := netparams.ByName(os.Getenv("NETWORK"))
+	if err != nil {
+		return nil, fmt.Errorf("%v : %v", err, os.Getenv("NETWORK"))
+	}
+
+	// Recent blocks to sync headers from, as well as those known
+	if cs := os.Getenv("CHECKPOINTS"); len(cs) > 0 {
+		checkpoints, err := netparams.ParseCheckpoints(cs)
+		if err != nil {
+			return nil, err
+		}
+
+		params, err = params.WithCheckpoints(checkpoints)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	c.Net = params
+
 	// Operator fee address
 	feeAddr := os.Getenv("FEE_ADDRESS")
-	feeAddress, err := btcutil.DecodeAddress(feeAddr, &chaincfg.MainNetParams)
+	feeAddress, err := btcutil.DecodeAddress(feeAddr, params.Chain)
 	if err != nil {
 		return nil, err
 	}
@@ -55,6 +101,81 @@ func NewConfig() (*Config, error) {
 		return nil, errors.New("Fee is set to 0 sats")
 	}
 
+	// Miner fee rate in sats per KB
+	c.FeeRate = txbuilder.DefaultSatsPerKB
+	if rate := os.Getenv("FEE_RATE"); len(rate) > 0 {
+		c.FeeRate, err = strconv.ParseUint(rate, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Miner fee rate in sats per KB for a type of response, in place of
+	// FeeRate, as code:rate pairs separated by commas
+	c.ResponseFeeRates = map[string]uint64{}
+	if rates := os.Getenv("RESPONSE_FEE_RATES"); len(rates) > 0 {
+		for _, pair := range strings.Split(rates, ",") {
+			parts := strings.Split(strings.TrimSpace(pair), ":")
+			if len(parts) != 2 {
+				return nil, fmt.Errorf("Response fee rate %q is not code:rate", pair)
+			}
+
+			rate, err := strconv.ParseUint(parts[1], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+
+			c.ResponseFeeRates[parts[0]] = rate
+		}
+	}
+
+	// Most a response can draw from the pool of the contract, when the
+	// request did not pay for it
+	c.PoolMaxTopUp = DefaultPoolMaxTopUp
+	if max := os.Getenv("POOL_MAX_TOPUP"); len(max) > 0 {
+		c.PoolMaxTopUp, err = strconv.ParseUint(max, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Holders a Message from the issuer is relayed to in each tx
+	c.MaxMessageRecipients = DefaultMaxMessageRecipients
+	if max := os.Getenv("MAX_MESSAGE_RECIPIENTS"); len(max) > 0 {
+		n, err := strconv.ParseUint(max, 10, 31)
+		if err != nil {
+			return nil, err
+		}
+
+		if n == 0 {
+			return nil, errors.New("Max message recipients is set to 0")
+		}
+
+		c.MaxMessageRecipients = int(n)
+	}
+
+	// Parent txs and signatures kept so they are not fetched or checked
+	// again
+	c.TxCacheSize = DefaultTxCacheSize
+	if size := os.Getenv("TX_CACHE_SIZE"); len(size) > 0 {
+		n, err := strconv.ParseUint(size, 10, 31)
+		if err != nil {
+			return nil, err
+		}
+
+		c.TxCacheSize = int(n)
+	}
+
+	c.SigCacheSize = DefaultSigCacheSize
+	if size := os.Getenv("SIG_CACHE_SIZE"); len(size) > 0 {
+		n, err := strconv.ParseUint(size, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+
+		c.SigCacheSize = uint(n)
+	}
+
 	return &c, nil
 }
 
@@ -63,9 +184,16 @@ func NewConfig() (*Config, error) {
 // This is important so we don't log sensitive config values.
 func (c Config) String() string {
 	pairs := map[string]string{
-		"ContractProviderID": c.ContractProviderID,
-		"Version":            c.Version,
-		"Fee":                fmt.Sprintf("%+v", c.Fee),
+		"ContractProviderID":   c.ContractProviderID,
+		"Version":              c.Version,
+		"Fee":                  fmt.Sprintf("%+v", c.Fee),
+		"FeeRate":              fmt.Sprintf("%v", c.FeeRate),
+		"ResponseFeeRates":     fmt.Sprintf("%v", c.ResponseFeeRates),
+		"PoolMaxTopUp":         fmt.Sprintf("%v", c.PoolMaxTopUp),
+		"MaxMessageRecipients": fmt.Sprintf("%v", c.MaxMessageRecipients),
+		"TxCacheSize":          fmt.Sprintf("%v", c.TxCacheSize),
+		"SigCacheSize":         fmt.Sprintf("%v", c.SigCacheSize),
+		"Net":                  c.Net.String(),
 	}
 
 	parts := []string{}
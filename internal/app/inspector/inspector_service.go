@@ -10,33 +10,36 @@ package inspector
  */
 
 import (
-	"bytes"
-	"errors"
-
 	"github.com/tokenized/smart-contract/internal/app/network"
 	"github.com/tokenized/smart-contract/pkg/protocol"
 	"github.com/tokenized/smart-contract/pkg/txbuilder"
+	"github.com/tokenized/smart-contract/pkg/txscript"
 	"github.com/tokenized/smart-contract/pkg/wire"
 
 	"github.com/btcsuite/btcd/chaincfg"
-	"github.com/btcsuite/btcd/txscript"
-)
-
-var (
-	targetVersion = []byte{0x0, 0x0, 0x0, 0x20}
 )
 
 type InspectorService struct {
-	Network network.NetworkInterface
-	Builder txbuilder.UTXOSetBuilder
+	Network  network.NetworkInterface
+	Builder  txbuilder.UTXOSetBuilder
+	SigCache *txscript.SigCache
+	Params   *chaincfg.Params
 }
 
-func NewInspectorService(network network.NetworkInterface) InspectorService {
-	builder := txbuilder.NewUTXOSetBuilder(network)
+// NewInspectorService returns a new InspectorService. The txs spent by a
+// tx are found in the cache before asking the network for them.
+func NewInspectorService(network network.NetworkInterface,
+	cache *txbuilder.TxCache,
+	sigCache *txscript.SigCache,
+	params *chaincfg.Params) InspectorService {
+
+	builder := txbuilder.NewUTXOSetBuilder(cache)
 
 	return InspectorService{
-		Network: network,
-		Builder: builder,
+		Network:  network,
+		Builder:  builder,
+		SigCache: sigCache,
+		Params:   params,
 	}
 }
 
@@ -90,10 +93,15 @@ func (s InspectorService) PromoteTransaction(tx *Transaction) (*Transaction, err
 		return nil, err
 	}
 
+	// Refuse to process a tx that does not spend its inputs
+	if err := txbuilder.VerifyWithCache(tx.MsgTx, inputs, s.SigCache); err != nil {
+		return nil, err
+	}
+
 	tx.Inputs = inputs
 
 	// Input addreses
-	inputAddresses, err := inputs.Addresses()
+	inputAddresses, err := inputs.Addresses(s.Params)
 	if err != nil {
 		return nil, err
 	}
@@ -121,12 +129,13 @@ func (s InspectorService) getOutputs(tx *wire.MsgTx) ([]txbuilder.TxOutput, erro
 
 		utxo := txbuilder.NewUTXOFromTX(*tx, uint32(i))
 
-		address, err := utxo.PublicAddress(&chaincfg.MainNetParams)
+		address, err := utxo.PublicAddress(s.Params)
 		if err != nil {
 			return nil, err
 		}
 
 		output := txbuilder.TxOutput{
+			Type:    txbuilder.AddressOutputType(address),
 			Index:   uint32(i),
 			Value:   uint64(txOut.Value),
 			Address: address,
@@ -164,27 +173,21 @@ func (s InspectorService) findTokenizedProtocol(tx *wire.MsgTx) (protocol.OpRetu
 
 // Returns a Tokenized protocol instance
 func (s InspectorService) newProtocolMessage(txOut *wire.TxOut) (protocol.OpReturnMessage, error) {
-	if txOut.PkScript[0] != txscript.OP_RETURN {
-		return nil, errors.New("Payload is not an OP_RETURN")
-	}
-
 	return protocol.New(txOut.PkScript)
 }
 
+// isTokenizedOpReturn returns true if the script is an OP_RETURN carrying a
+// message of any supported version of the protocol.
 func (s InspectorService) isTokenizedOpReturn(pkScript []byte) bool {
 	if len(pkScript) < 20 {
 		// this isn't long enough to be a sane message
 		return false
 	}
 
-	version := make([]byte, 4, 4)
-
-	// get the version. Where that is, depends on the message structure.
-	if pkScript[1] < 0x4c {
-		version = pkScript[2:6]
-	} else {
-		version = pkScript[3:7]
+	version, err := protocol.Version(pkScript)
+	if err != nil {
+		return false
 	}
 
-	return bytes.Equal(version, targetVersion)
+	return protocol.IsSupported(version)
 }
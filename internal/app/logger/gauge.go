@@ -0,0 +1,28 @@
+package logger
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// Gauge writes the current value of a measurement to the Logger, with
+// labels that tell measurements of the same name apart.
+func Gauge(ctx context.Context,
+	name string,
+	value uint64,
+	labels map[string]string) {
+
+	logger := NewLoggerFromContext(ctx)
+
+	fields := []zap.Field{
+		zap.String("gauge", name),
+		zap.Uint64("value", value),
+	}
+
+	for k, v := range labels {
+		fields = append(fields, zap.String(k, v))
+	}
+
+	logger.Info(name, fields...)
+}
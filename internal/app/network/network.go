@@ -2,7 +2,9 @@ package network
 
 import (
 	"context"
+	"errors"
 
+	"github.com/tokenized/smart-contract/internal/app/logger"
 	"github.com/tokenized/smart-contract/internal/app/rpcnode"
 	"github.com/tokenized/smart-contract/pkg/spvnode"
 	"github.com/tokenized/smart-contract/pkg/wire"
@@ -12,6 +14,10 @@ import (
 	"github.com/btcsuite/btcutil"
 )
 
+// ErrNotAccepted is returned when a tx has been announced to the peer, but
+// the peer has not requested it yet.
+var ErrNotAccepted = errors.New("Tx not requested by peer yet")
+
 /**
  * Network Kit
  *
@@ -26,7 +32,7 @@ type Network struct {
 	// PeerNodes     []spvnode.Node
 }
 
-func NewNetwork(rc rpcnode.Config, pn spvnode.Node) (*Network, error) {
+func NewNetwork(rc rpcnode.Config, pn *spvnode.Node) (*Network, error) {
 	rn, err := rpcnode.NewNode(rc)
 	if err != nil {
 		return nil, err
@@ -41,6 +47,11 @@ func NewNetwork(rc rpcnode.Config, pn spvnode.Node) (*Network, error) {
 		TrustedNode: tn,
 	}
 
+	// txs the peer does not pick up are sent through RPC instead
+	pn.RegisterListener(spvnode.ListenerBroadcastFallback, rpcFallback{
+		RpcNode: rn,
+	})
+
 	return n, nil
 }
 
@@ -52,10 +63,60 @@ func (n Network) RegisterBlockListener(listener Listener) {
 	n.TrustedNode.PeerNode.RegisterListener(spvnode.ListenerBlock, listener)
 }
 
+// RegisterDoubleSpendListener registers a Listener that is given each tx
+// seen to conflict with another tx.
+func (n Network) RegisterDoubleSpendListener(listener Listener) {
+	n.TrustedNode.PeerNode.RegisterListener(spvnode.ListenerDoubleSpend, listener)
+}
+
+// IsDoubleSpent returns true if the tx has been seen to conflict with
+// another tx.
+func (n Network) IsDoubleSpent(ctx context.Context, id *chainhash.Hash) bool {
+	return n.TrustedNode.PeerNode.IsDoubleSpent(*id)
+}
+
 func (n Network) Start() error {
 	return n.TrustedNode.PeerNode.Start()
 }
 
+// SendTX broadcasts a tx to the peer network. The peer node keeps
+// announcing it until it is mined.
+//
+// The tx is only accepted once the peer has requested it. Until then
+// ErrNotAccepted is returned, and the tx should be sent again later.
+//
+// If the peer node can't broadcast the tx, or the peer did not request it
+// after it was announced, it is sent through the RPC node instead.
+func (n Network) SendTX(ctx context.Context, tx *wire.MsgTx) (*chainhash.Hash, error) {
+	peer := n.TrustedNode.PeerNode
+	hash := tx.TxHash()
+
+	if peer.IsRequested(hash) {
+		return &hash, nil
+	}
+
+	log := logger.NewLoggerFromContext(ctx).Sugar()
+
+	if peer.IsAnnounced(hash) {
+		log.Warnf("Peer has not requested tx %s, using RPC", hash)
+
+		return n.TrustedNode.RpcNode.SendTX(ctx, tx)
+	}
+
+	if err := peer.BroadcastTX(ctx, tx); err != nil {
+		log.Warnf("Peer broadcast failed, using RPC : %v", err)
+
+		return n.TrustedNode.RpcNode.SendTX(ctx, tx)
+	}
+
+	return nil, ErrNotAccepted
+}
+
+// CancelTX stops the peer node announcing a tx that must not be sent.
+func (n Network) CancelTX(ctx context.Context, id *chainhash.Hash) {
+	n.TrustedNode.PeerNode.CancelTX(*id)
+}
+
 //
 // RPC Node proxies
 //
@@ -64,10 +125,6 @@ func (n Network) GetTX(ctx context.Context, id *chainhash.Hash) (*wire.MsgTx, er
 	return n.TrustedNode.RpcNode.GetTX(ctx, id)
 }
 
-func (n Network) SendTX(ctx context.Context, tx *wire.MsgTx) (*chainhash.Hash, error) {
-	return n.TrustedNode.RpcNode.SendTX(ctx, tx)
-}
-
 func (n Network) ListTransactions(ctx context.Context, address btcutil.Address) ([]btcjson.ListTransactionsResult, error) {
 	return n.TrustedNode.RpcNode.ListTransactions(ctx, address)
 }
@@ -14,7 +14,10 @@ type NetworkInterface interface {
 	Start() error
 	RegisterTxListener(Listener)
 	RegisterBlockListener(Listener)
+	RegisterDoubleSpendListener(Listener)
+	IsDoubleSpent(context.Context, *chainhash.Hash) bool
 	GetTX(context.Context, *chainhash.Hash) (*wire.MsgTx, error)
 	SendTX(context.Context, *wire.MsgTx) (*chainhash.Hash, error)
+	CancelTX(context.Context, *chainhash.Hash)
 	ListTransactions(context.Context, btcutil.Address) ([]btcjson.ListTransactionsResult, error)
 }
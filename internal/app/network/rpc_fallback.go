@@ -0,0 +1,31 @@
+package network
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tokenized/smart-contract/internal/app/logger"
+	"github.com/tokenized/smart-contract/internal/app/rpcnode"
+	"github.com/tokenized/smart-contract/pkg/wire"
+)
+
+// rpcFallback sends txs through the RPC node, when the peer node has not
+// been able to broadcast them.
+type rpcFallback struct {
+	RpcNode *rpcnode.RPCNode
+}
+
+// Handle implements the Listener interface.
+func (f rpcFallback) Handle(ctx context.Context, m wire.Message) error {
+	tx, ok := m.(*wire.MsgTx)
+	if !ok {
+		return errors.New("Could not assert as *wire.MsgTx")
+	}
+
+	log := logger.NewLoggerFromContext(ctx).Sugar()
+	log.Infof("Sending tx %s through RPC", tx.TxHash())
+
+	_, err := f.RpcNode.SendTX(ctx, tx)
+
+	return err
+}
@@ -1,11 +1,11 @@
 package network
 
 import (
-	"github.com/tokenized/smart-contract/pkg/spvnode"
 	"github.com/tokenized/smart-contract/internal/app/rpcnode"
+	"github.com/tokenized/smart-contract/pkg/spvnode"
 )
 
 type TrustedNode struct {
-	RpcNode    *rpcnode.RPCNode
-	PeerNode   spvnode.Node
+	RpcNode  *rpcnode.RPCNode
+	PeerNode *spvnode.Node
 }
@@ -2,19 +2,30 @@ package rpcnode
 
 import (
 	"fmt"
+	"net"
+
+	"github.com/tokenized/smart-contract/pkg/netparams"
 )
 
 type Config struct {
-	Host string
+	Host     string
 	Username string
 	Password string
 }
 
-func NewConfig(host, username, password string) Config {
+// NewConfig returns a new Config. If the host has no port, the default RPC
+// port of the network is used.
+func NewConfig(host, username, password string,
+	params *netparams.Params) Config {
+
+	if _, _, err := net.SplitHostPort(host); err != nil {
+		host = net.JoinHostPort(host, params.RPCPort)
+	}
+
 	return Config{
-		Host:        host,
-		Username:    username,
-		Password:    password,
+		Host:     host,
+		Username: username,
+		Password: password,
 	}
 }
 
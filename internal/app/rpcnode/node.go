@@ -20,7 +20,6 @@ import (
 	"github.com/tokenized/smart-contract/pkg/wire"
 
 	"github.com/btcsuite/btcd/btcjson"
-	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/rpcclient"
 	btcwire "github.com/btcsuite/btcd/wire"
@@ -108,13 +107,7 @@ func (r RPCNode) ListUnspent(ctx context.Context,
 		return nil, err
 	}
 
-	// Decode address from string in to array
-	a, err := btcutil.DecodeAddress(strAddr, &chaincfg.MainNetParams)
-	if err != nil {
-		return nil, err
-	}
-
-	addresses := []btcutil.Address{a}
+	addresses := []btcutil.Address{address}
 
 	// out []btcjson.ListUnspentResult
 	out, err := r.client.ListUnspentMinMaxAddresses(0, 999999, addresses)
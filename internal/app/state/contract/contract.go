@@ -13,10 +13,14 @@ import (
 	"github.com/btcsuite/btcutil"
 )
 
+// unpinnedProtocolID is the protocol ID of contracts stored without one.
+const unpinnedProtocolID uint32 = 0x00000020
+
 // Contract represents a Smart Contract.
 type Contract struct {
 	ID                          string           `json:"id"`
 	CreatedAt                   int64            `json:"created_at"`
+	ProtocolID                  uint32           `json:"protocol_id"`
 	IssuerAddress               string           `json:"issuer_address"`
 	OperatorAddress             string           `json:"operator_address"`
 	Revision                    uint16           `json:"revision"`
@@ -50,6 +54,7 @@ func NewContract(tx *wire.MsgTx,
 	c := Contract{
 		ID:                          contractAddress.EncodeAddress(),
 		CreatedAt:                   time.Now().UnixNano(),
+		ProtocolID:                  m.ProtocolID,
 		IssuerAddress:               issuerAddress.EncodeAddress(),
 		ContractName:                string(m.ContractName),
 		ContractFileHash:            fmt.Sprintf("%x", m.ContractFileHash),
@@ -107,9 +112,9 @@ func EditContract(c *Contract, cf *protocol.ContractFormation) *Contract {
 	return newContract
 }
 
-// Address returns the contract ID as an Address.
-func (c Contract) Address() (btcutil.Address, error) {
-	return btcutil.DecodeAddress(c.ID, &chaincfg.MainNetParams)
+// Address returns the contract ID as an Address on the given network.
+func (c Contract) Address(params *chaincfg.Params) (btcutil.Address, error) {
+	return btcutil.DecodeAddress(c.ID, params)
 }
 
 // Flags converts the AuthorizationFlags as a uint16.
@@ -121,6 +126,19 @@ func (c Contract) Flags() uint16 {
 	return binary.BigEndian.Uint16(c.AuthorizationFlags)
 }
 
+// Protocol returns the protocol ID the contract was formed with. Messages
+// for the contract must be sent with the same protocol version.
+//
+// Contracts stored before the version was pinned were all formed with the
+// first version of the protocol.
+func (c Contract) Protocol() uint32 {
+	if c.ProtocolID == 0 {
+		return unpinnedProtocolID
+	}
+
+	return c.ProtocolID
+}
+
 func (c Contract) IsIssuer(address string) bool {
 	return c.IssuerAddress == address
 }
@@ -36,6 +36,7 @@ func TestContract_NewContract(t *testing.T) {
 
 	wantContract := Contract{
 		ID:               "1Cessj8TyzEypaVzp9V8oZhiMLokVDNSR5",
+		ProtocolID:       protocol.ProtocolID,
 		IssuerAddress:    "1DNTgNSWtTestKs7j1DwaoxmSc4q9sEUsb",
 		ContractFileHash: "5891b5b522d5df086d0ff0b110fbd9d21bb4fc7163af34d08286a2e846f6be03",
 		Assets:           map[string]Asset{},
@@ -48,6 +49,33 @@ func TestContract_NewContract(t *testing.T) {
 	}
 }
 
+func TestContract_Protocol(t *testing.T) {
+	tests := []struct {
+		name     string
+		contract Contract
+		want     uint32
+	}{
+		{
+			name:     "pinned",
+			contract: Contract{ProtocolID: 0x21},
+			want:     0x21,
+		},
+		{
+			name:     "unpinned",
+			contract: Contract{},
+			want:     0x20,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.contract.Protocol(); got != tt.want {
+				t.Errorf("got %#08x, want %#08x", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestContract_IsIssuer(t *testing.T) {
 	contractAddr := "1Cy7znvXpwTZZG5iqiZoMYtQXfThbLBadf"
 	issuerAddr := "1CmQLd5vRdcvqXFaCeeLTcXZVHXzSzgscv"
@@ -4,7 +4,8 @@ import (
 	"encoding/hex"
 	"errors"
 
-	"github.com/btcsuite/btcd/btcec"
+	"github.com/tokenized/smart-contract/pkg/txbuilder"
+
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcutil"
 )
@@ -13,16 +14,19 @@ var (
 	ErrKeyNotFound = errors.New("Key not found")
 )
 
+// KeyStore holds the Signer for each address of the wallet.
 type KeyStore struct {
-	Keys map[string]*btcec.PrivateKey
+	Keys map[string]txbuilder.Signer
 }
 
-func NewKeyStore(privKey *btcec.PrivateKey) (*KeyStore, error) {
-	pub := privKey.PubKey()
+func NewKeyStore(signer txbuilder.Signer,
+	params *chaincfg.Params) (*KeyStore, error) {
+
+	pub := signer.PublicKey()
 
 	h := hex.EncodeToString(pub.SerializeCompressed())
 
-	pubhash, err := btcutil.DecodeAddress(h, &chaincfg.MainNetParams)
+	pubhash, err := btcutil.DecodeAddress(h, params)
 	if err != nil {
 		return nil, err
 	}
@@ -30,15 +34,15 @@ func NewKeyStore(privKey *btcec.PrivateKey) (*KeyStore, error) {
 	address := pubhash.EncodeAddress()
 
 	store := KeyStore{
-		Keys: map[string]*btcec.PrivateKey{
-			address: privKey,
+		Keys: map[string]txbuilder.Signer{
+			address: signer,
 		},
 	}
 
 	return &store, nil
 }
 
-func (k KeyStore) Get(address string) (*btcec.PrivateKey, error) {
+func (k KeyStore) Get(address string) (txbuilder.Signer, error) {
 	key, ok := k.Keys[address]
 
 	if !ok {
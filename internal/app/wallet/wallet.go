@@ -5,7 +5,7 @@ package wallet
  *
  * What is my purpose?
  * - You sign messages
- * - You store keys
+ * - You know who holds the keys
  */
 
 import (
@@ -24,11 +24,16 @@ import (
 type Wallet struct {
 	KeyStore      *KeyStore
 	PublicAddress string
-	PrivateKey    *btcec.PrivateKey
 	PublicKey     *btcec.PublicKey
+	FeePolicy     txbuilder.FeePolicy
 }
 
-func NewWallet(secret string) (*Wallet, error) {
+// NewWallet returns a Wallet that signs in process with the key in the WIF
+// secret.
+func NewWallet(secret string,
+	params *chaincfg.Params,
+	feePolicy txbuilder.FeePolicy) (*Wallet, error) {
+
 	if len(secret) == 0 {
 		return nil, errors.New("Create wallet failed: missing secret")
 	}
@@ -39,13 +44,20 @@ func NewWallet(secret string) (*Wallet, error) {
 		return nil, err
 	}
 
-	// Private / Public Keys
-	priv := wif.PrivKey
-	pub := priv.PubKey()
+	return NewWalletWithSigner(txbuilder.NewKeySigner(wif.PrivKey), params, feePolicy)
+}
+
+// NewWalletWithSigner returns a Wallet that signs with the Signer, such as
+// a signer daemon, so the key is never held by the Wallet.
+func NewWalletWithSigner(signer txbuilder.Signer,
+	params *chaincfg.Params,
+	feePolicy txbuilder.FeePolicy) (*Wallet, error) {
+
+	pub := signer.PublicKey()
 
 	// Public Address (PKH)
 	h := hex.EncodeToString(pub.SerializeCompressed())
-	pubhash, err := btcutil.DecodeAddress(h, &chaincfg.MainNetParams)
+	pubhash, err := btcutil.DecodeAddress(h, params)
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +65,7 @@ func NewWallet(secret string) (*Wallet, error) {
 	pubaddr := pubhash.EncodeAddress()
 
 	// Key Store
-	keystore, err := NewKeyStore(priv)
+	keystore, err := NewKeyStore(signer, params)
 	if err != nil {
 		return nil, err
 	}
@@ -61,33 +73,40 @@ func NewWallet(secret string) (*Wallet, error) {
 	w := Wallet{
 		KeyStore:      keystore,
 		PublicAddress: pubaddr,
-		PrivateKey:    priv,
 		PublicKey:     pub,
+		FeePolicy:     feePolicy,
 	}
 
 	return &w, nil
 }
 
-func (w Wallet) Get(address string) (*btcec.PrivateKey, error) {
+// Get returns the Signer for the address.
+func (w Wallet) Get(address string) (txbuilder.Signer, error) {
 	return w.KeyStore.Get(address)
 }
 
-func (w Wallet) BuildTX(key *btcec.PrivateKey,
+// BuildTX builds a tx for a message, signed by the Signer, and reports how
+// it was paid for.
+//
+// The fee is set by the FeePolicy of the Wallet, unless feePolicy is not
+// nil.
+func (w Wallet) BuildTX(signer txbuilder.Signer,
 	utxos txbuilder.UTXOs,
 	outs []txbuilder.TxOutput,
 	changeAddress btcutil.Address,
-	m protocol.OpReturnMessage) (*wire.MsgTx, error) {
+	m protocol.OpReturnMessage,
+	feePolicy *txbuilder.FeePolicy) (*wire.MsgTx, *txbuilder.BuildReport, error) {
 
 	outputs := w.buildOutputs(outs)
 
 	payload := make([]byte, m.Len(), m.Len())
 	if _, err := m.Read(payload); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	builder := txbuilder.NewTxBuilder(key)
+	builder := txbuilder.NewTxBuilderWithSigner(signer, w.FeePolicy)
 
-	return builder.Build(utxos, outputs, changeAddress, payload)
+	return builder.Build(utxos, outputs, changeAddress, payload, feePolicy)
 }
 
 func (w Wallet) buildOutputs(outs []txbuilder.TxOutput) []txbuilder.PayAddress {
@@ -100,12 +119,7 @@ func (w Wallet) buildOutputs(outs []txbuilder.TxOutput) []txbuilder.PayAddress {
 
 	// create any other payments required. There may be >= 0 payments here.
 	for _, out := range outs {
-		outAddress, err := txbuilder.GetAddressFromString(out.Address.EncodeAddress())
-		if err != nil {
-			continue
-		}
-
-		payment := txbuilder.NewPayAddress(outAddress, out.Value)
+		payment := txbuilder.NewPayAddress(out.Address, out.Value)
 		outputs = append(outputs, payment)
 	}
 
@@ -5,15 +5,15 @@ import (
 	"github.com/tokenized/smart-contract/pkg/txbuilder"
 	"github.com/tokenized/smart-contract/pkg/wire"
 
-	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcutil"
 )
 
 type WalletInterface interface {
-	Get(string) (*btcec.PrivateKey, error)
-	BuildTX(*btcec.PrivateKey,
+	Get(string) (txbuilder.Signer, error)
+	BuildTX(txbuilder.Signer,
 		txbuilder.UTXOs,
 		[]txbuilder.TxOutput,
 		btcutil.Address,
-		protocol.OpReturnMessage) (*wire.MsgTx, error)
+		protocol.OpReturnMessage,
+		*txbuilder.FeePolicy) (*wire.MsgTx, *txbuilder.BuildReport, error)
 }
@@ -5,33 +5,106 @@ package broadcaster
  *
  * What is my purpose?
  * - You broadcast responses
+ * - You make sure responses are not lost before they are confirmed
+ * - You refuse to broadcast responses that do not spend their inputs
  */
 
 import (
 	"context"
 
 	"github.com/tokenized/smart-contract/internal/app/network"
+	"github.com/tokenized/smart-contract/internal/outbox"
+	"github.com/tokenized/smart-contract/pkg/txbuilder"
+	"github.com/tokenized/smart-contract/pkg/txscript"
 	"github.com/tokenized/smart-contract/pkg/wire"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 )
 
 type BroadcastService struct {
-	Network network.NetworkInterface
+	Network  network.NetworkInterface
+	Outbox   outbox.OutboxService
+	Builder  txbuilder.UTXOSetBuilder
+	SigCache *txscript.SigCache
 }
 
-func NewBroadcastService(network network.NetworkInterface) BroadcastService {
+func NewBroadcastService(network network.NetworkInterface,
+	outbox outbox.OutboxService,
+	cache *txbuilder.TxCache,
+	sigCache *txscript.SigCache) BroadcastService {
+
 	return BroadcastService{
-		Network: network,
+		Network:  network,
+		Outbox:   outbox,
+		Builder:  txbuilder.NewUTXOSetBuilder(cache),
+		SigCache: sigCache,
+	}
+}
+
+// Record keeps a response to a request in the outbox. This must happen
+// before any state for the response is written, so the response can be
+// sent later if sending fails.
+//
+// A response that fails verification is not recorded.
+func (s BroadcastService) Record(ctx context.Context,
+	request chainhash.Hash,
+	contractID string,
+	tx *wire.MsgTx) error {
+
+	if err := s.verify(tx); err != nil {
+		return err
 	}
+
+	_, err := s.Outbox.Add(ctx, request, contractID, tx)
+
+	return err
 }
 
+// Announce sends a tx to the network through the outbox, recording it
+// first if needed. If sending fails, or the tx is not accepted yet, the
+// outbox retries it.
+//
+// A tx that was not recorded is verified first.
 func (s BroadcastService) Announce(ctx context.Context,
 	tx *wire.MsgTx) (*chainhash.Hash, error) {
-	hash, err := s.Network.SendTX(ctx, tx)
+
+	hash := tx.TxHash()
+
+	_, err := s.Outbox.Read(ctx, hash.String())
+	if err == outbox.ErrEntryNotFound {
+		if err := s.verify(tx); err != nil {
+			return nil, err
+		}
+
+		_, err = s.Outbox.Add(ctx, chainhash.Hash{}, "", tx)
+	}
+
 	if err != nil {
 		return nil, err
 	}
 
-	return hash, nil
+	// a tx the peer has not accepted yet is sent again by the outbox
+	if err := s.Outbox.Send(ctx, hash); err != nil && err != network.ErrNotAccepted {
+		return nil, err
+	}
+
+	return &hash, nil
+}
+
+// Cancel stops a recorded tx from being sent, by the outbox or by the peer
+// node, which may have announced it already.
+func (s BroadcastService) Cancel(ctx context.Context, hash chainhash.Hash) error {
+	s.Network.CancelTX(ctx, &hash)
+
+	return s.Outbox.Cancel(ctx, hash)
+}
+
+// verify executes the scripts of a tx against the outputs it spends.
+func (s BroadcastService) verify(tx *wire.MsgTx) error {
+	spent, err := s.Builder.Build(tx)
+	if err != nil {
+		return err
+	}
+
+	return txbuilder.VerifyWithCache(tx, spent, s.SigCache)
 }
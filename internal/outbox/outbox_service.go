@@ -0,0 +1,416 @@
+package outbox
+
+/**
+ * Outbox Kit
+ *
+ * What is my purpose?
+ * - You keep every response until it is confirmed in a block, and a while
+ *   after
+ * - You retry responses the network has not accepted
+ * - You tell me what is still waiting to be confirmed
+ */
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tokenized/smart-contract/internal/app/logger"
+	"github.com/tokenized/smart-contract/pkg/storage"
+	"github.com/tokenized/smart-contract/pkg/wire"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+const (
+	OutboxPrefix = "outbox"
+
+	// StatusPending is a tx that has not been accepted by the network.
+	StatusPending = "pending"
+
+	// StatusSent is a tx accepted by the network, but not seen in a block.
+	StatusSent = "sent"
+
+	// StatusConfirmed is a tx that has been seen in a block.
+	StatusConfirmed = "confirmed"
+
+	// StatusCancelled is a tx that must not be sent.
+	StatusCancelled = "cancelled"
+
+	// minBackoff is the wait before retrying a tx that failed to send.
+	// The wait doubles with each failure, up to maxBackoff.
+	minBackoff = time.Second * 30
+	maxBackoff = time.Hour
+
+	// resendInterval is the wait before sending a tx that was accepted
+	// again, if it has not been confirmed.
+	resendInterval = time.Minute * 30
+
+	// retryInterval is how often due txs are retried.
+	retryInterval = time.Minute
+
+	// pruneAfter is how long an Entry is kept once it is done, so a tx
+	// that is announced again soon after is still known.
+	pruneAfter = time.Hour * 24
+)
+
+var ErrEntryNotFound = errors.New("Outbox entry not found")
+
+// Sender sends a tx to the network. An error is returned if the tx has not
+// been accepted, even if it was announced, so the Entry stays pending and
+// is sent again.
+type Sender interface {
+	SendTX(context.Context, *wire.MsgTx) (*chainhash.Hash, error)
+}
+
+// Entry is a tx held in the outbox.
+type Entry struct {
+	Hash        string `json:"hash"`
+	Request     string `json:"request"`
+	ContractID  string `json:"contract_id"`
+	TX          string `json:"tx"`
+	Status      string `json:"status"`
+	Attempts    int    `json:"attempts"`
+	CreatedAt   int64  `json:"created_at"`
+	LastAttempt int64  `json:"last_attempt"`
+	NextAttempt int64  `json:"next_attempt"`
+	DoneAt      int64  `json:"done_at,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// MsgTx returns the tx held by the Entry.
+func (e Entry) MsgTx() (*wire.MsgTx, error) {
+	b, err := hex.DecodeString(e.TX)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := wire.MsgTx{}
+	if err := tx.Deserialize(bytes.NewReader(b)); err != nil {
+		return nil, err
+	}
+
+	return &tx, nil
+}
+
+// IsDone returns true if the Entry will not be sent again.
+func (e Entry) IsDone() bool {
+	return e.Status == StatusConfirmed || e.Status == StatusCancelled
+}
+
+type OutboxService struct {
+	Storage storage.Storage
+	Sender  Sender
+}
+
+func NewOutboxService(store storage.Storage, sender Sender) OutboxService {
+	return OutboxService{
+		Storage: store,
+		Sender:  sender,
+	}
+}
+
+// Add records a tx in the outbox, before it is sent.
+//
+// The request is the hash of the tx the response is for, and may be empty.
+func (s OutboxService) Add(ctx context.Context,
+	request chainhash.Hash,
+	contractID string,
+	tx *wire.MsgTx) (*Entry, error) {
+
+	var buf bytes.Buffer
+	if err := tx.Serialize(&buf); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UnixNano()
+
+	e := Entry{
+		Hash:        tx.TxHash().String(),
+		ContractID:  contractID,
+		TX:          hex.EncodeToString(buf.Bytes()),
+		Status:      StatusPending,
+		CreatedAt:   now,
+		NextAttempt: now,
+	}
+
+	if request != (chainhash.Hash{}) {
+		e.Request = request.String()
+	}
+
+	if err := s.write(ctx, e); err != nil {
+		return nil, err
+	}
+
+	return &e, nil
+}
+
+// Send sends the tx for an Entry, and records the outcome.
+//
+// A tx that fails to send is retried later, with backoff.
+func (s OutboxService) Send(ctx context.Context, hash chainhash.Hash) error {
+	e, err := s.Read(ctx, hash.String())
+	if err != nil {
+		return err
+	}
+
+	if e.IsDone() {
+		return nil
+	}
+
+	return s.send(ctx, *e, time.Now())
+}
+
+// Confirm marks the Entry for a tx as confirmed.
+func (s OutboxService) Confirm(ctx context.Context, hash chainhash.Hash) error {
+	return s.setStatus(ctx, hash, StatusConfirmed)
+}
+
+// Cancel marks the Entry for a tx so it is never sent.
+func (s OutboxService) Cancel(ctx context.Context, hash chainhash.Hash) error {
+	return s.setStatus(ctx, hash, StatusCancelled)
+}
+
+// ConfirmBlock confirms the Entries for any of the txs in a block. The txs
+// that were confirmed are returned.
+func (s OutboxService) ConfirmBlock(ctx context.Context,
+	txs []*wire.MsgTx) ([]*wire.MsgTx, error) {
+
+	entries, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := map[string]*wire.MsgTx{}
+	for _, tx := range txs {
+		hashes[tx.TxHash().String()] = tx
+	}
+
+	log := logger.NewLoggerFromContext(ctx).Sugar()
+
+	confirmed := []*wire.MsgTx{}
+
+	for _, e := range entries {
+		tx, ok := hashes[e.Hash]
+		if e.IsDone() || !ok {
+			continue
+		}
+
+		e.Status = StatusConfirmed
+		e.DoneAt = time.Now().UnixNano()
+		e.Error = ""
+
+		if err := s.write(ctx, e); err != nil {
+			return nil, err
+		}
+
+		confirmed = append(confirmed, tx)
+
+		log.Infof("Confirmed outbox tx %s", e.Hash)
+	}
+
+	return confirmed, nil
+}
+
+// Retry sends every tx that is due, that has not been confirmed.
+//
+// Entries done for longer than pruneAfter are removed, so the outbox only
+// grows with the txs still waiting.
+func (s OutboxService) Retry(ctx context.Context, now time.Time) error {
+	entries, err := s.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	log := logger.NewLoggerFromContext(ctx).Sugar()
+
+	for _, e := range entries {
+		if e.IsDone() {
+			if e.DoneAt > now.Add(-pruneAfter).UnixNano() {
+				continue
+			}
+
+			if err := s.Storage.Remove(ctx, s.buildPath(e.Hash)); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if e.NextAttempt > now.UnixNano() {
+			continue
+		}
+
+		if err := s.send(ctx, e, now); err != nil {
+			log.Errorf("Failed to send outbox tx %s : %v", e.Hash, err)
+		}
+	}
+
+	return nil
+}
+
+// SendAll sends every tx that has not been confirmed, whether it is due or
+// not.
+func (s OutboxService) SendAll(ctx context.Context) error {
+	entries, err := s.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		if e.IsDone() {
+			continue
+		}
+
+		if err := s.send(ctx, e, time.Now()); err != nil {
+			return fmt.Errorf("%v : %v", e.Hash, err)
+		}
+	}
+
+	return nil
+}
+
+// Run retries due txs until the Context is done.
+//
+// This is a blocking function, so it should be run in a goroutine.
+func (s OutboxService) Run(ctx context.Context) {
+	log := logger.NewLoggerFromContext(ctx).Sugar()
+
+	ticker := time.NewTicker(retryInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Retry(ctx, time.Now()); err != nil {
+			log.Errorf("Failed to retry outbox : %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Read returns the Entry for a tx hash.
+func (s OutboxService) Read(ctx context.Context, hash string) (*Entry, error) {
+	b, err := s.Storage.Read(ctx, s.buildPath(hash))
+	if err != nil {
+		if err == storage.ErrNotFound {
+			err = ErrEntryNotFound
+		}
+
+		return nil, err
+	}
+
+	e := Entry{}
+	if err := json.Unmarshal(b, &e); err != nil {
+		return nil, err
+	}
+
+	return &e, nil
+}
+
+// List returns all Entries.
+func (s OutboxService) List(ctx context.Context) ([]Entry, error) {
+	query := map[string]string{
+		"path": OutboxPrefix,
+	}
+
+	data, err := s.Storage.Search(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []Entry{}
+
+	for _, b := range data {
+		e := Entry{}
+		if err := json.Unmarshal(b, &e); err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
+
+// send sends the tx for an Entry, and writes the outcome.
+func (s OutboxService) send(ctx context.Context, e Entry, now time.Time) error {
+	tx, err := e.MsgTx()
+	if err != nil {
+		return err
+	}
+
+	e.Attempts++
+	e.LastAttempt = now.UnixNano()
+
+	_, sendErr := s.Sender.SendTX(ctx, tx)
+
+	if sendErr != nil {
+		e.Error = sendErr.Error()
+		e.NextAttempt = now.Add(backoff(e.Attempts)).UnixNano()
+	} else {
+		e.Status = StatusSent
+		e.Error = ""
+		e.NextAttempt = now.Add(resendInterval).UnixNano()
+	}
+
+	if err := s.write(ctx, e); err != nil {
+		return err
+	}
+
+	return sendErr
+}
+
+func (s OutboxService) setStatus(ctx context.Context,
+	hash chainhash.Hash,
+	status string) error {
+
+	e, err := s.Read(ctx, hash.String())
+	if err != nil {
+		return err
+	}
+
+	e.Status = status
+
+	if e.IsDone() {
+		e.DoneAt = time.Now().UnixNano()
+	}
+
+	return s.write(ctx, *e)
+}
+
+func (s OutboxService) write(ctx context.Context, e Entry) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	return s.Storage.Write(ctx, s.buildPath(e.Hash), b, nil)
+}
+
+func (s OutboxService) buildPath(hash string) string {
+	return fmt.Sprintf("%v/%v", OutboxPrefix, hash)
+}
+
+// backoff returns the wait before the next attempt, after the given
+// number of failed attempts.
+func backoff(attempts int) time.Duration {
+	d := minBackoff
+
+	for i := 1; i < attempts && d < maxBackoff; i++ {
+		d *= 2
+	}
+
+	if d > maxBackoff {
+		d = maxBackoff
+	}
+
+	return d
+}
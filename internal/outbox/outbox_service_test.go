@@ -0,0 +1,235 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/tokenized/smart-contract/pkg/storage"
+	"github.com/tokenized/smart-contract/pkg/wire"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+type mockSender struct {
+	err  error
+	sent []chainhash.Hash
+}
+
+func (m *mockSender) SendTX(ctx context.Context,
+	tx *wire.MsgTx) (*chainhash.Hash, error) {
+
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	hash := tx.TxHash()
+	m.sent = append(m.sent, hash)
+
+	return &hash, nil
+}
+
+func newTestOutbox(t *testing.T) (OutboxService, *mockSender, func()) {
+	root, err := ioutil.TempDir("", "outbox")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store := storage.NewFilesystemStorage(storage.NewConfig("", "", "", "standalone", root))
+	sender := &mockSender{}
+
+	return NewOutboxService(store, sender), sender, func() { os.RemoveAll(root) }
+}
+
+func newTestTx(value int64) *wire.MsgTx {
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{1}}, nil))
+	tx.AddTxOut(wire.NewTxOut(value, nil))
+
+	return tx
+}
+
+func TestOutboxService_Send(t *testing.T) {
+	ctx := context.Background()
+	box, sender, cleanup := newTestOutbox(t)
+	defer cleanup()
+
+	tx := newTestTx(1000)
+	hash := tx.TxHash()
+
+	if _, err := box.Add(ctx, chainhash.Hash{9}, "contract", tx); err != nil {
+		t.Fatal(err)
+	}
+
+	// the network does not accept the tx
+	sender.err = errors.New("rejected")
+
+	if err := box.Send(ctx, hash); err == nil {
+		t.Fatalf("got no error, want error")
+	}
+
+	e, err := box.Read(ctx, hash.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if e.Status != StatusPending || e.Attempts != 1 || e.Error != "rejected" {
+		t.Fatalf("got %+v, want pending with 1 failed attempt", e)
+	}
+
+	got := time.Duration(e.NextAttempt - e.LastAttempt)
+	if got != minBackoff {
+		t.Errorf("got backoff %v, want %v", got, minBackoff)
+	}
+
+	// not due yet
+	if err := box.Retry(ctx, time.Unix(0, e.LastAttempt)); err != nil {
+		t.Fatal(err)
+	}
+
+	sender.err = nil
+
+	if err := box.Retry(ctx, time.Unix(0, e.NextAttempt)); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(sender.sent) != 1 || sender.sent[0] != hash {
+		t.Fatalf("got sent %v, want %v", sender.sent, hash)
+	}
+
+	e, err = box.Read(ctx, hash.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if e.Status != StatusSent || e.Attempts != 2 {
+		t.Errorf("got %+v, want sent after 2 attempts", e)
+	}
+
+	msg, err := e.MsgTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if msg.TxHash() != hash {
+		t.Errorf("got tx %s, want %s", msg.TxHash(), hash)
+	}
+}
+
+func TestOutboxService_ConfirmBlock(t *testing.T) {
+	ctx := context.Background()
+	box, sender, cleanup := newTestOutbox(t)
+	defer cleanup()
+
+	confirmed := newTestTx(1000)
+	cancelled := newTestTx(900)
+	waiting := newTestTx(800)
+
+	for _, tx := range []*wire.MsgTx{confirmed, cancelled, waiting} {
+		if _, err := box.Add(ctx, chainhash.Hash{}, "", tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := box.Cancel(ctx, cancelled.TxHash()); err != nil {
+		t.Fatal(err)
+	}
+
+	txs, err := box.ConfirmBlock(ctx, []*wire.MsgTx{confirmed, cancelled})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(txs) != 1 || txs[0].TxHash() != confirmed.TxHash() {
+		t.Fatalf("got %d confirmed txs, want %s", len(txs), confirmed.TxHash())
+	}
+
+	if err := box.SendAll(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(sender.sent) != 1 || sender.sent[0] != waiting.TxHash() {
+		t.Fatalf("got sent %v, want %v", sender.sent, waiting.TxHash())
+	}
+
+	e, err := box.Read(ctx, confirmed.TxHash().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if e.Status != StatusConfirmed {
+		t.Errorf("got status %v, want %v", e.Status, StatusConfirmed)
+	}
+}
+
+func TestOutboxService_Retry_prune(t *testing.T) {
+	ctx := context.Background()
+	box, _, cleanup := newTestOutbox(t)
+	defer cleanup()
+
+	confirmed := newTestTx(1000)
+	cancelled := newTestTx(900)
+	waiting := newTestTx(800)
+
+	for _, tx := range []*wire.MsgTx{confirmed, cancelled, waiting} {
+		if _, err := box.Add(ctx, chainhash.Hash{}, "", tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := box.Cancel(ctx, cancelled.TxHash()); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := box.ConfirmBlock(ctx, []*wire.MsgTx{confirmed}); err != nil {
+		t.Fatal(err)
+	}
+
+	// done entries are kept for a while
+	if err := box.Retry(ctx, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := box.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 3 {
+		t.Fatalf("got %d entries, want 3", len(entries))
+	}
+
+	if err := box.Retry(ctx, time.Now().Add(pruneAfter+time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err = box.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 1 || entries[0].Hash != waiting.TxHash().String() {
+		t.Fatalf("got %+v, want only %s", entries, waiting.TxHash())
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, minBackoff},
+		{2, minBackoff * 2},
+		{4, minBackoff * 8},
+		{100, maxBackoff},
+	}
+
+	for _, tt := range tests {
+		if got := backoff(tt.attempts); got != tt.want {
+			t.Errorf("attempts %v : got %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,626 @@
+package pool
+
+/**
+ * Pool Service
+ *
+ * What is my purpose?
+ * - You keep the UTXOs a contract holds, from its own confirmed txs
+ * - You top up responses that a request did not pay enough for
+ * - You consolidate small UTXOs, so they stay worth spending
+ */
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/tokenized/smart-contract/internal/app/logger"
+	"github.com/tokenized/smart-contract/pkg/storage"
+	"github.com/tokenized/smart-contract/pkg/txbuilder"
+	"github.com/tokenized/smart-contract/pkg/txscript"
+	"github.com/tokenized/smart-contract/pkg/wire"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
+)
+
+const (
+	PoolPrefix = "pool"
+
+	// SmallValue is the value below which a UTXO is consolidated.
+	SmallValue = uint64(2000)
+
+	// ConsolidateCount is the number of small UTXOs a pool holds before
+	// they are consolidated.
+	ConsolidateCount = 20
+
+	// maxConsolidateInputs is the most UTXOs spent by one consolidation.
+	maxConsolidateInputs = 100
+)
+
+var (
+	// ErrTopUpLimit is returned when a tx draws more from the pool than
+	// it is allowed to.
+	ErrTopUpLimit = errors.New("Top up exceeds limit")
+)
+
+// UTXO is an output held in the pool.
+type UTXO struct {
+	Hash     string `json:"hash"`
+	Index    uint32 `json:"index"`
+	PkScript string `json:"pk_script"`
+	Value    uint64 `json:"value"`
+
+	// ReservedBy is the hash of the tx spending the UTXO, which has not
+	// been confirmed yet.
+	ReservedBy string `json:"reserved_by,omitempty"`
+}
+
+// TxBuilderUTXO returns the UTXO in the form the txbuilder spends.
+func (u UTXO) TxBuilderUTXO() (txbuilder.UTXO, error) {
+	hash, err := chainhash.NewHashFromStr(u.Hash)
+	if err != nil {
+		return txbuilder.UTXO{}, err
+	}
+
+	pkScript, err := hex.DecodeString(u.PkScript)
+	if err != nil {
+		return txbuilder.UTXO{}, err
+	}
+
+	return txbuilder.NewUTXO(*hash, u.Index, pkScript, u.Value), nil
+}
+
+// Pool is the set of UTXOs held by a contract address.
+type Pool struct {
+	Address string `json:"address"`
+	UTXOs   []UTXO `json:"utxos"`
+
+	// Chained are outputs of unconfirmed txs, not held yet, that are spent
+	// by another unconfirmed tx. They are held reserved when they are
+	// added.
+	Chained []UTXO `json:"chained,omitempty"`
+}
+
+// Balance is a summary of the value held in a Pool.
+type Balance struct {
+	Address   string
+	Available uint64
+	Reserved  uint64
+	Count     int
+	Small     int
+}
+
+// Balance returns the value held in the Pool.
+func (p Pool) Balance() Balance {
+	b := Balance{
+		Address: p.Address,
+		Count:   len(p.UTXOs),
+	}
+
+	for _, u := range p.UTXOs {
+		if len(u.ReservedBy) > 0 {
+			b.Reserved += u.Value
+			continue
+		}
+
+		b.Available += u.Value
+
+		if u.Value < SmallValue {
+			b.Small++
+		}
+	}
+
+	return b
+}
+
+type PoolService struct {
+	Storage storage.Storage
+	Params  *chaincfg.Params
+
+	// mu guards reads and writes of a Pool, which happen for requests and
+	// blocks in parallel.
+	mu *sync.Mutex
+}
+
+func NewPoolService(store storage.Storage,
+	params *chaincfg.Params) PoolService {
+
+	return PoolService{
+		Storage: store,
+		Params:  params,
+		mu:      &sync.Mutex{},
+	}
+}
+
+// Add adds the outputs of a tx that pay to the address.
+//
+// Only the outputs of txs sent by the contract are added, once they are
+// confirmed.
+func (s PoolService) Add(ctx context.Context,
+	address btcutil.Address,
+	tx *wire.MsgTx) error {
+
+	pkScript, err := txscript.PayToAddrScript(address)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, err := s.read(ctx, address.EncodeAddress())
+	if err != nil {
+		return err
+	}
+
+	hash := tx.TxHash().String()
+	added := 0
+
+	for i, out := range tx.TxOut {
+		if !bytes.Equal(out.PkScript, pkScript) || p.contains(hash, uint32(i)) {
+			continue
+		}
+
+		u := UTXO{
+			Hash:     hash,
+			Index:    uint32(i),
+			PkScript: hex.EncodeToString(out.PkScript),
+			Value:    uint64(out.Value),
+		}
+
+		if c := p.findChained(hash, uint32(i)); c >= 0 {
+			u.ReservedBy = p.Chained[c].ReservedBy
+			p.Chained = append(p.Chained[:c], p.Chained[c+1:]...)
+		}
+
+		p.UTXOs = append(p.UTXOs, u)
+		added++
+	}
+
+	if added == 0 {
+		return nil
+	}
+
+	return s.write(ctx, *p)
+}
+
+// Spend removes the UTXOs spent by any of the txs, from every Pool.
+func (s PoolService) Spend(ctx context.Context, txs []*wire.MsgTx) error {
+	spent := map[wire.OutPoint]bool{}
+
+	for _, tx := range txs {
+		for _, in := range tx.TxIn {
+			spent[in.PreviousOutPoint] = true
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pools, err := s.list(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range pools {
+		utxos := []UTXO{}
+
+		for _, u := range p.UTXOs {
+			hash, err := chainhash.NewHashFromStr(u.Hash)
+			if err != nil {
+				return err
+			}
+
+			if !spent[wire.OutPoint{Hash: *hash, Index: u.Index}] {
+				utxos = append(utxos, u)
+			}
+		}
+
+		chained := []UTXO{}
+
+		for _, u := range p.Chained {
+			hash, err := chainhash.NewHashFromStr(u.Hash)
+			if err != nil {
+				return err
+			}
+
+			if !spent[wire.OutPoint{Hash: *hash, Index: u.Index}] {
+				chained = append(chained, u)
+			}
+		}
+
+		if len(utxos) == len(p.UTXOs) && len(chained) == len(p.Chained) {
+			continue
+		}
+
+		p.UTXOs = utxos
+		p.Chained = chained
+
+		if err := s.write(ctx, p); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Available returns the UTXOs of the address that are not reserved,
+// oldest first.
+func (s PoolService) Available(ctx context.Context,
+	address btcutil.Address) (txbuilder.UTXOs, error) {
+
+	p, err := s.Read(ctx, address.EncodeAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	utxos := txbuilder.UTXOs{}
+
+	for _, u := range p.UTXOs {
+		if len(u.ReservedBy) > 0 {
+			continue
+		}
+
+		utxo, err := u.TxBuilderUTXO()
+		if err != nil {
+			return nil, err
+		}
+
+		utxos = append(utxos, utxo)
+	}
+
+	return utxos, nil
+}
+
+// Reserve marks the UTXOs of the address spent by a tx, so they are not
+// spent by another tx before it is confirmed.
+//
+// The value drawn from the pool is what the tx spends from it, less what
+// the tx pays back to the address. If that is more than max, ErrTopUpLimit
+// is returned and nothing is reserved.
+func (s PoolService) Reserve(ctx context.Context,
+	address btcutil.Address,
+	tx *wire.MsgTx,
+	max uint64) error {
+
+	pkScript, err := txscript.PayToAddrScript(address)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, err := s.read(ctx, address.EncodeAddress())
+	if err != nil {
+		return err
+	}
+
+	hash := tx.TxHash().String()
+
+	spent := uint64(0)
+	reserved := 0
+
+	for _, in := range tx.TxIn {
+		i := p.find(in.PreviousOutPoint.Hash.String(), in.PreviousOutPoint.Index)
+		if i < 0 {
+			continue
+		}
+
+		if r := p.UTXOs[i].ReservedBy; len(r) > 0 && r != hash {
+			return fmt.Errorf("UTXO %v is reserved by %v", in.PreviousOutPoint, r)
+		}
+
+		spent += p.UTXOs[i].Value
+		p.UTXOs[i].ReservedBy = hash
+		reserved++
+	}
+
+	if reserved == 0 {
+		return nil
+	}
+
+	returned := uint64(0)
+	for _, out := range tx.TxOut {
+		if bytes.Equal(out.PkScript, pkScript) {
+			returned += uint64(out.Value)
+		}
+	}
+
+	if spent > returned && spent-returned > max {
+		return ErrTopUpLimit
+	}
+
+	return s.write(ctx, *p)
+}
+
+// ReserveChained reserves the UTXOs of the address spent by a tx, that are
+// outputs of another tx of the contract which has not been confirmed yet.
+//
+// The UTXOs are not held until the tx paying them is confirmed. They are
+// held reserved by the tx from then, so they are not spent twice.
+func (s PoolService) ReserveChained(ctx context.Context,
+	address btcutil.Address,
+	utxos txbuilder.UTXOs,
+	tx *wire.MsgTx) error {
+
+	spent := map[wire.OutPoint]bool{}
+	for _, in := range tx.TxIn {
+		spent[in.PreviousOutPoint] = true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, err := s.read(ctx, address.EncodeAddress())
+	if err != nil {
+		return err
+	}
+
+	hash := tx.TxHash().String()
+	reserved := 0
+
+	for _, utxo := range utxos {
+		if !spent[wire.OutPoint{Hash: utxo.Hash, Index: utxo.Index}] {
+			continue
+		}
+
+		u := UTXO{
+			Hash:       utxo.Hash.String(),
+			Index:      utxo.Index,
+			PkScript:   hex.EncodeToString(utxo.PkScript),
+			Value:      utxo.Value,
+			ReservedBy: hash,
+		}
+
+		// confirmed already
+		if i := p.find(u.Hash, u.Index); i >= 0 {
+			if r := p.UTXOs[i].ReservedBy; len(r) > 0 && r != hash {
+				return fmt.Errorf("UTXO %s:%d is reserved by %v", u.Hash, u.Index, r)
+			}
+
+			p.UTXOs[i].ReservedBy = hash
+			reserved++
+			continue
+		}
+
+		if c := p.findChained(u.Hash, u.Index); c >= 0 {
+			if r := p.Chained[c].ReservedBy; r != hash {
+				return fmt.Errorf("UTXO %s:%d is reserved by %v", u.Hash, u.Index, r)
+			}
+
+			continue
+		}
+
+		p.Chained = append(p.Chained, u)
+		reserved++
+	}
+
+	if reserved == 0 {
+		return nil
+	}
+
+	return s.write(ctx, *p)
+}
+
+// Release frees the UTXOs reserved by a tx that will not be sent.
+func (s PoolService) Release(ctx context.Context, hash chainhash.Hash) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pools, err := s.list(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range pools {
+		released := 0
+
+		for i, u := range p.UTXOs {
+			if u.ReservedBy == hash.String() {
+				p.UTXOs[i].ReservedBy = ""
+				released++
+			}
+		}
+
+		chained := []UTXO{}
+		for _, u := range p.Chained {
+			if u.ReservedBy == hash.String() {
+				released++
+				continue
+			}
+
+			chained = append(chained, u)
+		}
+
+		p.Chained = chained
+
+		if released == 0 {
+			continue
+		}
+
+		if err := s.write(ctx, p); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Consolidate returns a signed tx that spends the small UTXOs of the
+// address back to it, if the pool holds at least ConsolidateCount of them.
+// Otherwise nil is returned.
+//
+// The UTXOs spent are reserved. The tx must be sent, or released if it is
+// not.
+func (s PoolService) Consolidate(ctx context.Context,
+	address btcutil.Address,
+	signer txbuilder.Signer,
+	policy txbuilder.FeePolicy) (*wire.MsgTx, error) {
+
+	available, err := s.Available(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	inputFee := policy.InputFee()
+
+	spendable := []*txbuilder.TxOutput{}
+	for _, u := range available {
+		// UTXOs that cost more to spend than they hold are left alone
+		if u.Value >= SmallValue || u.Value <= inputFee {
+			continue
+		}
+
+		spendable = append(spendable, &txbuilder.TxOutput{
+			PkScript:        u.PkScript,
+			Value:           u.Value,
+			TransactionHash: u.Hash.CloneBytes(),
+			Index:           u.Index,
+		})
+	}
+
+	if len(spendable) < ConsolidateCount {
+		return nil, nil
+	}
+
+	selector := txbuilder.OldestFirst{
+		MaxInputs: maxConsolidateInputs,
+	}
+
+	tx, _, err := txbuilder.BuildUnsignedWithTxOuts(nil, spendable, address, policy, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	ptx, err := tx.PartialTx()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := ptx.SignWith(s.Params, signer); err != nil {
+		return nil, err
+	}
+
+	signed, err := ptx.Finalize(s.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	// nothing is drawn from the pool, other than the fee
+	if err := s.Reserve(ctx, address, signed, tx.Selection.Fee); err != nil {
+		return nil, err
+	}
+
+	log := logger.NewLoggerFromContext(ctx).Sugar()
+	log.Infof("Consolidating %d UTXOs of %s in tx %s",
+		len(signed.TxIn), address.EncodeAddress(), signed.TxHash())
+
+	return signed, nil
+}
+
+// Read returns the Pool for an address. A Pool with no UTXOs is returned
+// if the address has none.
+func (s PoolService) Read(ctx context.Context, address string) (*Pool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.read(ctx, address)
+}
+
+// List returns every Pool.
+func (s PoolService) List(ctx context.Context) ([]Pool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list(ctx)
+}
+
+func (s PoolService) read(ctx context.Context, address string) (*Pool, error) {
+	b, err := s.Storage.Read(ctx, s.buildPath(address))
+	if err != nil {
+		if err == storage.ErrNotFound {
+			return &Pool{Address: address}, nil
+		}
+
+		return nil, err
+	}
+
+	p := Pool{}
+	if err := json.Unmarshal(b, &p); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+func (s PoolService) list(ctx context.Context) ([]Pool, error) {
+	query := map[string]string{
+		"path": PoolPrefix,
+	}
+
+	data, err := s.Storage.Search(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	pools := []Pool{}
+
+	for _, b := range data {
+		p := Pool{}
+		if err := json.Unmarshal(b, &p); err != nil {
+			return nil, err
+		}
+
+		pools = append(pools, p)
+	}
+
+	return pools, nil
+}
+
+func (s PoolService) write(ctx context.Context, p Pool) error {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	return s.Storage.Write(ctx, s.buildPath(p.Address), b, nil)
+}
+
+func (s PoolService) buildPath(address string) string {
+	return fmt.Sprintf("%v/%v", PoolPrefix, address)
+}
+
+// find returns the index of a UTXO in the Pool, or -1 if it is not held.
+func (p Pool) find(hash string, index uint32) int {
+	for i, u := range p.UTXOs {
+		if u.Hash == hash && u.Index == index {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// findChained returns the index of a chained UTXO in the Pool, or -1 if it
+// is not reserved.
+func (p Pool) findChained(hash string, index uint32) int {
+	for i, u := range p.Chained {
+		if u.Hash == hash && u.Index == index {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// contains returns true if the Pool holds the UTXO.
+func (p Pool) contains(hash string, index uint32) bool {
+	return p.find(hash, index) >= 0
+}
@@ -0,0 +1,345 @@
+package pool
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/tokenized/smart-contract/pkg/storage"
+	"github.com/tokenized/smart-contract/pkg/txbuilder"
+	"github.com/tokenized/smart-contract/pkg/txscript"
+	"github.com/tokenized/smart-contract/pkg/wire"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
+)
+
+func newTestPool(t *testing.T) (PoolService, func()) {
+	root, err := ioutil.TempDir("", "pool")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store := storage.NewFilesystemStorage(storage.NewConfig("", "", "", "standalone", root))
+
+	return NewPoolService(store, &chaincfg.MainNetParams), func() { os.RemoveAll(root) }
+}
+
+func newTestKey(t *testing.T) (*btcec.PrivateKey, btcutil.Address) {
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	address, err := btcutil.NewAddressPubKeyHash(
+		btcutil.Hash160(key.PubKey().SerializeCompressed()), &chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return key, address
+}
+
+// newTestTx returns a tx paying each of the values to the address.
+func newTestTx(t *testing.T, address btcutil.Address, values ...int64) *wire.MsgTx {
+	pkScript, err := txscript.PayToAddrScript(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{1}}, nil))
+
+	for _, v := range values {
+		tx.AddTxOut(wire.NewTxOut(v, pkScript))
+	}
+
+	return tx
+}
+
+// newTestSpend returns a tx spending the outputs of tx, paying back value
+// to the address.
+func newTestSpend(t *testing.T,
+	tx *wire.MsgTx,
+	address btcutil.Address,
+	value int64) *wire.MsgTx {
+
+	spend := newTestTx(t, address, value)
+	spend.TxIn = nil
+
+	hash := tx.TxHash()
+	for i := range tx.TxOut {
+		spend.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: hash, Index: uint32(i)}, nil))
+	}
+
+	return spend
+}
+
+func TestPoolService_Spend(t *testing.T) {
+	ctx := context.Background()
+	pool, cleanup := newTestPool(t)
+	defer cleanup()
+
+	_, address := newTestKey(t)
+	_, other := newTestKey(t)
+
+	tx := newTestTx(t, address, 1000, 2000)
+	tx.AddTxOut(newTestTx(t, other, 3000).TxOut[0])
+
+	if err := pool.Add(ctx, address, tx); err != nil {
+		t.Fatal(err)
+	}
+
+	// adding the same tx again holds the UTXOs once
+	if err := pool.Add(ctx, address, tx); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := pool.Read(ctx, address.EncodeAddress())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if b := p.Balance(); b.Available != 3000 || b.Count != 2 || b.Small != 1 {
+		t.Fatalf("got balance %+v, want 3000 in 2 UTXOs", b)
+	}
+
+	spend := newTestSpend(t, tx, other, 2500)
+	spend.TxIn = spend.TxIn[1:]
+
+	if err := pool.Spend(ctx, []*wire.MsgTx{spend}); err != nil {
+		t.Fatal(err)
+	}
+
+	utxos, err := pool.Available(ctx, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(utxos) != 1 || utxos[0].Value != 1000 {
+		t.Fatalf("got UTXOs %+v, want 1000", utxos)
+	}
+}
+
+func TestPoolService_Reserve(t *testing.T) {
+	ctx := context.Background()
+	pool, cleanup := newTestPool(t)
+	defer cleanup()
+
+	_, address := newTestKey(t)
+
+	tx := newTestTx(t, address, 4000, 6000)
+	if err := pool.Add(ctx, address, tx); err != nil {
+		t.Fatal(err)
+	}
+
+	// draws 10000 - 7000 = 3000 from the pool
+	spend := newTestSpend(t, tx, address, 7000)
+
+	if err := pool.Reserve(ctx, address, spend, 2999); err != ErrTopUpLimit {
+		t.Fatalf("got err %v, want %v", err, ErrTopUpLimit)
+	}
+
+	utxos, err := pool.Available(ctx, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(utxos) != 2 {
+		t.Fatalf("got %d available UTXOs, want 2", len(utxos))
+	}
+
+	if err := pool.Reserve(ctx, address, spend, 3000); err != nil {
+		t.Fatal(err)
+	}
+
+	utxos, err = pool.Available(ctx, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(utxos) != 0 {
+		t.Fatalf("got %d available UTXOs, want 0", len(utxos))
+	}
+
+	// another tx can not spend the reserved UTXOs
+	if err := pool.Reserve(ctx, address, newTestSpend(t, tx, address, 9000), 10000); err == nil {
+		t.Fatal("reserved UTXOs twice")
+	}
+
+	if err := pool.Release(ctx, spend.TxHash()); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := pool.Read(ctx, address.EncodeAddress())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if b := p.Balance(); b.Available != 10000 || b.Reserved != 0 {
+		t.Fatalf("got balance %+v, want 10000 available", b)
+	}
+}
+
+func TestPoolService_Consolidate(t *testing.T) {
+	ctx := context.Background()
+	pool, cleanup := newTestPool(t)
+	defer cleanup()
+
+	key, address := newTestKey(t)
+	policy := txbuilder.DefaultFeePolicy()
+
+	values := []int64{}
+	for i := 0; i < ConsolidateCount-1; i++ {
+		values = append(values, 1000)
+	}
+
+	// worth less than the fee to spend it, so it is left alone
+	values = append(values, int64(policy.InputFee()))
+
+	if err := pool.Add(ctx, address, newTestTx(t, address, values...)); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err := pool.Consolidate(ctx, address, txbuilder.NewKeySigner(key), policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tx != nil {
+		t.Fatalf("consolidated %d UTXOs, want none", len(tx.TxIn))
+	}
+
+	if err := pool.Add(ctx, address, newTestTx(t, address, 1500, 50000)); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err = pool.Consolidate(ctx, address, txbuilder.NewKeySigner(key), policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tx == nil {
+		t.Fatal("no consolidation")
+	}
+
+	if len(tx.TxIn) != ConsolidateCount || len(tx.TxOut) != 1 {
+		t.Fatalf("got %d inputs and %d outputs, want %d and 1",
+			len(tx.TxIn), len(tx.TxOut), ConsolidateCount)
+	}
+
+	p, err := pool.Read(ctx, address.EncodeAddress())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := uint64((ConsolidateCount-1)*1000 + 1500)
+	if b := p.Balance(); b.Reserved != want {
+		t.Fatalf("got %d reserved, want %d", b.Reserved, want)
+	}
+}
+
+func TestPoolService_ReserveChained(t *testing.T) {
+	ctx := context.Background()
+	pool, cleanup := newTestPool(t)
+	defer cleanup()
+
+	_, address := newTestKey(t)
+	_, holder := newTestKey(t)
+
+	// the first batch pays its change to the contract, and the second
+	// batch spends it before either is confirmed
+	first := newTestTx(t, holder, 546)
+	first.AddTxOut(newTestTx(t, address, 5000).TxOut[0])
+
+	second := newTestSpend(t, first, holder, 546)
+	second.TxIn = second.TxIn[1:]
+
+	utxos := txbuilder.UTXOs{
+		txbuilder.NewUTXO(first.TxHash(), 1, first.TxOut[1].PkScript, 5000),
+	}
+
+	if err := pool.ReserveChained(ctx, address, utxos, second); err != nil {
+		t.Fatal(err)
+	}
+
+	// the batches confirm in separate blocks
+	if err := pool.Add(ctx, address, first); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := pool.Spend(ctx, []*wire.MsgTx{first}); err != nil {
+		t.Fatal(err)
+	}
+
+	available, err := pool.Available(ctx, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(available) != 0 {
+		t.Fatalf("got %d available UTXOs, want the change reserved", len(available))
+	}
+
+	p, err := pool.Read(ctx, address.EncodeAddress())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if b := p.Balance(); b.Reserved != 5000 || len(p.Chained) != 0 {
+		t.Fatalf("got balance %+v and %d chained, want 5000 reserved", b, len(p.Chained))
+	}
+
+	if err := pool.Spend(ctx, []*wire.MsgTx{second}); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err = pool.Read(ctx, address.EncodeAddress())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(p.UTXOs) != 0 {
+		t.Fatalf("got %d UTXOs, want none", len(p.UTXOs))
+	}
+}
+
+func TestPoolService_ReserveChained_release(t *testing.T) {
+	ctx := context.Background()
+	pool, cleanup := newTestPool(t)
+	defer cleanup()
+
+	_, address := newTestKey(t)
+
+	first := newTestTx(t, address, 5000)
+	second := newTestSpend(t, first, address, 4000)
+
+	utxos := txbuilder.UTXOs{
+		txbuilder.NewUTXO(first.TxHash(), 0, first.TxOut[0].PkScript, 5000),
+	}
+
+	if err := pool.ReserveChained(ctx, address, utxos, second); err != nil {
+		t.Fatal(err)
+	}
+
+	// the second tx is not sent, so the change is free once it confirms
+	if err := pool.Release(ctx, second.TxHash()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := pool.Add(ctx, address, first); err != nil {
+		t.Fatal(err)
+	}
+
+	available, err := pool.Available(ctx, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(available) != 1 || available[0].Value != 5000 {
+		t.Fatalf("got UTXOs %+v, want 5000", available)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcutil"
 	"github.com/tokenized/smart-contract/internal/app/state/contract"
 	"github.com/tokenized/smart-contract/pkg/protocol"
@@ -49,6 +50,7 @@ func TestHandleAssetDefinition(t *testing.T) {
 		senders:  senders,
 		contract: c,
 		m:        &m,
+		params:   &chaincfg.MainNetParams,
 	}
 
 	// the test
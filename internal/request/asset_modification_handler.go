@@ -61,35 +61,24 @@ func (h assetModificationHandler) handle(ctx context.Context,
 
 // buildOutputs
 //
-// 0 : Contract's Public Address (change, added later when calculated)
+// 0 : Contract's Public Address (546)
 // 1 : Issuer's Public Address (546)
-// 2 : Contract Fee Address (fee amount)
-// 3 : OP_RETURN (Asset Creation, 0 sats)
+// 2 : Contract Fee Address (fee amount, optional)
+// 3 : Change, if any
+// 4 : OP_RETURN (Asset Creation, 0 sats)
 func (h assetModificationHandler) buildOutputs(r contractRequest) ([]txbuilder.TxOutput, error) {
-	contractAddress, err := r.contract.Address()
+	contractAddress, err := r.contract.Address(r.params)
 	if err != nil {
 		return nil, err
 	}
 
-	outs := []txbuilder.TxOutput{
-		txbuilder.TxOutput{
-			Address: contractAddress,
-			Value:   dustLimit,
-		},
-		txbuilder.TxOutput{
-			Address: r.senders[0],
-			Value:   dustLimit, // any change will be added to this output value
-		},
-	}
+	layout := newResponseLayout(protocol.CodeAssetCreation).
+		notify(roleContract, contractAddress).
+		notify(roleIssuer, r.senders[0])
 
 	if h.Fee.Value > 0 {
-		feeOutput := txbuilder.TxOutput{
-			Address: h.Fee.Address,
-			Value:   h.Fee.Value,
-		}
-
-		outs = append(outs, feeOutput)
+		layout.pay(roleFee, h.Fee.Address, h.Fee.Value)
 	}
 
-	return outs, nil
+	return layout.build()
 }
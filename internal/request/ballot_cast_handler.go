@@ -29,7 +29,8 @@ func (h ballotCastHandler) handle(ctx context.Context,
 	key := string(ballotCast.VoteTxnID)
 	vote, ok := c.Votes[key]
 	if !ok {
-		return nil, errors.New("Vote not found")
+		return nil, protocol.NewRejectionReason(protocol.RejectionCodeVoteNotFound,
+			"vote_txn_id", "vote %x not found", ballotCast.VoteTxnID)
 	}
 
 	ballot := contract.NewBallotFromBallotCast(r.senders[0], ballotCast)
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcutil"
 	"github.com/tokenized/smart-contract/internal/app/state/contract"
 	"github.com/tokenized/smart-contract/pkg/protocol"
@@ -96,6 +97,7 @@ func TestBallotCastHandler_handle(t *testing.T) {
 		senders:   senders,
 		receivers: receivers,
 		m:         &ballotCast,
+		params:    &chaincfg.MainNetParams,
 	}
 
 	h := newBallotCastHandler()
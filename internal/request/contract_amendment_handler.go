@@ -60,13 +60,6 @@ func (h contractAmendmentHandler) handle(ctx context.Context,
 		return nil, err
 	}
 
-	// outs := []txbuilder.TxOutput{
-	// 	txbuilder.TxOutput{
-	// 		Address: r.sender,
-	// 		Value:   dustLimit,
-	// 	},
-	// }
-
 	resp := contractResponse{
 		Contract: contract,
 		Message:  &cf,
@@ -77,30 +70,18 @@ func (h contractAmendmentHandler) handle(ctx context.Context,
 }
 
 func (h contractAmendmentHandler) buildOutputs(r contractRequest) ([]txbuilder.TxOutput, error) {
-	contractAddress, err := r.contract.Address()
+	contractAddress, err := r.contract.Address(r.params)
 	if err != nil {
 		return nil, err
 	}
 
-	outs := []txbuilder.TxOutput{
-		txbuilder.TxOutput{
-			Address: contractAddress,
-			Value:   dustLimit,
-		},
-		txbuilder.TxOutput{
-			Address: r.senders[0],
-			Value:   dustLimit, // any change will be added to this output value
-		},
-	}
+	layout := newResponseLayout(protocol.CodeContractFormation).
+		notify(roleContract, contractAddress).
+		notify(roleIssuer, r.senders[0])
 
 	if h.Fee.Value > 0 {
-		feeOutput := txbuilder.TxOutput{
-			Address: h.Fee.Address,
-			Value:   h.Fee.Value,
-		}
-
-		outs = append(outs, feeOutput)
+		layout.pay(roleFee, h.Fee.Address, h.Fee.Value)
 	}
 
-	return outs, nil
+	return layout.build()
 }
@@ -62,30 +62,18 @@ func (h contractOfferHandler) handle(ctx context.Context,
 }
 
 func (h contractOfferHandler) buildOutputs(r contractRequest) ([]txbuilder.TxOutput, error) {
-	contractAddress, err := r.contract.Address()
+	contractAddress, err := r.contract.Address(r.params)
 	if err != nil {
 		return nil, err
 	}
 
-	outs := []txbuilder.TxOutput{
-		txbuilder.TxOutput{
-			Address: contractAddress,
-			Value:   dustLimit,
-		},
-		txbuilder.TxOutput{
-			Address: r.senders[0],
-			Value:   dustLimit, // any change will be added to this output value
-		},
-	}
+	layout := newResponseLayout(protocol.CodeContractFormation).
+		notify(roleContract, contractAddress).
+		notify(roleIssuer, r.senders[0])
 
 	if h.Fee.Value > 0 {
-		feeOutput := txbuilder.TxOutput{
-			Address: h.Fee.Address,
-			Value:   h.Fee.Value,
-		}
-
-		outs = append(outs, feeOutput)
+		layout.pay(roleFee, h.Fee.Address, h.Fee.Value)
 	}
 
-	return outs, nil
+	return layout.build()
 }
@@ -3,11 +3,8 @@ package request
 import (
 	"context"
 	"errors"
-	"fmt"
 	"time"
 
-	"github.com/btcsuite/btcd/chaincfg"
-	"github.com/btcsuite/btcutil"
 	"github.com/tokenized/smart-contract/internal/app/config"
 	"github.com/tokenized/smart-contract/internal/app/logger"
 	"github.com/tokenized/smart-contract/pkg/protocol"
@@ -39,12 +36,14 @@ func (h exchangeHandler) handle(ctx context.Context,
 	assetKey := string(exchange.Party1AssetID)
 	asset, ok := c.Assets[assetKey]
 	if !ok {
-		return nil, fmt.Errorf("exchange : Asset ID not found : contract=%s assetID=%s", c.ID, exchange.Party1AssetID)
+		return nil, protocol.NewRejectionReason(protocol.RejectionCodeAssetNotFound,
+			"party1_asset_id", "asset %s not found", assetKey)
 	}
 
 	// Bounds check for receivers - contract, party1, party2
 	if len(r.receivers) < 3 {
-		return nil, fmt.Errorf("Missing receivers")
+		return nil, protocol.NewRejectionReason(protocol.RejectionCodeReceiverUnspecified,
+			"", "%d outputs, want 3", len(r.receivers))
 	}
 
 	// Locate Balance for Party 1
@@ -52,7 +51,8 @@ func (h exchangeHandler) handle(ctx context.Context,
 	party1Key := party1Address.Address.EncodeAddress()
 	party1Holding, ok := asset.Holdings[party1Key]
 	if !ok {
-		return nil, fmt.Errorf("exchange : holding not found contract=%s assetID=%s party1=%s", c.ID, assetKey, party1Key)
+		return nil, protocol.NewRejectionReason(protocol.RejectionCodeInsufficientAssets,
+			"party1_token_qty", "%s holds none of asset %s", party1Key, assetKey)
 	}
 	party1Balance := party1Holding.Balance
 
@@ -67,7 +67,9 @@ func (h exchangeHandler) handle(ctx context.Context,
 
 	// Check the token balance
 	if party1Balance < exchange.Party1TokenQty {
-		return nil, fmt.Errorf("exchange : insufficient assets contract=%s assetID=%s party1=%s", c.ID, assetKey, party1Key)
+		return nil, protocol.NewRejectionReason(protocol.RejectionCodeInsufficientAssets,
+			"party1_token_qty", "holding %d is less than %d",
+			party1Balance, exchange.Party1TokenQty)
 	}
 
 	logger := logger.NewLoggerFromContext(ctx).Sugar()
@@ -116,10 +118,11 @@ func (h exchangeHandler) handle(ctx context.Context,
 }
 
 func (h exchangeHandler) buildOutputs(r contractRequest) ([]txbuilder.TxOutput, error) {
-	party1Addr := r.senders[0]
+	// the parties are the same as the balances of the Settlement
+	party1Addr := r.receivers[1].Address
 	party2Addr := r.receivers[2].Address
 
-	contractAddress, err := r.contract.Address()
+	contractAddress, err := r.contract.Address(r.params)
 	if err != nil {
 		return nil, err
 	}
@@ -129,48 +132,26 @@ func (h exchangeHandler) buildOutputs(r contractRequest) ([]txbuilder.TxOutput,
 		return nil, errors.New("Not *protocol.Exchange")
 	}
 
-	// the TX needs to pay to the Receiver as well, so add that here.
-	outs := []txbuilder.TxOutput{
-		txbuilder.TxOutput{
-			Address: party1Addr,
-			Value:   dustLimit, // any change will be added to this output value
-		},
-		txbuilder.TxOutput{
-			Address: party2Addr,
-			Value:   dustLimit,
-		},
-		txbuilder.TxOutput{
-			Address: contractAddress,
-			Value:   dustLimit,
-		},
-	}
-
-	// optional contract fee
-	if h.Fee.Value > 0 {
-		o := txbuilder.TxOutput{
-			Address: h.Fee.Address,
-			Value:   h.Fee.Value,
-		}
-
-		outs = append(outs, o)
-	}
+	layout := newResponseLayout(protocol.CodeSettlement).
+		notify(roleParty1, party1Addr).
+		notify(roleParty2, party2Addr).
+		notify(roleContract, contractAddress)
 
 	// Optional exchange fee.
 	if exchange.ExchangeFeeFixed > 0 {
-		a := string(exchange.ExchangeFeeAddress)
-		addr, err := btcutil.DecodeAddress(a, &chaincfg.MainNetParams)
+		addr, err := addressField("exchange_fee_address", exchange.ExchangeFeeAddress, r.params)
 		if err != nil {
 			return nil, err
 		}
 
 		// convert BCH to Satoshi's
-		o := txbuilder.TxOutput{
-			Address: addr,
-			Value:   txbuilder.ConvertBCHToSatoshis(exchange.ExchangeFeeFixed),
-		}
+		layout.pay(roleExchangeFee, addr, txbuilder.ConvertBCHToSatoshis(exchange.ExchangeFeeFixed))
+	}
 
-		outs = append(outs, o)
+	// optional contract fee
+	if h.Fee.Value > 0 {
+		layout.pay(roleFee, h.Fee.Address, h.Fee.Value)
 	}
 
-	return outs, nil
+	return layout.build()
 }
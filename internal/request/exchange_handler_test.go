@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcutil"
 	"github.com/tokenized/smart-contract/internal/app/state/contract"
 	"github.com/tokenized/smart-contract/pkg/protocol"
@@ -83,6 +84,7 @@ func TestExchangeHandler_handle(t *testing.T) {
 		senders:   senders,
 		receivers: receivers,
 		m:         &exchange,
+		params:    &chaincfg.MainNetParams,
 	}
 
 	config := newTestConfig()
@@ -16,7 +16,9 @@ import (
 	"github.com/tokenized/smart-contract/internal/app/config"
 	"github.com/tokenized/smart-contract/internal/app/logger"
 	"github.com/tokenized/smart-contract/internal/app/state/contract"
+	"github.com/tokenized/smart-contract/pkg/netparams"
 	"github.com/tokenized/smart-contract/pkg/protocol"
+	"github.com/tokenized/smart-contract/pkg/txbuilder"
 	"github.com/tokenized/smart-contract/pkg/txscript"
 	"github.com/tokenized/smart-contract/pkg/wire"
 	"go.uber.org/zap"
@@ -56,6 +58,8 @@ func newTestConfig() config.Config {
 			Address: decodeAddress("19fhPw9rheNT9kT4BcLsNCyZhjo1QRivd8"),
 			Value:   546,
 		},
+		FeeRate: txbuilder.DefaultSatsPerKB,
+		Net:     &netparams.MainNet,
 	}
 
 	return c
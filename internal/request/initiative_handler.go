@@ -5,7 +5,6 @@ import (
 	"errors"
 	"time"
 
-	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcutil"
 	"github.com/tokenized/smart-contract/internal/app/state/contract"
 	"github.com/tokenized/smart-contract/pkg/protocol"
@@ -53,25 +52,17 @@ func (h initiativeHandler) handle(ctx context.Context,
 	// add the Vote to the Contract
 	c.Votes[v.RefTxnIDHash] = v
 
-	// calculate the fee to pay the issuer.
-	issuerFee := uint64(546)
-
-	// how much to return to the sender?
-	// 0 : Contract's Public Address
-	// 1 : Issuer's Public Address
-
-	issuerAddress, err := btcutil.DecodeAddress(c.IssuerAddress,
-		&chaincfg.MainNetParams)
-
+	// the issuer is notified of the vote
+	issuerAddress, err := btcutil.DecodeAddress(c.IssuerAddress, r.params)
 	if err != nil {
 		return nil, err
 	}
 
-	outs := []txbuilder.TxOutput{
-		txbuilder.TxOutput{
-			Address: issuerAddress,
-			Value:   issuerFee,
-		},
+	outs, err := newResponseLayout(protocol.CodeVote).
+		notify(roleIssuer, issuerAddress).
+		build()
+	if err != nil {
+		return nil, err
 	}
 
 	resp := contractResponse{
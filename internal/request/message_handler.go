@@ -0,0 +1,151 @@
+package request
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/tokenized/smart-contract/internal/app/config"
+	"github.com/tokenized/smart-contract/internal/app/state/contract"
+	"github.com/tokenized/smart-contract/pkg/protocol"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+)
+
+type messageHandler struct {
+	Fee config.Fee
+
+	// MaxRecipients is the most holders the Message is relayed to in one
+	// tx.
+	MaxRecipients int
+}
+
+func newMessageHandler(fee config.Fee, maxRecipients int) messageHandler {
+	if maxRecipients <= 0 {
+		maxRecipients = config.DefaultMaxMessageRecipients
+	}
+
+	return messageHandler{
+		Fee:           fee,
+		MaxRecipients: maxRecipients,
+	}
+}
+
+// handle relays a Message from the issuer to every holder of an asset of
+// the contract, other than the sender.
+//
+// Each holder is paid an output of the relayed Message. When there are
+// more holders than MaxRecipients, the first response is followed by a
+// response for each further batch of holders, in Responses.
+func (h messageHandler) handle(ctx context.Context,
+	r contractRequest) (*contractResponse, error) {
+
+	msg, ok := r.m.(*protocol.Message)
+	if !ok {
+		return nil, errors.New("Not *protocol.Message")
+	}
+
+	// Contract
+	c := r.contract
+
+	contractAddr, err := c.Address(r.params)
+	if err != nil {
+		return nil, err
+	}
+
+	holders, err := h.holders(c, r.senders[0], r.params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(holders) == 0 {
+		return nil, protocol.NewRejectionReason(protocol.RejectionCodeReceiverUnspecified,
+			"", "contract has no holders")
+	}
+
+	// Message <- Message
+	relay := protocol.NewMessage()
+	relay.Timestamp = uint64(time.Now().Unix())
+	relay.MessageType = msg.MessageType
+	relay.Message = msg.Message
+
+	var first *contractResponse
+
+	for start := 0; start < len(holders); start += h.MaxRecipients {
+		end := start + h.MaxRecipients
+		if end > len(holders) {
+			end = len(holders)
+		}
+
+		layout := newResponseLayout(protocol.CodeMessage).
+			notifyEach(roleHolder, holders[start:end])
+
+		// the fee is paid once, by the first tx
+		if first == nil && h.Fee.Value > 0 {
+			layout.pay(roleFee, h.Fee.Address, h.Fee.Value)
+		}
+
+		outs, err := layout.build()
+		if err != nil {
+			return nil, err
+		}
+
+		// change stays with the contract, to fund the next tx
+		cr := contractResponse{
+			Contract:      c,
+			Message:       &relay,
+			outs:          outs,
+			changeAddress: contractAddr,
+		}
+
+		if first == nil {
+			first = &cr
+			continue
+		}
+
+		first.Responses = append(first.Responses, cr)
+	}
+
+	return first, nil
+}
+
+// holders returns the address of each holder of an asset of the contract,
+// other than the sender, in order.
+func (h messageHandler) holders(c contract.Contract,
+	sender btcutil.Address,
+	params *chaincfg.Params) ([]btcutil.Address, error) {
+
+	seen := map[string]bool{
+		sender.EncodeAddress(): true,
+	}
+
+	keys := []string{}
+
+	for _, asset := range c.Assets {
+		for key, holding := range asset.Holdings {
+			if holding.Balance == 0 || seen[key] {
+				continue
+			}
+
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	addresses := make([]btcutil.Address, len(keys))
+
+	for i, key := range keys {
+		addr, err := btcutil.DecodeAddress(key, params)
+		if err != nil {
+			return nil, err
+		}
+
+		addresses[i] = addr
+	}
+
+	return addresses, nil
+}
@@ -0,0 +1,132 @@
+package request
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/tokenized/smart-contract/internal/app/state/contract"
+	"github.com/tokenized/smart-contract/pkg/protocol"
+)
+
+func TestMessageHandler_handle(t *testing.T) {
+	ctx := newSilentContext()
+	config := newTestConfig()
+
+	contractAddr := "1DNTgNSWtTestKs7j1DwaoxmSc4q9sEUsb"
+	issuerAddr := "13FzCGiNWaUHCWGvuLobWM7iaNyP3TJAJg"
+
+	holder1 := "123h2RL1DT4AuYyJUseGxcXSAe5imPSeLV"
+	holder2 := "1Af3Hu5t7HTwLHxfPcgFLB6E3puzT2Ci9C"
+	holder3 := "1HQ2ULuD7T5ykaucZ3KmTo4i29925Qa6ic"
+	holder4 := "1J5NEGEfYAqnhzXHkEyWFXre4BBdzjvK1H"
+	formerHolder := "1hezzpRJnet3NL38eqwiT6g33J3bS76z9"
+
+	c := contract.Contract{
+		ID:            contractAddr,
+		IssuerAddress: issuerAddr,
+		Assets: map[string]contract.Asset{
+			"common": contract.Asset{
+				ID: "common",
+				Holdings: map[string]contract.Holding{
+					issuerAddr:   contract.Holding{Address: issuerAddr, Balance: 100},
+					holder4:      contract.Holding{Address: holder4, Balance: 10},
+					holder1:      contract.Holding{Address: holder1, Balance: 10},
+					formerHolder: contract.Holding{Address: formerHolder, Balance: 0},
+				},
+			},
+			"preferred": contract.Asset{
+				ID: "preferred",
+				Holdings: map[string]contract.Holding{
+					holder4: contract.Holding{Address: holder4, Balance: 5},
+					holder3: contract.Holding{Address: holder3, Balance: 5},
+					holder2: contract.Holding{Address: holder2, Balance: 5},
+				},
+			},
+		},
+	}
+
+	m := protocol.NewMessage()
+	m.MessageType = []byte("6")
+	m.Message = []byte("AGM on 1 July")
+
+	req := contractRequest{
+		contract: c,
+		senders:  []btcutil.Address{decodeAddress(issuerAddr)},
+		m:        &m,
+		params:   &chaincfg.MainNetParams,
+	}
+
+	h := newMessageHandler(config.Fee, 3)
+
+	resp, err := h.handle(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// each holder, other than the sender, is notified once, in batches of
+	// at most 3. Only the first tx pays the fee.
+	want := [][]string{
+		{holder1, holder2, holder3, config.Fee.Address.EncodeAddress()},
+		{holder4},
+	}
+
+	responses := append([]contractResponse{*resp}, resp.Responses...)
+	if len(responses) != len(want) {
+		t.Fatalf("got %d responses, want %d", len(responses), len(want))
+	}
+
+	for i, r := range responses {
+		relay, ok := r.Message.(*protocol.Message)
+		if !ok {
+			t.Fatalf("response %d : got %T, want *protocol.Message", i, r.Message)
+		}
+
+		if string(relay.Message) != string(m.Message) || string(relay.MessageType) != string(m.MessageType) {
+			t.Errorf("response %d : got message %+v, want %+v", i, relay, m)
+		}
+
+		if r.changeAddress.EncodeAddress() != contractAddr {
+			t.Errorf("response %d : got change to %s, want the contract", i, r.changeAddress)
+		}
+
+		if len(r.outs) != len(want[i]) {
+			t.Fatalf("response %d : got %d outputs, want %d", i, len(r.outs), len(want[i]))
+		}
+
+		for j, o := range r.outs {
+			if o.Address.EncodeAddress() != want[i][j] {
+				t.Errorf("response %d output %d : got %s, want %s", i, j,
+					o.Address.EncodeAddress(), want[i][j])
+			}
+		}
+	}
+}
+
+func TestMessageHandler_handle_noHolders(t *testing.T) {
+	ctx := newSilentContext()
+	config := newTestConfig()
+
+	issuerAddr := "13FzCGiNWaUHCWGvuLobWM7iaNyP3TJAJg"
+
+	c := contract.Contract{
+		ID:            "1DNTgNSWtTestKs7j1DwaoxmSc4q9sEUsb",
+		IssuerAddress: issuerAddr,
+	}
+
+	m := protocol.NewMessage()
+
+	req := contractRequest{
+		contract: c,
+		senders:  []btcutil.Address{decodeAddress(issuerAddr)},
+		m:        &m,
+		params:   &chaincfg.MainNetParams,
+	}
+
+	_, err := newMessageHandler(config.Fee, 0).handle(ctx, req)
+
+	reason, ok := err.(*protocol.RejectionReason)
+	if !ok || reason.Code != protocol.RejectionCodeReceiverUnspecified {
+		t.Fatalf("got error %v, want a Receiver Unspecified rejection", err)
+	}
+}
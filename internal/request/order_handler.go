@@ -3,11 +3,9 @@ package request
 import (
 	"context"
 	"errors"
-	"fmt"
 	"time"
 
 	"github.com/btcsuite/btcd/chaincfg"
-	"github.com/btcsuite/btcutil"
 	"github.com/tokenized/smart-contract/internal/app/config"
 	"github.com/tokenized/smart-contract/internal/app/state/contract"
 	"github.com/tokenized/smart-contract/pkg/protocol"
@@ -39,14 +37,16 @@ func (h orderHandler) handle(ctx context.Context,
 	assetKey := string(order.AssetID)
 	asset, ok := c.Assets[assetKey]
 	if !ok {
-		return nil, fmt.Errorf("order : Asset ID not found : contract=%s assetID=%s", c.ID, order.AssetID)
+		return nil, protocol.NewRejectionReason(protocol.RejectionCodeAssetNotFound,
+			"asset_id", "asset %s not found", assetKey)
 	}
 
 	// Holdings check
 	targetAddr := string(order.TargetAddress)
 	_, ok = asset.Holdings[targetAddr]
 	if !ok {
-		return nil, fmt.Errorf("order : Holding not found contract=%s assetID=%s target=%s", c.ID, assetKey, targetAddr)
+		return nil, protocol.NewRejectionReason(protocol.RejectionCodeInsufficientAssets,
+			"target_address", "%s holds none of asset %s", targetAddr, assetKey)
 	}
 
 	// Apply logic based on Compliance Action type
@@ -55,20 +55,22 @@ func (h orderHandler) handle(ctx context.Context,
 
 	switch order.ComplianceAction {
 	case protocol.ComplianceActionFreeze:
-		resp, err = h.freeze(c, order)
+		resp, err = h.freeze(r.params, c, order)
 	case protocol.ComplianceActionThaw:
-		resp, err = h.thaw(c, order)
+		resp, err = h.thaw(r.params, c, order)
 	case protocol.ComplianceActionConfiscation:
-		resp, err = h.confiscate(c, order)
+		resp, err = h.confiscate(r.params, c, order)
 	default:
-		return nil, fmt.Errorf("Unknown enforcement : %v", order.ComplianceAction)
+		return nil, protocol.NewRejectionReason(protocol.RejectionCodeMalformed,
+			"compliance_action", "unknown action %q", order.ComplianceAction)
 	}
 
 	return resp, err
 }
 
 // freeze sets the state of a holding to frozen.
-func (h orderHandler) freeze(c contract.Contract,
+func (h orderHandler) freeze(params *chaincfg.Params,
+	c contract.Contract,
 	order *protocol.Order) (*contractResponse, error) {
 
 	// Freeze <- Order
@@ -80,13 +82,13 @@ func (h orderHandler) freeze(c contract.Contract,
 	freeze.Message = order.Message
 	freeze.Expiration = order.Expiration
 
-	contractAddr, err := c.Address()
+	contractAddr, err := c.Address(params)
 	if err != nil {
 		return nil, err
 	}
 
 	// Outputs
-	outputs, err := h.buildFreezeThawOutputs(c, order)
+	outputs, err := h.buildFreezeThawOutputs(params, c, order)
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +104,8 @@ func (h orderHandler) freeze(c contract.Contract,
 }
 
 // thaw reverses the freeze operation on a holding.
-func (h orderHandler) thaw(c contract.Contract,
+func (h orderHandler) thaw(params *chaincfg.Params,
+	c contract.Contract,
 	order *protocol.Order) (*contractResponse, error) {
 
 	// Thaw <- Order
@@ -113,13 +116,13 @@ func (h orderHandler) thaw(c contract.Contract,
 	thaw.Qty = order.Qty
 	thaw.Message = order.Message
 
-	contractAddr, err := c.Address()
+	contractAddr, err := c.Address(params)
 	if err != nil {
 		return nil, err
 	}
 
 	// Outputs
-	outputs, err := h.buildFreezeThawOutputs(c, order)
+	outputs, err := h.buildFreezeThawOutputs(params, c, order)
 	if err != nil {
 		return nil, err
 	}
@@ -135,7 +138,8 @@ func (h orderHandler) thaw(c contract.Contract,
 }
 
 // confiscate performs a confiscation of assets.
-func (h orderHandler) confiscate(c contract.Contract,
+func (h orderHandler) confiscate(params *chaincfg.Params,
+	c contract.Contract,
 	order *protocol.Order) (*contractResponse, error) {
 
 	// Asset
@@ -178,12 +182,12 @@ func (h orderHandler) confiscate(c contract.Contract,
 	confiscation.DepositsQty = depositBalance
 
 	// Outputs
-	outputs, err := h.buildConfiscateOutputs(c, order)
+	outputs, err := h.buildConfiscateOutputs(params, c, order)
 	if err != nil {
 		return nil, err
 	}
 
-	contractAddr, err := c.Address()
+	contractAddr, err := c.Address(params)
 	if err != nil {
 		return nil, err
 	}
@@ -198,92 +202,63 @@ func (h orderHandler) confiscate(c contract.Contract,
 	return &cr, nil
 }
 
-func (h orderHandler) buildFreezeThawOutputs(contract contract.Contract,
+func (h orderHandler) buildFreezeThawOutputs(params *chaincfg.Params,
+	contract contract.Contract,
 	order *protocol.Order) ([]txbuilder.TxOutput, error) {
 
-	contractAddr, err := contract.Address()
+	contractAddr, err := contract.Address(params)
 	if err != nil {
 		return nil, err
 	}
 
-	targetAddr, err := btcutil.DecodeAddress(string(order.TargetAddress),
-		&chaincfg.MainNetParams)
+	targetAddr, err := addressField("target_address", order.TargetAddress, params)
 	if err != nil {
 		return nil, err
 	}
 
-	// Alleged Target's Public Address
-	// Contract's Public Address
-	// Contract Fee Address
-	outs := []txbuilder.TxOutput{
-		txbuilder.TxOutput{
-			Address: targetAddr,
-			Value:   546,
-		},
-		txbuilder.TxOutput{
-			Address: contractAddr,
-			Value:   546, // address will receive change, if any
-		},
+	// freeze and thaw share a layout
+	layout := newResponseLayout(protocol.CodeFreeze)
+	if order.ComplianceAction == protocol.ComplianceActionThaw {
+		layout = newResponseLayout(protocol.CodeThaw)
 	}
 
-	// optional contract fee
-	if h.Fee.Value > 0 {
-		o := txbuilder.TxOutput{
-			Address: h.Fee.Address,
-			Value:   h.Fee.Value,
-		}
+	layout.notify(roleTarget, targetAddr).
+		notify(roleContract, contractAddr)
 
-		outs = append(outs, o)
+	if h.Fee.Value > 0 {
+		layout.pay(roleFee, h.Fee.Address, h.Fee.Value)
 	}
 
-	return outs, nil
+	return layout.build()
 }
 
-func (h orderHandler) buildConfiscateOutputs(contract contract.Contract,
+func (h orderHandler) buildConfiscateOutputs(params *chaincfg.Params,
+	contract contract.Contract,
 	order *protocol.Order) ([]txbuilder.TxOutput, error) {
 
-	// we need a txout to the target
-	targetAddr, err := btcutil.DecodeAddress(string(order.TargetAddress),
-		&chaincfg.MainNetParams)
+	targetAddr, err := addressField("target_address", order.TargetAddress, params)
 	if err != nil {
 		return nil, err
 	}
 
-	depositAddr, err := btcutil.DecodeAddress(string(order.DepositAddress),
-		&chaincfg.MainNetParams)
+	depositAddr, err := addressField("deposit_address", order.DepositAddress, params)
 	if err != nil {
 		return nil, err
 	}
 
-	contractAddr, err := contract.Address()
+	contractAddr, err := contract.Address(params)
 	if err != nil {
 		return nil, err
 	}
 
-	outs := []txbuilder.TxOutput{
-		txbuilder.TxOutput{
-			Address: targetAddr,
-			Value:   546,
-		},
-		txbuilder.TxOutput{
-			Address: depositAddr,
-			Value:   546,
-		},
-		txbuilder.TxOutput{
-			Address: contractAddr,
-			Value:   546, // address will receive change, if any
-		},
-	}
+	layout := newResponseLayout(protocol.CodeConfiscation).
+		notify(roleTarget, targetAddr).
+		notify(roleDeposit, depositAddr).
+		notify(roleContract, contractAddr)
 
-	// optional contract fee
 	if h.Fee.Value > 0 {
-		o := txbuilder.TxOutput{
-			Address: h.Fee.Address,
-			Value:   h.Fee.Value,
-		}
-
-		outs = append(outs, o)
+		layout.pay(roleFee, h.Fee.Address, h.Fee.Value)
 	}
 
-	return outs, nil
+	return layout.build()
 }
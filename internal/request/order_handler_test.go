@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcutil"
 	"github.com/tokenized/smart-contract/internal/app/state/contract"
 	"github.com/tokenized/smart-contract/pkg/protocol"
@@ -64,6 +65,7 @@ func TestOrderHandler_handle_freeze(t *testing.T) {
 		contract: c,
 		senders:  senders,
 		m:        &order,
+		params:   &chaincfg.MainNetParams,
 	}
 
 	config := newTestConfig()
@@ -200,6 +202,7 @@ func TestOrderHandler_handle_confiscate(t *testing.T) {
 		contract: c,
 		senders:  senders,
 		m:        &order,
+		params:   &chaincfg.MainNetParams,
 	}
 
 	config := newTestConfig()
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/btcsuite/btcutil"
 	"github.com/tokenized/smart-contract/internal/app/state/contract"
 	"github.com/tokenized/smart-contract/pkg/protocol"
 	"github.com/tokenized/smart-contract/pkg/txbuilder"
@@ -50,9 +51,22 @@ func (h referendumHandler) handle(ctx context.Context,
 	// add the Vote to the Contract
 	c.Votes[v.RefTxnIDHash] = v
 
+	issuerAddress, err := btcutil.DecodeAddress(c.IssuerAddress, r.params)
+	if err != nil {
+		return nil, err
+	}
+
+	outs, err := newResponseLayout(protocol.CodeVote).
+		notify(roleIssuer, issuerAddress).
+		build()
+	if err != nil {
+		return nil, err
+	}
+
 	resp := contractResponse{
 		Contract: c,
 		Message:  &vote,
+		outs:     outs,
 	}
 
 	return &resp, nil
@@ -6,6 +6,7 @@ import (
 	"github.com/tokenized/smart-contract/pkg/txbuilder"
 	"github.com/tokenized/smart-contract/pkg/wire"
 
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcutil"
 )
@@ -17,4 +18,20 @@ type contractRequest struct {
 	receivers []txbuilder.TxOutput
 	contract  contract.Contract
 	m         protocol.OpReturnMessage
+	params    *chaincfg.Params
+}
+
+// addressField returns the address held by a field of the request. An
+// address that can not be decoded is a reason to reject the request.
+func addressField(field string,
+	b []byte,
+	params *chaincfg.Params) (btcutil.Address, error) {
+
+	addr, err := btcutil.DecodeAddress(string(b), params)
+	if err != nil {
+		return nil, protocol.NewRejectionReason(protocol.RejectionCodeMalformed,
+			field, "%v", err)
+	}
+
+	return addr, nil
 }
@@ -13,4 +13,8 @@ type contractResponse struct {
 	outs          []txbuilder.TxOutput
 	Responses     []contractResponse
 	changeAddress btcutil.Address
+
+	// feePolicy overrides the fee rate of the Wallet, if it is not nil. It
+	// is set from the fee rate configured for the type of the response.
+	feePolicy *txbuilder.FeePolicy
 }
@@ -15,12 +15,17 @@ import (
 	"fmt"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
 	"github.com/tokenized/smart-contract/internal/app/config"
 	"github.com/tokenized/smart-contract/internal/app/inspector"
+	"github.com/tokenized/smart-contract/internal/app/logger"
 	"github.com/tokenized/smart-contract/internal/app/state"
 	"github.com/tokenized/smart-contract/internal/app/state/contract"
 	"github.com/tokenized/smart-contract/internal/app/wallet"
+	"github.com/tokenized/smart-contract/internal/pool"
 	"github.com/tokenized/smart-contract/pkg/protocol"
+	"github.com/tokenized/smart-contract/pkg/txbuilder"
+	"github.com/tokenized/smart-contract/pkg/wire"
 )
 
 var (
@@ -35,13 +40,10 @@ var (
 		protocol.CodeReferendum:        true,
 		protocol.CodeBallotCast:        true,
 		protocol.CodeOrder:             true,
+		protocol.CodeMessage:           true,
 	}
 )
 
-const (
-	dustLimit = 546
-)
-
 func newRequestHandlers(state state.StateInterface,
 	config config.Config) map[string]requestHandlerInterface {
 
@@ -53,6 +55,7 @@ func newRequestHandlers(state state.StateInterface,
 		protocol.CodeSend:              newSendHandler(config.Fee),
 		protocol.CodeExchange:          newExchangeHandler(config.Fee),
 		protocol.CodeOrder:             newOrderHandler(config.Fee),
+		protocol.CodeMessage:           newMessageHandler(config.Fee, config.MaxMessageRecipients),
 		// protocol.CodeInitiative:        newInitiativeHandler(),
 		// protocol.CodeReferendum:        newReferendumHandler(),
 		// protocol.CodeBallotCast:        newBallotCastHandler(),
@@ -64,19 +67,22 @@ type RequestService struct {
 	State     state.StateInterface
 	Wallet    wallet.WalletInterface
 	Inspector inspector.InspectorService
+	Pool      pool.PoolService
 	handlers  map[string]requestHandlerInterface
 }
 
 func NewRequestService(config config.Config,
 	wallet wallet.WalletInterface,
 	state state.StateInterface,
-	inspector inspector.InspectorService) RequestService {
+	inspector inspector.InspectorService,
+	pool pool.PoolService) RequestService {
 
 	return RequestService{
 		Config:    config,
 		State:     state,
 		Wallet:    wallet,
 		Inspector: inspector,
+		Pool:      pool,
 		handlers:  newRequestHandlers(state, config),
 	}
 }
@@ -111,8 +117,11 @@ func (s RequestService) PreFilter(ctx context.Context,
 
 // Process the request through a handler
 //
+// The response is returned first, followed by a tx for each further
+// response of the handler, such as the batches of a relayed Message. Each
+// further tx spends the change the tx before it paid to the contract.
 func (s RequestService) Process(ctx context.Context,
-	itx *inspector.Transaction, contract *contract.Contract) (*inspector.Transaction, error) {
+	itx *inspector.Transaction, contract *contract.Contract) ([]*inspector.Transaction, error) {
 
 	tx := itx.MsgTx
 	msg := itx.MsgProto
@@ -132,6 +141,7 @@ func (s RequestService) Process(ctx context.Context,
 		receivers: itx.Outputs,
 		contract:  *contract,
 		m:         msg,
+		params:    s.Config.Net.Chain,
 	}
 
 	// Run the handler, return the response
@@ -163,17 +173,159 @@ func (s RequestService) Process(ctx context.Context,
 	}
 
 	// Create usable transaction to pass back
-	newTx, err := s.Wallet.BuildTX(key, utxos, res.outs, changeAddress, res.Message)
+	newItx, err := s.build(ctx, key, contractAddress, utxos, changeAddress, res)
+	if err != nil {
+		return nil, err
+	}
+
+	itxs := []*inspector.Transaction{newItx}
+
+	for i := range res.Responses {
+		// the change of the previous tx funds the next
+		outputs, err := s.Inspector.Builder.BuildFromOutputs(newItx.MsgTx)
+		if err != nil {
+			s.release(ctx, itxs)
+			return nil, err
+		}
+
+		utxos, err := outputs.ForAddress(contractAddress)
+		if err != nil {
+			s.release(ctx, itxs)
+			return nil, err
+		}
+
+		next := &res.Responses[i]
+
+		nextChange := next.changeAddress
+		if nextChange == nil {
+			nextChange = contractAddress
+		}
+
+		newItx, err = s.build(ctx, key, contractAddress, utxos, nextChange, next)
+		if err != nil {
+			s.release(ctx, itxs)
+			return nil, err
+		}
+
+		itxs = append(itxs, newItx)
+
+		// the change joins the pool if the previous tx confirms first, and
+		// must not be spent again from there
+		if err := s.Pool.ReserveChained(ctx, contractAddress, utxos, newItx.MsgTx); err != nil {
+			s.release(ctx, itxs)
+			return nil, err
+		}
+	}
+
+	return itxs, nil
+}
+
+// release frees the UTXOs reserved from the pool by the txs of a response
+// that is not returned.
+func (s RequestService) release(ctx context.Context, itxs []*inspector.Transaction) {
+	log := logger.NewLoggerFromContext(ctx).Sugar()
+
+	for _, itx := range itxs {
+		hash := itx.MsgTx.TxHash()
+
+		if err := s.Pool.Release(ctx, hash); err != nil {
+			log.Errorf("Failed to release pool for response %s : %v", hash, err)
+		}
+	}
+}
+
+// build returns the tx of a response, spending the UTXOs. The pool of the
+// contract tops it up if the UTXOs do not pay for it.
+func (s RequestService) build(ctx context.Context,
+	key txbuilder.Signer,
+	contractAddress btcutil.Address,
+	utxos txbuilder.UTXOs,
+	changeAddress btcutil.Address,
+	res *contractResponse) (*inspector.Transaction, error) {
+
+	if res.feePolicy == nil {
+		res.feePolicy = s.feePolicy(res.Message)
+	}
+
+	newTx, report, err := s.Wallet.BuildTX(key, utxos, res.outs, changeAddress, res.Message,
+		res.feePolicy)
+	if txbuilder.IsInsufficientValue(err) {
+		newTx, utxos, report, err = s.topUp(ctx, key, contractAddress, utxos, res, err)
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	log := logger.NewLoggerFromContext(ctx).Sugar()
+	log.Infof("Built response %s : %s", newTx.TxHash(), report)
+
 	newItx := s.Inspector.CreateTransaction(utxos, res.outs, res.Message)
 	newItx.MsgTx = newTx
 
 	return newItx, nil
 }
 
+// feePolicy returns the fee policy configured for the type of a response,
+// or nil if the fee rate of the Wallet is used.
+func (s RequestService) feePolicy(m protocol.OpReturnMessage) *txbuilder.FeePolicy {
+	rate, ok := s.Config.ResponseFeeRates[m.Type()]
+	if !ok {
+		return nil
+	}
+
+	policy := txbuilder.NewFeePolicy(rate)
+
+	return &policy
+}
+
+// topUp builds the response again, spending the pool of the contract as
+// well as the UTXOs of the request, which did not pay enough for it. The
+// change is kept by the contract.
+//
+// The UTXOs spent are returned, and those from the pool are reserved. If
+// the pool can not be used, short is returned.
+func (s RequestService) topUp(ctx context.Context,
+	key txbuilder.Signer,
+	contractAddress btcutil.Address,
+	utxos txbuilder.UTXOs,
+	res *contractResponse,
+	short error) (*wire.MsgTx, txbuilder.UTXOs, *txbuilder.BuildReport, error) {
+
+	if s.Config.PoolMaxTopUp == 0 {
+		return nil, nil, nil, short
+	}
+
+	available, err := s.Pool.Available(ctx, contractAddress)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if len(available) == 0 {
+		return nil, nil, nil, short
+	}
+
+	utxos = append(append(txbuilder.UTXOs{}, utxos...), available...)
+
+	tx, report, err := s.Wallet.BuildTX(key, utxos, res.outs, contractAddress, res.Message,
+		res.feePolicy)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	err = s.Pool.Reserve(ctx, contractAddress, tx, s.Config.PoolMaxTopUp)
+	if err == pool.ErrTopUpLimit {
+		return nil, nil, nil, short
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	log := logger.NewLoggerFromContext(ctx).Sugar()
+	log.Infof("Topped up response %s from the pool", tx.TxHash())
+
+	return tx, utxos, report, nil
+}
+
 // isIncomingMessageType returns true is the message type is one that we
 // want to process, false otherwise.
 func (s RequestService) isIncomingMessageType(msg protocol.OpReturnMessage) bool {
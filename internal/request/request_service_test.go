@@ -0,0 +1,32 @@
+package request
+
+import (
+	"testing"
+
+	"github.com/tokenized/smart-contract/pkg/protocol"
+)
+
+func TestRequestService_feePolicy(t *testing.T) {
+	config := newTestConfig()
+	config.ResponseFeeRates = map[string]uint64{
+		protocol.CodeMessage: 250,
+	}
+
+	s := RequestService{
+		Config: config,
+	}
+
+	message := protocol.NewMessage()
+
+	policy := s.feePolicy(&message)
+	if policy == nil || policy.SatsPerKB != 250 {
+		t.Fatalf("got policy %+v, want 250 sats per KB", policy)
+	}
+
+	// other responses use the fee rate of the Wallet
+	settlement := protocol.NewSettlement()
+
+	if policy := s.feePolicy(&settlement); policy != nil {
+		t.Errorf("got policy %+v, want none", policy)
+	}
+}
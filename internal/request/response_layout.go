@@ -0,0 +1,176 @@
+package request
+
+import (
+	"fmt"
+
+	"github.com/tokenized/smart-contract/pkg/protocol"
+	"github.com/tokenized/smart-contract/pkg/txbuilder"
+
+	"github.com/btcsuite/btcutil"
+)
+
+// outputRole is the part played in a response by the address an output
+// pays.
+type outputRole string
+
+const (
+	roleContract    outputRole = "contract"
+	roleIssuer      outputRole = "issuer"
+	roleParty1      outputRole = "party1"
+	roleParty2      outputRole = "party2"
+	roleTarget      outputRole = "target"
+	roleDeposit     outputRole = "deposit"
+	roleFee         outputRole = "fee"
+	roleExchangeFee outputRole = "exchange fee"
+	roleHolder      outputRole = "holder"
+)
+
+// optionalRoles may be left out of a response. They must come after every
+// other role of a layout, and the fee must come last, so leaving one out
+// moves no output other than the fee.
+var optionalRoles = map[outputRole]bool{
+	roleFee:         true,
+	roleExchangeFee: true,
+}
+
+// repeatedRoles may have any number of outputs in a response, from one up.
+var repeatedRoles = map[outputRole]bool{
+	roleHolder: true,
+}
+
+// responseLayouts are the outputs of each response message, in order.
+//
+// Consumers of a response find the parties to it by the index of their
+// output, so the order of a layout must never change. Change is never
+// merged into these outputs. It is paid in an output after them, and
+// before the OP_RETURN.
+var responseLayouts = map[string][]outputRole{
+	protocol.CodeContractFormation: {roleContract, roleIssuer, roleFee},
+	protocol.CodeAssetCreation:     {roleContract, roleIssuer, roleFee},
+	protocol.CodeSettlement:        {roleParty1, roleParty2, roleContract, roleExchangeFee, roleFee},
+	protocol.CodeFreeze:            {roleTarget, roleContract, roleFee},
+	protocol.CodeThaw:              {roleTarget, roleContract, roleFee},
+	protocol.CodeConfiscation:      {roleTarget, roleDeposit, roleContract, roleFee},
+	protocol.CodeVote:              {roleIssuer},
+	protocol.CodeMessage:           {roleHolder, roleFee},
+}
+
+// responseLayout collects the outputs of a response by role, and returns
+// them in the order of the layout of the response message.
+type responseLayout struct {
+	code    string
+	outputs map[outputRole]txbuilder.TxOutput
+	repeats map[outputRole][]txbuilder.TxOutput
+}
+
+// newResponseLayout returns a responseLayout for the response message
+// type.
+func newResponseLayout(code string) *responseLayout {
+	return &responseLayout{
+		code:    code,
+		outputs: map[outputRole]txbuilder.TxOutput{},
+		repeats: map[outputRole][]txbuilder.TxOutput{},
+	}
+}
+
+// pay sets the output for a role.
+func (l *responseLayout) pay(role outputRole,
+	address btcutil.Address,
+	value uint64) *responseLayout {
+
+	l.outputs[role] = txbuilder.TxOutput{
+		Address: address,
+		Value:   value,
+	}
+
+	return l
+}
+
+// notify sets the output for a role to the least value that will be
+// relayed. It exists so the address is an output of the response.
+func (l *responseLayout) notify(role outputRole,
+	address btcutil.Address) *responseLayout {
+
+	return l.pay(role, address, txbuilder.DustMinimumOutput)
+}
+
+// notifyEach adds an output for a repeated role for each address, in
+// order, to the least value that will be relayed.
+func (l *responseLayout) notifyEach(role outputRole,
+	addresses []btcutil.Address) *responseLayout {
+
+	for _, address := range addresses {
+		l.repeats[role] = append(l.repeats[role], txbuilder.TxOutput{
+			Address: address,
+			Value:   txbuilder.DustMinimumOutput,
+		})
+	}
+
+	return l
+}
+
+// build returns the outputs in the order of the layout.
+//
+// An error is returned if a role that is not optional was not set, or if a
+// role that is not in the layout was set.
+func (l *responseLayout) build() ([]txbuilder.TxOutput, error) {
+	layout, ok := responseLayouts[l.code]
+	if !ok {
+		return nil, fmt.Errorf("No output layout for response type %v", l.code)
+	}
+
+	outs := []txbuilder.TxOutput{}
+	used := 0
+
+	for _, role := range layout {
+		if repeatedRoles[role] {
+			repeats, ok := l.repeats[role]
+			if !ok {
+				return nil, fmt.Errorf("Missing %s output for response type %v", role, l.code)
+			}
+
+			outs = append(outs, repeats...)
+			used++
+			continue
+		}
+
+		o, ok := l.outputs[role]
+		if !ok {
+			if optionalRoles[role] {
+				continue
+			}
+
+			return nil, fmt.Errorf("Missing %s output for response type %v", role, l.code)
+		}
+
+		outs = append(outs, o)
+		used++
+	}
+
+	if used != len(l.outputs)+len(l.repeats) {
+		for role := range l.outputs {
+			if !hasRole(layout, role) || repeatedRoles[role] {
+				return nil, fmt.Errorf("No %s output in layout for response type %v", role, l.code)
+			}
+		}
+
+		for role := range l.repeats {
+			if !hasRole(layout, role) || !repeatedRoles[role] {
+				return nil, fmt.Errorf("No %s output in layout for response type %v", role, l.code)
+			}
+		}
+	}
+
+	return outs, nil
+}
+
+// hasRole returns true if the role is in the layout.
+func hasRole(layout []outputRole, role outputRole) bool {
+	for _, r := range layout {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
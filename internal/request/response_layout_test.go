@@ -0,0 +1,368 @@
+package request
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/tokenized/smart-contract/internal/app/state/contract"
+	"github.com/tokenized/smart-contract/pkg/protocol"
+	"github.com/tokenized/smart-contract/pkg/txbuilder"
+	"github.com/tokenized/smart-contract/pkg/wire"
+)
+
+func TestResponseLayouts(t *testing.T) {
+	for code, layout := range responseLayouts {
+		seen := map[outputRole]bool{}
+		optional := false
+
+		for i, role := range layout {
+			if seen[role] {
+				t.Errorf("%v : role %s is in the layout twice", code, role)
+			}
+			seen[role] = true
+
+			if optionalRoles[role] {
+				optional = true
+			} else if optional {
+				t.Errorf("%v : role %s at %d follows an optional role", code, role, i)
+			}
+
+			// leaving an optional role out may only move the fee
+			if role == roleFee && i != len(layout)-1 {
+				t.Errorf("%v : fee at %d is not last", code, i)
+			}
+		}
+	}
+}
+
+func TestResponseLayout_build(t *testing.T) {
+	contractAddress := decodeAddress("1hezzpRJnet3NL38eqwiT6g33J3bS76z9")
+	targetAddress := decodeAddress("1Af3Hu5t7HTwLHxfPcgFLB6E3puzT2Ci9C")
+
+	// the optional fee can be left out
+	outs, err := newResponseLayout(protocol.CodeFreeze).
+		notify(roleContract, contractAddress).
+		notify(roleTarget, targetAddress).
+		build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(outs) != 2 || outs[0].Address != targetAddress || outs[1].Address != contractAddress {
+		t.Fatalf("got outputs %+v, want target then contract", outs)
+	}
+
+	if _, err := newResponseLayout(protocol.CodeFreeze).
+		notify(roleContract, contractAddress).
+		build(); err == nil {
+		t.Error("built layout without a target")
+	}
+
+	if _, err := newResponseLayout(protocol.CodeFreeze).
+		notify(roleContract, contractAddress).
+		notify(roleTarget, targetAddress).
+		notify(roleDeposit, targetAddress).
+		build(); err == nil {
+		t.Error("built layout with a deposit")
+	}
+
+	// the exchange fee keeps its index, with or without the fee after it
+	for fee, n := range map[uint64]int{0: 4, 1000: 5} {
+		layout := newResponseLayout(protocol.CodeSettlement).
+			notify(roleParty1, contractAddress).
+			notify(roleParty2, targetAddress).
+			notify(roleContract, contractAddress).
+			pay(roleExchangeFee, targetAddress, 2000)
+
+		if fee > 0 {
+			layout.pay(roleFee, contractAddress, fee)
+		}
+
+		outs, err = layout.build()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(outs) != n || outs[3].Value != 2000 {
+			t.Fatalf("fee %d : got outputs %+v, want exchange fee at 3", fee, outs)
+		}
+	}
+
+	if _, err := newResponseLayout(protocol.CodeRejection).build(); err == nil {
+		t.Error("built layout for unknown response")
+	}
+}
+
+func TestResponseLayout_build_repeated(t *testing.T) {
+	holders := []btcutil.Address{
+		decodeAddress("1Af3Hu5t7HTwLHxfPcgFLB6E3puzT2Ci9C"),
+		decodeAddress("1J5NEGEfYAqnhzXHkEyWFXre4BBdzjvK1H"),
+	}
+	feeAddress := decodeAddress("19fhPw9rheNT9kT4BcLsNCyZhjo1QRivd8")
+
+	// every holder is paid, in order, before the fee
+	outs, err := newResponseLayout(protocol.CodeMessage).
+		pay(roleFee, feeAddress, 1000).
+		notifyEach(roleHolder, holders).
+		build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(outs) != 3 || outs[0].Address != holders[0] || outs[1].Address != holders[1] ||
+		outs[2].Address != feeAddress {
+		t.Fatalf("got outputs %+v, want holders then fee", outs)
+	}
+
+	if _, err := newResponseLayout(protocol.CodeMessage).build(); err == nil {
+		t.Error("built layout without holders")
+	}
+
+	if _, err := newResponseLayout(protocol.CodeFreeze).
+		notify(roleTarget, holders[0]).
+		notify(roleContract, feeAddress).
+		notifyEach(roleHolder, holders).
+		build(); err == nil {
+		t.Error("built layout with holders")
+	}
+}
+
+// TestRequestHandlers_layout checks the outputs of the response to each
+// request are in the order of the layout of the response.
+func TestRequestHandlers_layout(t *testing.T) {
+	ctx := newSilentContext()
+	config := newTestConfig()
+
+	contractAddr := "1hezzpRJnet3NL38eqwiT6g33J3bS76z9"
+	issuerAddr := "1J5NEGEfYAqnhzXHkEyWFXre4BBdzjvK1H"
+	party2Addr := "1Af3Hu5t7HTwLHxfPcgFLB6E3puzT2Ci9C"
+	exchangeFeeAddr := "1HQ2ULuD7T5ykaucZ3KmTo4i29925Qa6ic"
+
+	contractAddress := decodeAddress(contractAddr)
+	issuerAddress := decodeAddress(issuerAddr)
+	party2Address := decodeAddress(party2Addr)
+
+	asset := contract.Asset{
+		ID:   "SHCebr4e35mwoa1wohklcdmcmg1gbd10otk",
+		Type: "SHC",
+		Holdings: map[string]contract.Holding{
+			issuerAddr: contract.Holding{
+				Address: issuerAddr,
+				Balance: 1000,
+			},
+			party2Addr: contract.Holding{
+				Address: party2Addr,
+				Balance: 1000,
+			},
+		},
+	}
+
+	newContract := func() contract.Contract {
+		return contract.Contract{
+			ID:            contractAddr,
+			IssuerAddress: issuerAddr,
+			Assets: map[string]contract.Asset{
+				asset.ID: asset,
+			},
+			Votes: map[string]contract.Vote{},
+		}
+	}
+
+	newOrder := func(action byte) *protocol.Order {
+		m := protocol.NewOrder()
+		m.AssetID = []byte(asset.ID)
+		m.AssetType = []byte(asset.Type)
+		m.ComplianceAction = action
+		m.TargetAddress = []byte(party2Addr)
+		m.DepositAddress = []byte(issuerAddr)
+		m.Qty = 10
+
+		return &m
+	}
+
+	send := protocol.NewSend()
+	send.AssetID = []byte(asset.ID)
+	send.TokenQty = 10
+
+	exchange := protocol.NewExchange()
+	exchange.Party1AssetID = []byte(asset.ID)
+	exchange.Party1TokenQty = 10
+	exchange.ExchangeFeeFixed = 0.00001
+	exchange.ExchangeFeeAddress = []byte(exchangeFeeAddr)
+
+	offer := protocol.NewContractOffer()
+	amendment := protocol.NewContractAmendment()
+	definition := protocol.NewAssetDefinition()
+	modification := protocol.NewAssetModification()
+	initiative := protocol.NewInitiative()
+	referendum := protocol.NewReferendum()
+	message := protocol.NewMessage()
+
+	// the vote is kept with the output it was paid to
+	tx := wire.NewMsgTx(2)
+	tx.AddTxOut(wire.NewTxOut(1000, nil))
+	tx.AddTxOut(wire.NewTxOut(1000, nil))
+
+	// an exchange pays both parties, a send only the receiver
+	receivers := []txbuilder.TxOutput{
+		txbuilder.TxOutput{Address: contractAddress},
+		txbuilder.TxOutput{Address: issuerAddress},
+		txbuilder.TxOutput{Address: party2Address},
+	}
+
+	sendReceivers := []txbuilder.TxOutput{
+		receivers[0],
+		receivers[2],
+	}
+
+	fee := config.Fee.Address
+
+	noFee := config.Fee
+	noFee.Value = 0
+
+	tests := []struct {
+		name      string
+		handler   requestHandlerInterface
+		m         protocol.OpReturnMessage
+		receivers []txbuilder.TxOutput
+		code      string
+		want      []btcutil.Address
+	}{
+		{
+			name:    "contract offer",
+			handler: newContractOfferHandler(config.Fee),
+			m:       &offer,
+			code:    protocol.CodeContractFormation,
+			want:    []btcutil.Address{contractAddress, issuerAddress, fee},
+		},
+		{
+			name:    "contract amendment",
+			handler: newContractAmendmentHandler(config.Fee),
+			m:       &amendment,
+			code:    protocol.CodeContractFormation,
+			want:    []btcutil.Address{contractAddress, issuerAddress, fee},
+		},
+		{
+			name:    "asset definition",
+			handler: newAssetDefinitionHandler(config.Fee),
+			m:       &definition,
+			code:    protocol.CodeAssetCreation,
+			want:    []btcutil.Address{contractAddress, issuerAddress, fee},
+		},
+		{
+			name:    "asset modification",
+			handler: newAssetModificationHandler(config.Fee),
+			m:       &modification,
+			code:    protocol.CodeAssetCreation,
+			want:    []btcutil.Address{contractAddress, issuerAddress, fee},
+		},
+		{
+			name:      "send",
+			handler:   newSendHandler(config.Fee),
+			m:         &send,
+			receivers: sendReceivers,
+			code:      protocol.CodeSettlement,
+			want:      []btcutil.Address{issuerAddress, party2Address, contractAddress, fee},
+		},
+		{
+			name:    "exchange",
+			handler: newExchangeHandler(config.Fee),
+			m:       &exchange,
+			code:    protocol.CodeSettlement,
+			want: []btcutil.Address{issuerAddress, party2Address, contractAddress,
+				decodeAddress(exchangeFeeAddr), fee},
+		},
+		{
+			name:    "exchange fee, no contract fee",
+			handler: newExchangeHandler(noFee),
+			m:       &exchange,
+			code:    protocol.CodeSettlement,
+			want: []btcutil.Address{issuerAddress, party2Address, contractAddress,
+				decodeAddress(exchangeFeeAddr)},
+		},
+		{
+			name:    "freeze",
+			handler: newOrderHandler(config.Fee),
+			m:       newOrder(protocol.ComplianceActionFreeze),
+			code:    protocol.CodeFreeze,
+			want:    []btcutil.Address{party2Address, contractAddress, fee},
+		},
+		{
+			name:    "thaw",
+			handler: newOrderHandler(config.Fee),
+			m:       newOrder(protocol.ComplianceActionThaw),
+			code:    protocol.CodeThaw,
+			want:    []btcutil.Address{party2Address, contractAddress, fee},
+		},
+		{
+			name:    "confiscation",
+			handler: newOrderHandler(config.Fee),
+			m:       newOrder(protocol.ComplianceActionConfiscation),
+			code:    protocol.CodeConfiscation,
+			want:    []btcutil.Address{party2Address, issuerAddress, contractAddress, fee},
+		},
+		{
+			name:    "initiative",
+			handler: newInitiativeHandler(),
+			m:       &initiative,
+			code:    protocol.CodeVote,
+			want:    []btcutil.Address{issuerAddress},
+		},
+		{
+			name:    "referendum",
+			handler: newReferendumHandler(),
+			m:       &referendum,
+			code:    protocol.CodeVote,
+			want:    []btcutil.Address{issuerAddress},
+		},
+		{
+			name:    "message",
+			handler: newMessageHandler(config.Fee, 0),
+			m:       &message,
+			code:    protocol.CodeMessage,
+			want:    []btcutil.Address{party2Address, fee},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.receivers == nil {
+				tt.receivers = receivers
+			}
+
+			req := contractRequest{
+				tx:        tx,
+				contract:  newContract(),
+				senders:   []btcutil.Address{issuerAddress},
+				receivers: tt.receivers,
+				m:         tt.m,
+				params:    &chaincfg.MainNetParams,
+			}
+
+			resp, err := tt.handler.handle(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if resp.Message.Type() != tt.code {
+				t.Fatalf("got response %v, want %v", resp.Message.Type(), tt.code)
+			}
+
+			if len(resp.outs) != len(tt.want) {
+				t.Fatalf("got %d outputs, want %d", len(resp.outs), len(tt.want))
+			}
+
+			for i, o := range resp.outs {
+				if o.Address.EncodeAddress() != tt.want[i].EncodeAddress() {
+					t.Errorf("output %d %s : got %s, want %s", i, responseLayouts[tt.code][i],
+						o.Address.EncodeAddress(), tt.want[i].EncodeAddress())
+				}
+
+				if o.Value < txbuilder.DustMinimumOutput {
+					t.Errorf("output %d is dust : %d", i, o.Value)
+				}
+			}
+		})
+	}
+}
@@ -3,7 +3,6 @@ package request
 import (
 	"context"
 	"errors"
-	"fmt"
 	"time"
 
 	"github.com/tokenized/smart-contract/internal/app/config"
@@ -36,31 +35,36 @@ func (h sendHandler) handle(ctx context.Context,
 	k := string(issue.AssetID)
 	asset, ok := c.Assets[k]
 	if !ok {
-		return nil, fmt.Errorf("send : Asset ID not found : contract=%s assetID=%s", c.ID, issue.AssetID)
+		return nil, protocol.NewRejectionReason(protocol.RejectionCodeAssetNotFound,
+			"asset_id", "asset %s not found", k)
 	}
 
 	// Bounds check for receivers - contract, receiver
 	if len(r.receivers) < 2 {
-		return nil, fmt.Errorf("Missing receivers")
+		return nil, protocol.NewRejectionReason(protocol.RejectionCodeReceiverUnspecified,
+			"", "%d outputs, want 2", len(r.receivers))
 	}
 
 	// Party 1 (Sender)
 	party1Addr := r.senders[0].EncodeAddress()
 	party1Holding, ok := asset.Holdings[party1Addr]
 	if !ok {
-		return nil, fmt.Errorf("send : holding not found contract=%s assetID=%s party1=%s", c.ID, issue.AssetID, party1Addr)
+		return nil, protocol.NewRejectionReason(protocol.RejectionCodeInsufficientAssets,
+			"token_qty", "%s holds none of asset %s", party1Addr, k)
 	}
 	party1Balance := party1Holding.Balance
 
 	// Check the token balance
 	if party1Balance < issue.TokenQty {
-		return nil, fmt.Errorf("send : insufficient assets contract=%s assetID=%s party1=%s", c.ID, issue.AssetID, party1Addr)
+		return nil, protocol.NewRejectionReason(protocol.RejectionCodeInsufficientAssets,
+			"token_qty", "holding %d is less than %d", party1Balance, issue.TokenQty)
 	}
 
 	// Party 2 (Receiver)
 	party2Addr := r.receivers[1].Address.EncodeAddress()
 	if party1Addr == party2Addr {
-		return nil, fmt.Errorf("send : cannot transfer to own self contract=%s assetID=%s party1=%s", c.ID, issue.AssetID, party1Addr)
+		return nil, protocol.NewRejectionReason(protocol.RejectionCodeTransferSelf,
+			"", "%s sends to itself", party1Addr)
 	}
 
 	party2Holding, ok := asset.Holdings[party2Addr]
@@ -100,36 +104,21 @@ func (h sendHandler) buildOutputs(r contractRequest) ([]txbuilder.TxOutput, erro
 	party1Addr := r.senders[0]
 	party2Addr := r.receivers[1].Address
 
-	contractAddress, err := r.contract.Address()
+	contractAddress, err := r.contract.Address(r.params)
 	if err != nil {
 		return nil, err
 	}
 
 	// the TX needs to pay to the Receiver as well, so add that here.
-	outs := []txbuilder.TxOutput{
-		txbuilder.TxOutput{
-			Address: party1Addr,
-			Value:   dustLimit, // any change will be added to this output value
-		},
-		txbuilder.TxOutput{
-			Address: party2Addr,
-			Value:   dustLimit,
-		},
-		txbuilder.TxOutput{
-			Address: contractAddress,
-			Value:   dustLimit,
-		},
-	}
+	layout := newResponseLayout(protocol.CodeSettlement).
+		notify(roleParty1, party1Addr).
+		notify(roleParty2, party2Addr).
+		notify(roleContract, contractAddress)
 
 	// optional contract fee
 	if h.Fee.Value > 0 {
-		feeOutput := txbuilder.TxOutput{
-			Address: h.Fee.Address,
-			Value:   h.Fee.Value,
-		}
-
-		outs = append(outs, feeOutput)
+		layout.pay(roleFee, h.Fee.Address, h.Fee.Value)
 	}
 
-	return outs, nil
+	return layout.build()
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcutil"
 	"github.com/tokenized/smart-contract/internal/app/state/contract"
 	"github.com/tokenized/smart-contract/pkg/protocol"
@@ -63,6 +64,7 @@ func TestSendHandler_handle(t *testing.T) {
 		senders:   senders,
 		receivers: receivers,
 		m:         &issue,
+		params:    &chaincfg.MainNetParams,
 	}
 
 	config := newTestConfig()
@@ -98,3 +100,72 @@ func TestSendHandler_handle(t *testing.T) {
 		t.Fatalf("got\n%+v\nwant\n%+v", settlement, &wantSettlement)
 	}
 }
+
+func TestSendHandler_handle_insufficientAssets(t *testing.T) {
+	ctx := newSilentContext()
+
+	contractAddr := "1DNTgNSWtTestKs7j1DwaoxmSc4q9sEUsb"
+
+	issuerAddr := "13FzCGiNWaUHCWGvuLobWM7iaNyP3TJAJg"
+	receiverAddr := "123h2RL1DT4AuYyJUseGxcXSAe5imPSeLV"
+
+	asset := contract.Asset{
+		ID:  "foo",
+		Qty: 20,
+		Holdings: map[string]contract.Holding{
+			issuerAddr: contract.Holding{
+				Address: issuerAddr,
+				Balance: 20,
+			},
+		},
+	}
+
+	c := contract.Contract{
+		ID:            contractAddr,
+		IssuerAddress: issuerAddr,
+		Assets: map[string]contract.Asset{
+			asset.ID: asset,
+		},
+	}
+
+	issue := protocol.NewSend()
+	issue.AssetID = []byte(asset.ID)
+	issue.AssetType = []byte("RRE")
+	issue.TokenQty = 21
+
+	req := contractRequest{
+		contract: c,
+		senders: []btcutil.Address{
+			decodeAddress(issuerAddr),
+		},
+		receivers: []txbuilder.TxOutput{
+			txbuilder.TxOutput{},
+			txbuilder.TxOutput{
+				Address: decodeAddress(receiverAddr),
+			},
+		},
+		m:      &issue,
+		params: &chaincfg.MainNetParams,
+	}
+
+	config := newTestConfig()
+
+	h := newSendHandler(config.Fee)
+	_, err := h.handle(ctx, req)
+
+	// the failure is answered with a rejection
+	reason, ok := err.(*protocol.RejectionReason)
+	if !ok {
+		t.Fatalf("got error %#v, want *protocol.RejectionReason", err)
+	}
+
+	want := protocol.RejectionReason{
+		Code:  protocol.RejectionCodeInsufficientAssets,
+		Field: "token_qty",
+		Text:  "holding 20 is less than 21",
+	}
+
+	if *reason != want {
+		t.Errorf("got %+v, want %+v", *reason, want)
+	}
+}
@@ -0,0 +1,22 @@
+package response
+
+import (
+	"context"
+
+	"github.com/tokenized/smart-contract/internal/app/inspector"
+	"github.com/tokenized/smart-contract/internal/app/state/contract"
+)
+
+// messageHandler handles a Message relayed to holders by the contract. A
+// relayed Message does not change the state of the contract.
+type messageHandler struct{}
+
+func newMessageHandler() messageHandler {
+	return messageHandler{}
+}
+
+func (h messageHandler) process(ctx context.Context,
+	itx *inspector.Transaction, c *contract.Contract) error {
+
+	return nil
+}
@@ -32,6 +32,7 @@ var (
 		protocol.CodeConfiscation:      true,
 		protocol.CodeReconciliation:    true,
 		protocol.CodeRejection:         true,
+		protocol.CodeMessage:           true,
 	}
 )
 
@@ -47,6 +48,7 @@ func newResponseHandlers(state state.StateInterface,
 		protocol.CodeConfiscation:      newConfiscationHandler(),
 		protocol.CodeReconciliation:    newReconciliationHandler(),
 		protocol.CodeRejection:         newRejectionHandler(),
+		protocol.CodeMessage:           newMessageHandler(),
 		// protocol.CodeVote:              newVoteHandler(),
 		// protocol.CodeBallotCounted:     newBallotCountedHandler(),
 		// protocol.CodeResult:            newResultHandler(),
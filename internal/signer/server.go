@@ -0,0 +1,195 @@
+package signer
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+
+	"github.com/tokenized/smart-contract/internal/app/logger"
+	"github.com/tokenized/smart-contract/pkg/txbuilder"
+	"github.com/tokenized/smart-contract/pkg/txscript"
+	"github.com/tokenized/smart-contract/pkg/wire"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+)
+
+// Server signs inputs for authenticated clients.
+//
+// Only P2PKH outputs paying to the key of the Server are signed, with
+// SIGHASH_ALL and SIGHASH_FORKID, so a client can not have the key sign
+// anything else.
+type Server struct {
+	Signer txbuilder.Signer
+	Params *chaincfg.Params
+	secret []byte
+}
+
+// NewServer returns a Server signing with the Signer for clients that know
+// the secret.
+func NewServer(signer txbuilder.Signer,
+	params *chaincfg.Params,
+	secret []byte) Server {
+
+	return Server{
+		Signer: signer,
+		Params: params,
+		secret: secret,
+	}
+}
+
+// Listen returns a listener on a Unix socket at path, that only the owner
+// of the process can connect to. An old socket at path is removed.
+func Listen(path string) (net.Listener, error) {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Chmod(path, 0600); err != nil {
+		l.Close()
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// Serve accepts connections on the listener until it is closed.
+func (s Server) Serve(ctx context.Context, l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+
+		go s.serveConn(ctx, conn)
+	}
+}
+
+// serveConn authenticates a client, then answers its requests until it
+// disconnects.
+func (s Server) serveConn(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+
+	log := logger.NewLoggerFromContext(ctx).Sugar()
+
+	challenge := make([]byte, challengeSize)
+	if _, err := rand.Read(challenge); err != nil {
+		log.Errorf("Failed to create challenge : %v", err)
+		return
+	}
+
+	if _, err := conn.Write(challenge); err != nil {
+		return
+	}
+
+	reader := bufio.NewReaderSize(conn, 4096)
+
+	got := make([]byte, len(answer(s.secret, challenge)))
+	if _, err := io.ReadFull(reader, got); err != nil {
+		return
+	}
+
+	if !hmac.Equal(got, answer(s.secret, challenge)) {
+		log.Warn("Refused client with wrong secret")
+		return
+	}
+
+	encoder := json.NewEncoder(conn)
+
+	for {
+		line, err := readLine(reader)
+		if err != nil {
+			return
+		}
+
+		res := s.handle(ctx, line)
+		if err := encoder.Encode(res); err != nil {
+			return
+		}
+	}
+}
+
+// handle returns the response to a request.
+func (s Server) handle(ctx context.Context, line []byte) response {
+	req := request{}
+	if err := json.Unmarshal(line, &req); err != nil {
+		return response{Error: err.Error()}
+	}
+
+	switch req.Method {
+	case methodPublicKey:
+		return response{
+			PublicKey: hex.EncodeToString(s.Signer.PublicKey().SerializeCompressed()),
+		}
+
+	case methodSign:
+		sig, err := s.sign(ctx, req)
+		if err != nil {
+			return response{Error: err.Error()}
+		}
+
+		return response{Signature: hex.EncodeToString(sig)}
+	}
+
+	return response{Error: fmt.Sprintf("unknown method %s", req.Method)}
+}
+
+// sign returns the signature for an input, if the Server will sign it.
+func (s Server) sign(ctx context.Context, req request) ([]byte, error) {
+	hashType := txscript.SigHashType(req.HashType)
+	if hashType != txscript.SigHashAll+txbuilder.SigHashForkID {
+		return nil, fmt.Errorf("refusing hash type %#x", req.HashType)
+	}
+
+	raw, err := hex.DecodeString(req.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := wire.MsgTx{}
+	if err := tx.Deserialize(bytes.NewReader(raw)); err != nil {
+		return nil, err
+	}
+
+	if req.Index < 0 || req.Index >= len(tx.TxIn) {
+		return nil, fmt.Errorf("no input %d", req.Index)
+	}
+
+	pkScript, err := hex.DecodeString(req.PkScript)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := btcutil.NewAddressPubKeyHash(
+		btcutil.Hash160(s.Signer.PublicKey().SerializeCompressed()), s.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	own, err := txscript.PayToAddrScript(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(pkScript, own) {
+		return nil, fmt.Errorf("refusing to sign output not paying %s", address.EncodeAddress())
+	}
+
+	log := logger.NewLoggerFromContext(ctx).Sugar()
+	log.Infof("Signing input %d of %s", req.Index, tx.TxHash())
+
+	return s.Signer.SignInput(&tx, req.Index, pkScript, req.Value, hashType)
+}
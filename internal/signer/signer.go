@@ -0,0 +1,306 @@
+package signer
+
+/**
+ * Signer Service
+ *
+ * What is my purpose?
+ * - You hold the contract key, away from the contract node
+ * - You sign inputs for the contract node, and give back signatures
+ * - You refuse to talk to anyone who does not know the secret
+ *
+ * The protocol runs over a Unix socket. When a client connects the server
+ * sends a random challenge, and the client answers with the HMAC-SHA256 of
+ * the challenge keyed with the shared secret. If the answer is wrong the
+ * connection is closed.
+ *
+ * After that each request and response is a line of JSON.
+ */
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/tokenized/smart-contract/pkg/txscript"
+	"github.com/tokenized/smart-contract/pkg/wire"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+const (
+	// challengeSize is the size of the challenge sent to a client.
+	challengeSize = 32
+
+	// maxLineSize is the largest request or response read.
+	maxLineSize = 4 * 1024 * 1024
+
+	// callTimeout is the longest a request, or connecting, may take before
+	// the connection is dropped.
+	callTimeout = time.Second * 10
+
+	methodPublicKey = "public_key"
+	methodSign      = "sign"
+)
+
+var (
+	// ErrNotAuthorized is returned when the secret of a client is refused.
+	ErrNotAuthorized = errors.New("not authorized by signer")
+)
+
+// request is sent by the client.
+type request struct {
+	Method   string `json:"method"`
+	Tx       string `json:"tx,omitempty"`
+	Index    int    `json:"index,omitempty"`
+	PkScript string `json:"pk_script,omitempty"`
+	Value    uint64 `json:"value,omitempty"`
+	HashType uint32 `json:"hash_type,omitempty"`
+}
+
+// response is sent by the server.
+type response struct {
+	PublicKey string `json:"public_key,omitempty"`
+	Signature string `json:"signature,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// answer returns the answer to a challenge for the secret.
+func answer(secret, challenge []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(challenge)
+
+	return mac.Sum(nil)
+}
+
+// Client is a txbuilder.Signer that asks a signer daemon for signatures.
+//
+// If the connection to the signer daemon is lost, or a request takes too
+// long, the Client connects again on the next request.
+type Client struct {
+	path      string
+	secret    []byte
+	timeout   time.Duration
+	conn      net.Conn
+	reader    *bufio.Reader
+	answered  bool
+	publicKey *btcec.PublicKey
+	mu        *sync.Mutex
+}
+
+// Dial connects to the signer daemon listening on the Unix socket at path,
+// and authenticates with the secret.
+func Dial(path string, secret []byte) (*Client, error) {
+	return dial(path, secret, callTimeout)
+}
+
+// dial connects to the signer daemon, giving up on any request that takes
+// longer than the timeout.
+func dial(path string, secret []byte, timeout time.Duration) (*Client, error) {
+	c := &Client{
+		path:    path,
+		secret:  secret,
+		timeout: timeout,
+		mu:      &sync.Mutex{},
+	}
+
+	res, err := c.call(request{Method: methodPublicKey})
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+
+	b, err := hex.DecodeString(res.PublicKey)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+
+	c.publicKey, err = btcec.ParsePubKey(b, btcec.S256())
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Close closes the connection to the signer daemon.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn == nil {
+		return nil
+	}
+
+	err := c.conn.Close()
+	c.conn = nil
+
+	return err
+}
+
+// PublicKey implements the txbuilder.Signer interface.
+func (c *Client) PublicKey() *btcec.PublicKey {
+	return c.publicKey
+}
+
+// SignInput implements the txbuilder.Signer interface.
+//
+// The whole tx is sent, so the signer daemon works out the hash it signs.
+func (c *Client) SignInput(tx *wire.MsgTx,
+	index int,
+	pkScript []byte,
+	value uint64,
+	hashType txscript.SigHashType) ([]byte, error) {
+
+	raw, err := serializeTx(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.call(request{
+		Method:   methodSign,
+		Tx:       raw,
+		Index:    index,
+		PkScript: hex.EncodeToString(pkScript),
+		Value:    value,
+		HashType: uint32(hashType),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hex.DecodeString(res.Signature)
+}
+
+// call sends a request and reads the response, connecting first if
+// needed. Only one request is sent at a time.
+//
+// If the signer daemon does not answer within the timeout, the connection
+// is dropped and an error is returned.
+func (c *Client) call(req request) (*response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn == nil {
+		if err := c.connect(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.conn.SetDeadline(time.Now().Add(c.timeout)); err != nil {
+		c.conn.Close()
+		c.conn = nil
+		return nil, err
+	}
+
+	line, err := c.send(req)
+	if err != nil {
+		c.conn.Close()
+		c.conn = nil
+		return nil, err
+	}
+
+	res := response{}
+	if err := json.Unmarshal(line, &res); err != nil {
+		return nil, err
+	}
+
+	if len(res.Error) > 0 {
+		return nil, fmt.Errorf("signer : %s", res.Error)
+	}
+
+	return &res, nil
+}
+
+// connect opens a connection to the signer daemon, and answers its
+// challenge.
+func (c *Client) connect() error {
+	conn, err := net.DialTimeout("unix", c.path, c.timeout)
+	if err != nil {
+		return err
+	}
+
+	if err := conn.SetDeadline(time.Now().Add(c.timeout)); err != nil {
+		conn.Close()
+		return err
+	}
+
+	reader := bufio.NewReaderSize(conn, 4096)
+
+	challenge := make([]byte, challengeSize)
+	if _, err := io.ReadFull(reader, challenge); err != nil {
+		conn.Close()
+		return err
+	}
+
+	if _, err := conn.Write(answer(c.secret, challenge)); err != nil {
+		conn.Close()
+		return err
+	}
+
+	c.conn = conn
+	c.reader = reader
+	c.answered = false
+
+	return nil
+}
+
+// send writes a request and returns the line of the response.
+func (c *Client) send(req request) ([]byte, error) {
+	if err := json.NewEncoder(c.conn).Encode(req); err != nil {
+		return nil, err
+	}
+
+	line, err := readLine(c.reader)
+	if err == io.EOF && !c.answered {
+		// the server closes the connection if the secret is wrong
+		return nil, ErrNotAuthorized
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c.answered = true
+
+	return line, nil
+}
+
+// readLine reads a line, refusing lines longer than maxLineSize.
+func readLine(r *bufio.Reader) ([]byte, error) {
+	line := []byte{}
+
+	for {
+		b, isPrefix, err := r.ReadLine()
+		if err != nil {
+			return nil, err
+		}
+
+		line = append(line, b...)
+		if len(line) > maxLineSize {
+			return nil, errors.New("line too long")
+		}
+
+		if !isPrefix {
+			return line, nil
+		}
+	}
+}
+
+func serializeTx(tx *wire.MsgTx) (string, error) {
+	buf := bytes.Buffer{}
+	if err := tx.Serialize(&buf); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(buf.Bytes()), nil
+}
@@ -0,0 +1,186 @@
+package signer
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/tokenized/smart-contract/internal/app/logger"
+	"github.com/tokenized/smart-contract/pkg/txbuilder"
+	"github.com/tokenized/smart-contract/pkg/txscript"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
+	"go.uber.org/zap"
+)
+
+var testSecret = []byte("secret")
+
+// newTestServer starts a Server for a new key, and returns the path of its
+// socket and the key.
+func newTestServer(t *testing.T) (string, *btcec.PrivateKey, func()) {
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir, err := ioutil.TempDir("", "signer")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(dir, "signer.sock")
+
+	l, err := Listen(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := logger.ContextWithLogger(context.Background(), zap.NewNop())
+
+	s := NewServer(txbuilder.NewKeySigner(key), &chaincfg.MainNetParams, testSecret)
+	go s.Serve(ctx, l)
+
+	return path, key, func() {
+		l.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func newTestAddress(t *testing.T, key *btcec.PrivateKey) btcutil.Address {
+	address, err := btcutil.NewAddressPubKeyHash(
+		btcutil.Hash160(key.PubKey().SerializeCompressed()), &chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return address
+}
+
+func TestClient_SignInput(t *testing.T) {
+	path, key, cleanup := newTestServer(t)
+	defer cleanup()
+
+	client, err := Dial(path, testSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	if !client.PublicKey().IsEqual(key.PubKey()) {
+		t.Fatal("got wrong public key")
+	}
+
+	address := newTestAddress(t, key)
+
+	pkScript, err := txscript.PayToAddrScript(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	utxos := txbuilder.UTXOs{
+		txbuilder.NewUTXO(chainhash.Hash{1}, 0, pkScript, 10000),
+		txbuilder.NewUTXO(chainhash.Hash{2}, 1, pkScript, 5000),
+	}
+
+	other, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	outs := []txbuilder.PayAddress{
+		txbuilder.NewPayAddress(newTestAddress(t, other), 12000),
+	}
+
+	payload := append([]byte{0x6a, 0x14}, make([]byte, 20)...)
+
+	builder := txbuilder.NewTxBuilderWithSigner(client, txbuilder.DefaultFeePolicy())
+
+	tx, _, err := builder.Build(utxos, outs, address, payload, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spent := txbuilder.UTXOs{}
+	for _, in := range tx.TxIn {
+		for _, u := range utxos {
+			if u.Hash == in.PreviousOutPoint.Hash {
+				spent = append(spent, u)
+			}
+		}
+	}
+
+	if err := txbuilder.Verify(tx, spent); err != nil {
+		t.Fatal(err)
+	}
+
+	// the signer only signs outputs paying to its key
+	foreign, err := txscript.PayToAddrScript(newTestAddress(t, other))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.SignInput(tx, 0, foreign, 10000,
+		txscript.SigHashAll+txbuilder.SigHashForkID); err == nil {
+		t.Error("signed output of another key")
+	}
+
+	// and only with SIGHASH_ALL
+	if _, err := client.SignInput(tx, 0, pkScript, 10000,
+		txscript.SigHashNone+txbuilder.SigHashForkID); err == nil {
+		t.Error("signed with SIGHASH_NONE")
+	}
+}
+
+func TestDial_wrongSecret(t *testing.T) {
+	path, _, cleanup := newTestServer(t)
+	defer cleanup()
+
+	if _, err := Dial(path, []byte("wrong")); err != ErrNotAuthorized {
+		t.Fatalf("got err %v, want %v", err, ErrNotAuthorized)
+	}
+}
+
+func TestDial_stalled(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "signer.sock")
+
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	// the daemon sends its challenge, and then never answers
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		conn.Write(make([]byte, challengeSize))
+		time.Sleep(time.Second * 5)
+	}()
+
+	start := time.Now()
+
+	if _, err := dial(path, testSecret, time.Millisecond*100); err == nil {
+		t.Fatal("got no error from a stalled signer")
+	}
+
+	if d := time.Since(start); d > time.Second*2 {
+		t.Errorf("gave up after %v, want about 100ms", d)
+	}
+}
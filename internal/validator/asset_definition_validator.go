@@ -5,7 +5,6 @@ import (
 
 	"github.com/tokenized/smart-contract/internal/app/config"
 	"github.com/tokenized/smart-contract/internal/app/inspector"
-	"github.com/tokenized/smart-contract/internal/app/logger"
 	"github.com/tokenized/smart-contract/internal/app/state/contract"
 	"github.com/tokenized/smart-contract/pkg/protocol"
 )
@@ -21,16 +20,10 @@ func newAssetDefinitionValidator(fee config.Fee) assetDefinitionValidator {
 	}
 }
 
-// can returns a code indicating if the message can be applied to the
-// contract.
-//
-// A return value of 0 (protocol.RejectionCodeOK) indicates that the message
-// can be applied to the Contract. Any non-zero value should be interpreted
-// as the rejection code.
+// validate returns the reason the message can not be applied to the
+// contract, or nil if it can.
 func (h assetDefinitionValidator) validate(ctx context.Context,
-	itx *inspector.Transaction, vd validatorData) uint8 {
-
-	log := logger.NewLoggerFromContext(ctx).Sugar()
+	itx *inspector.Transaction, vd validatorData) *protocol.RejectionReason {
 
 	// Contract and Message
 	c := vd.contract
@@ -40,17 +33,17 @@ func (h assetDefinitionValidator) validate(ctx context.Context,
 	assetID := string(m.AssetID)
 
 	if _, ok := c.Assets[assetID]; ok {
-		log.Errorf("asset definition : Asset ID already exists")
-		return protocol.RejectionCodeDuplicateAssetID
+		return protocol.NewRejectionReason(protocol.RejectionCodeDuplicateAssetID,
+			"asset_id", "asset %s already exists", assetID)
 	}
 
 	// check that the contract can have more assets added.
 	if !h.canHaveMoreAssets(c) {
-		log.Errorf("asset definition : Number of assets exceeds contract Qty")
-		return protocol.RejectionCodeFixedQuantity
+		return protocol.NewRejectionReason(protocol.RejectionCodeFixedQuantity,
+			"", "contract permits %d assets", c.Qty)
 	}
 
-	return protocol.RejectionCodeOK
+	return nil
 }
 
 // canHaveMoreAssets returns true if an Asset can be added to the Contract,
@@ -5,7 +5,6 @@ import (
 
 	"github.com/tokenized/smart-contract/internal/app/config"
 	"github.com/tokenized/smart-contract/internal/app/inspector"
-	"github.com/tokenized/smart-contract/internal/app/logger"
 	"github.com/tokenized/smart-contract/pkg/protocol"
 )
 
@@ -19,16 +18,10 @@ func newAssetModificationValidator(fee config.Fee) assetModificationValidator {
 	}
 }
 
-// can returns a code indicating if the message can be applied to the
-// contract.
-//
-// A return value of 0 (protocol.RejectionCodeOK) indicates that the message
-// can be applied to the Contract. Any non-zero value should be interpreted
-// as the rejection code.
+// validate returns the reason the message can not be applied to the
+// contract, or nil if it can.
 func (h assetModificationValidator) validate(ctx context.Context,
-	itx *inspector.Transaction, vd validatorData) uint8 {
-
-	log := logger.NewLoggerFromContext(ctx).Sugar()
+	itx *inspector.Transaction, vd validatorData) *protocol.RejectionReason {
 
 	// Contract and Message
 	c := vd.contract
@@ -38,8 +31,8 @@ func (h assetModificationValidator) validate(ctx context.Context,
 	assetID := string(m.AssetID)
 	a, ok := c.Assets[assetID]
 	if !ok {
-		log.Errorf("asset modification : Asset ID not found")
-		return protocol.RejectionCodeAssetNotFound
+		return protocol.NewRejectionReason(protocol.RejectionCodeAssetNotFound,
+			"asset_id", "asset %s not found", assetID)
 	}
 
 	// @TODO: When reducing an assets available supply, the amount must
@@ -51,9 +44,10 @@ func (h assetModificationValidator) validate(ctx context.Context,
 
 	// Revision mismatch
 	if a.Revision != m.AssetRevision {
-		log.Errorf("asset modification : Asset Revision does not match current")
-		return protocol.RejectionCodeAssetRevision
+		return protocol.NewRejectionReason(protocol.RejectionCodeAssetRevision,
+			"asset_revision", "revision %d, current revision is %d",
+			m.AssetRevision, a.Revision)
 	}
 
-	return protocol.RejectionCodeOK
+	return nil
 }
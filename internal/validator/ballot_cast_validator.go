@@ -14,14 +14,10 @@ func newBallotCastValidator() ballotCastValidator {
 	return ballotCastValidator{}
 }
 
-// can returns a code indicating if the message can be applied to the
-// contract.
-//
-// A return value of 0 (protocol.RejectionCodeOK) indicates that the message
-// can be applied to the Contract. Any non-zero value should be interpreted
-// as the rejection code.
+// validate returns the reason the message can not be applied to the
+// contract, or nil if it can.
 func (h ballotCastValidator) validate(ctx context.Context,
-	itx *inspector.Transaction, vd validatorData) uint8 {
+	itx *inspector.Transaction, vd validatorData) *protocol.RejectionReason {
 
 	// Contract and Message
 	c := vd.contract
@@ -31,7 +27,8 @@ func (h ballotCastValidator) validate(ctx context.Context,
 	key := string(m.VoteTxnID)
 	vote, ok := c.Votes[key]
 	if !ok {
-		return protocol.RejectionCodeVoteNotFound
+		return protocol.NewRejectionReason(protocol.RejectionCodeVoteNotFound,
+			"vote_txn_id", "vote %x not found", m.VoteTxnID)
 	}
 
 	// Can this person vote
@@ -39,11 +36,11 @@ func (h ballotCastValidator) validate(ctx context.Context,
 	ballot := contract.NewBallotFromBallotCast(sender, m)
 
 	if code := c.CanVote(vote, ballot); code != protocol.RejectionCodeOK {
-		return code
+		return protocol.NewRejectionReason(code, "", "ballot from %s", sender.EncodeAddress())
 	}
 
 	// TODO reject if the asset was received after the vote
 	// If settlement date > vote date = reject
 
-	return protocol.RejectionCodeOK
+	return nil
 }
@@ -6,7 +6,6 @@ import (
 
 	"github.com/tokenized/smart-contract/internal/app/config"
 	"github.com/tokenized/smart-contract/internal/app/inspector"
-	"github.com/tokenized/smart-contract/internal/app/logger"
 	"github.com/tokenized/smart-contract/internal/app/state/contract"
 	"github.com/tokenized/smart-contract/pkg/protocol"
 )
@@ -22,16 +21,10 @@ func newContractAmendmentValidator(fee config.Fee) contractAmendmentValidator {
 	}
 }
 
-// can returns a code indicating if the message can be applied to the
-// contract.
-//
-// A return value of 0 (protocol.RejectionCodeOK) indicates that the message
-// can be applied to the Contract. Any non-zero value should be interpreted
-// as the rejection code.
+// validate returns the reason the message can not be applied to the
+// contract, or nil if it can.
 func (h contractAmendmentValidator) validate(ctx context.Context,
-	itx *inspector.Transaction, vd validatorData) uint8 {
-
-	log := logger.NewLoggerFromContext(ctx).Sugar()
+	itx *inspector.Transaction, vd validatorData) *protocol.RejectionReason {
 
 	// Contract and Message
 	c := vd.contract
@@ -56,11 +49,11 @@ func (h contractAmendmentValidator) validate(ctx context.Context,
 	// Ensure reduction in qty is OK, keeping in mind that zero (0) means
 	// unlimited asset creation is permitted.
 	if c.Qty > 0 && int(m.RestrictedQty) < len(c.Assets) {
-		log.Errorf("contract amendment : Cannot reduce allowable assets below existing number")
-		return protocol.RejectionCodeContractQtyReduction
+		return protocol.NewRejectionReason(protocol.RejectionCodeContractQtyReduction,
+			"restricted_qty", "contract has %d assets", len(c.Assets))
 	}
 
-	return protocol.RejectionCodeOK
+	return nil
 }
 
 // canChangeAuthFlags returns true if the auth flags allow the issuer to
@@ -19,6 +19,6 @@ func newContractOfferValidator(fee config.Fee) contractOfferValidator {
 }
 
 func (h contractOfferValidator) validate(ctx context.Context,
-	itx *inspector.Transaction, vd validatorData) uint8 {
-	return protocol.RejectionCodeOK
+	itx *inspector.Transaction, vd validatorData) *protocol.RejectionReason {
+	return nil
 }
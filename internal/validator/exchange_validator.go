@@ -5,7 +5,6 @@ import (
 
 	"github.com/tokenized/smart-contract/internal/app/config"
 	"github.com/tokenized/smart-contract/internal/app/inspector"
-	"github.com/tokenized/smart-contract/internal/app/logger"
 	"github.com/tokenized/smart-contract/pkg/protocol"
 )
 
@@ -19,16 +18,10 @@ func newExchangeValidator(fee config.Fee) exchangeValidator {
 	}
 }
 
-// can returns a code indicating if the message can be applied to the
-// contract.
-//
-// A return value of 0 (protocol.RejectionCodeOK) indicates that the message
-// can be applied to the Contract. Any non-zero value should be interpreted
-// as the rejection code.
+// validate returns the reason the message can not be applied to the
+// contract, or nil if it can.
 func (h exchangeValidator) validate(ctx context.Context,
-	itx *inspector.Transaction, vd validatorData) uint8 {
-
-	log := logger.NewLoggerFromContext(ctx).Sugar()
+	itx *inspector.Transaction, vd validatorData) *protocol.RejectionReason {
 
 	// Contract and Message
 	c := vd.contract
@@ -38,13 +31,13 @@ func (h exchangeValidator) validate(ctx context.Context,
 	assetKey := string(m.Party1AssetID)
 	asset, ok := c.Assets[assetKey]
 	if !ok {
-		log.Errorf("exchange : Asset ID not found : contract=%s assetID=%s", c.ID, m.Party1AssetID)
-		return protocol.RejectionCodeAssetNotFound
+		return protocol.NewRejectionReason(protocol.RejectionCodeAssetNotFound,
+			"party1_asset_id", "asset %s not found", assetKey)
 	}
 
 	if asset.Holdings == nil {
-		log.Errorf("exchange : No holdings for Asset ID : contract=%s assetID=%s", c.ID, m.Party1AssetID)
-		return protocol.RejectionCodeAssetNotFound
+		return protocol.NewRejectionReason(protocol.RejectionCodeAssetNotFound,
+			"party1_asset_id", "asset %s has no holdings", assetKey)
 	}
 
 	// Party 1: Reject if no holding
@@ -53,30 +46,38 @@ func (h exchangeValidator) validate(ctx context.Context,
 	party1Addr := party1Address.EncodeAddress()
 	party1Holding, ok := asset.Holdings[party1Addr]
 	if !ok {
-		log.Errorf("exchange : Party holding not found contract=%s assetID=%s party1=%s", c.ID, m.Party1AssetID, party1Addr)
-		return protocol.RejectionCodeInsufficientAssets
+		return protocol.NewRejectionReason(protocol.RejectionCodeInsufficientAssets,
+			"party1_token_qty", "%s holds none of asset %s", party1Addr, assetKey)
 	}
 
 	// Check the token balance
 	//
 	if party1Holding.Balance < m.Party1TokenQty {
-		log.Errorf("exchange : Insufficient assets contract=%s assetID=%s party1=%s", c.ID, m.Party1AssetID, party1Addr)
-		return protocol.RejectionCodeInsufficientAssets
+		return protocol.NewRejectionReason(protocol.RejectionCodeInsufficientAssets,
+			"party1_token_qty", "holding %d is less than %d",
+			party1Holding.Balance, m.Party1TokenQty)
 	}
 
 	// Party 1: Frozen assets
 	//
 	// An order is in force
 	if party1Holding.HoldingStatus != nil && !party1Holding.HoldingStatus.Expired() {
-		log.Errorf("exchange : Party has assets frozen")
-		return protocol.RejectionCodeFrozen
+		return protocol.NewRejectionReason(protocol.RejectionCodeFrozen,
+			"", "holding of %s is frozen", party1Addr)
 	}
 
 	// Not enough outputs / Receiver missing
 	//
 	if len(itx.Outputs) < 3 {
-		log.Errorf("exchange : Not enough outputs")
-		return protocol.RejectionCodeReceiverUnspecified
+		return protocol.NewRejectionReason(protocol.RejectionCodeReceiverUnspecified,
+			"", "%d outputs, want 3", len(itx.Outputs))
+	}
+
+	// Party 2 signs an input, so it must have one
+	//
+	if len(itx.InputAddrs) < 2 {
+		return protocol.NewRejectionReason(protocol.RejectionCodeReceiverUnspecified,
+			"", "%d inputs, want 2", len(itx.InputAddrs))
 	}
 
 	// Party 2: Skip if no holding
@@ -87,8 +88,9 @@ func (h exchangeValidator) validate(ctx context.Context,
 
 	// An order is in force
 	if ok && party2Holding.HoldingStatus != nil && !party2Holding.HoldingStatus.Expired() {
-		return protocol.RejectionCodeFrozen
+		return protocol.NewRejectionReason(protocol.RejectionCodeFrozen,
+			"", "holding of %s is frozen", party2Addr)
 	}
 
-	return protocol.RejectionCodeOK
+	return nil
 }
@@ -13,14 +13,10 @@ func newInitiativeValidator() initiativeValidator {
 	return initiativeValidator{}
 }
 
-// can returns a code indicating if the message can be applied to the
-// contract.
-//
-// A return value of 0 (protocol.RejectionCodeOK) indicates that the message
-// can be applied to the Contract. Any non-zero value should be interpreted
-// as the rejection code.
+// validate returns the reason the message can not be applied to the
+// contract, or nil if it can.
 func (h initiativeValidator) validate(ctx context.Context,
-	itx *inspector.Transaction, vd validatorData) uint8 {
+	itx *inspector.Transaction, vd validatorData) *protocol.RejectionReason {
 
 	// Contract and Message
 	c := vd.contract
@@ -32,16 +28,18 @@ func (h initiativeValidator) validate(ctx context.Context,
 	key := hash.String()
 	if _, ok := c.Votes[key]; ok {
 		// a vote already exists, cannot clobber the old one
-		return protocol.RejectionCodeVoteExists
+		return protocol.NewRejectionReason(protocol.RejectionCodeVoteExists,
+			"", "vote %s exists", key)
 	}
 
 	// TODO reject if not from a User (including Issuer)
 	userAddress := itx.InputAddrs[0].EncodeAddress()
 
 	if !c.IsOwner(userAddress) {
-		return protocol.RejectionCodeUnknownAddress
+		return protocol.NewRejectionReason(protocol.RejectionCodeUnknownAddress,
+			"", "%s holds no assets", userAddress)
 	}
 
 	// FIXME fill in auth flags, permit User to act.
-	return protocol.RejectionCodeOK
+	return nil
 }
@@ -0,0 +1,34 @@
+package validator
+
+import (
+	"context"
+
+	"github.com/tokenized/smart-contract/internal/app/inspector"
+	"github.com/tokenized/smart-contract/pkg/protocol"
+)
+
+type messageValidator struct{}
+
+func newMessageValidator() messageValidator {
+	return messageValidator{}
+}
+
+// validate returns the reason the message can not be applied to the
+// contract, or nil if it can.
+//
+// Only the issuer or operator may have the contract relay a Message to its
+// holders.
+func (h messageValidator) validate(ctx context.Context,
+	itx *inspector.Transaction, vd validatorData) *protocol.RejectionReason {
+
+	c := vd.contract
+
+	sender := itx.InputAddrs[0].EncodeAddress()
+
+	if !c.IsIssuer(sender) && !c.IsOperator(sender) {
+		return protocol.NewRejectionReason(protocol.RejectionCodeIssuerAddress,
+			"", "%s is not the issuer or operator", sender)
+	}
+
+	return nil
+}
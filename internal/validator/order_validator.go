@@ -5,7 +5,6 @@ import (
 
 	"github.com/tokenized/smart-contract/internal/app/config"
 	"github.com/tokenized/smart-contract/internal/app/inspector"
-	"github.com/tokenized/smart-contract/internal/app/logger"
 	"github.com/tokenized/smart-contract/pkg/protocol"
 )
 
@@ -19,16 +18,10 @@ func newOrderValidator(fee config.Fee) orderValidator {
 	}
 }
 
-// can returns a code indicating if the message can be applied to the
-// contract.
-//
-// A return value of 0 (protocol.RejectionCodeOK) indicates that the message
-// can be applied to the Contract. Any non-zero value should be interpreted
-// as the rejection code.
+// validate returns the reason the message can not be applied to the
+// contract, or nil if it can.
 func (h orderValidator) validate(ctx context.Context,
-	itx *inspector.Transaction, vd validatorData) uint8 {
-
-	log := logger.NewLoggerFromContext(ctx).Sugar()
+	itx *inspector.Transaction, vd validatorData) *protocol.RejectionReason {
 
 	// Contract and Message
 	c := vd.contract
@@ -38,22 +31,22 @@ func (h orderValidator) validate(ctx context.Context,
 	assetKey := string(m.AssetID)
 	asset, ok := c.Assets[assetKey]
 	if !ok {
-		log.Errorf("order : Asset ID not found : contract=%s assetID=%s", c.ID, m.AssetID)
-		return protocol.RejectionCodeAssetNotFound
+		return protocol.NewRejectionReason(protocol.RejectionCodeAssetNotFound,
+			"asset_id", "asset %s not found", assetKey)
 	}
 
 	if asset.Holdings == nil {
-		log.Errorf("order : No holdings for Asset ID : contract=%s assetID=%s", c.ID, m.AssetID)
-		return protocol.RejectionCodeAssetNotFound
+		return protocol.NewRejectionReason(protocol.RejectionCodeAssetNotFound,
+			"asset_id", "asset %s has no holdings", assetKey)
 	}
 
 	// Party 1 (Target): Reject if no holding
 	party1Addr := string(m.TargetAddress)
 	_, ok = asset.Holdings[party1Addr]
 	if !ok {
-		log.Errorf("exchange : Party holding not found contract=%s assetID=%s party1=%s", c.ID, m.AssetID, party1Addr)
-		return protocol.RejectionCodeInsufficientAssets
+		return protocol.NewRejectionReason(protocol.RejectionCodeInsufficientAssets,
+			"target_address", "%s holds none of asset %s", party1Addr, assetKey)
 	}
 
-	return protocol.RejectionCodeOK
+	return nil
 }
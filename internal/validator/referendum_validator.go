@@ -13,14 +13,10 @@ func newReferendumValidator() referendumValidator {
 	return referendumValidator{}
 }
 
-// can returns a code indicating if the message can be applied to the
-// contract.
-//
-// A return value of 0 (protocol.RejectionCodeOK) indicates that the message
-// can be applied to the Contract. Any non-zero value should be interpreted
-// as the rejection code.
+// validate returns the reason the message can not be applied to the
+// contract, or nil if it can.
 func (h referendumValidator) validate(ctx context.Context,
-	itx *inspector.Transaction, vd validatorData) uint8 {
+	itx *inspector.Transaction, vd validatorData) *protocol.RejectionReason {
 
 	// Contract and Message
 	c := vd.contract
@@ -32,7 +28,8 @@ func (h referendumValidator) validate(ctx context.Context,
 	key := hash.String()
 	if _, ok := c.Votes[key]; ok {
 		// a vote already exists, cannot clobber the old one
-		return protocol.RejectionCodeVoteExists
+		return protocol.NewRejectionReason(protocol.RejectionCodeVoteExists,
+			"", "vote %s exists", key)
 	}
 
 	// TODO reject if not from an Issuer (should already be handled by the
@@ -40,5 +37,5 @@ func (h referendumValidator) validate(ctx context.Context,
 	// ...
 
 	// FIXME fill in auth flags, permit User to act.
-	return protocol.RejectionCodeOK
+	return nil
 }
@@ -5,7 +5,6 @@ import (
 
 	"github.com/tokenized/smart-contract/internal/app/config"
 	"github.com/tokenized/smart-contract/internal/app/inspector"
-	"github.com/tokenized/smart-contract/internal/app/logger"
 	"github.com/tokenized/smart-contract/pkg/protocol"
 )
 
@@ -19,16 +18,10 @@ func newSendValidator(fee config.Fee) sendValidator {
 	}
 }
 
-// can returns a code indicating if the message can be applied to the
-// contract.
-//
-// A return value of 0 (protocol.RejectionCodeOK) indicates that the message
-// can be applied to the Contract. Any non-zero value should be interpreted
-// as the rejection code.
+// validate returns the reason the message can not be applied to the
+// contract, or nil if it can.
 func (h sendValidator) validate(ctx context.Context,
-	itx *inspector.Transaction, vd validatorData) uint8 {
-
-	log := logger.NewLoggerFromContext(ctx).Sugar()
+	itx *inspector.Transaction, vd validatorData) *protocol.RejectionReason {
 
 	// Contract and Message
 	c := vd.contract
@@ -38,13 +31,13 @@ func (h sendValidator) validate(ctx context.Context,
 	assetKey := string(m.AssetID)
 	asset, ok := c.Assets[assetKey]
 	if !ok {
-		log.Errorf("send : Asset ID not found : contract=%s assetID=%s", c.ID, m.AssetID)
-		return protocol.RejectionCodeAssetNotFound
+		return protocol.NewRejectionReason(protocol.RejectionCodeAssetNotFound,
+			"asset_id", "asset %s not found", assetKey)
 	}
 
 	if asset.Holdings == nil {
-		log.Errorf("send : No holdings for Asset ID : contract=%s assetID=%s", c.ID, m.AssetID)
-		return protocol.RejectionCodeAssetNotFound
+		return protocol.NewRejectionReason(protocol.RejectionCodeAssetNotFound,
+			"asset_id", "asset %s has no holdings", assetKey)
 	}
 
 	// Party 1 (Sender): Reject if no holding
@@ -53,15 +46,16 @@ func (h sendValidator) validate(ctx context.Context,
 	party1Addr := party1Address.EncodeAddress()
 	party1Holding, ok := asset.Holdings[party1Addr]
 	if !ok {
-		log.Errorf("send : Party holding not found contract=%s assetID=%s party1=%s", c.ID, m.AssetID, party1Addr)
-		return protocol.RejectionCodeInsufficientAssets
+		return protocol.NewRejectionReason(protocol.RejectionCodeInsufficientAssets,
+			"token_qty", "%s holds none of asset %s", party1Addr, assetKey)
 	}
 
 	// The balance is an unsigned int, so we can't substract without
 	// wrapping. Do a comparison instead.
 	if m.TokenQty > party1Holding.Balance {
-		log.Errorf("send : Insufficient assets contract=%s assetID=%s party1=%s", c.ID, m.AssetID, party1Addr)
-		return protocol.RejectionCodeInsufficientAssets
+		return protocol.NewRejectionReason(protocol.RejectionCodeInsufficientAssets,
+			"token_qty", "holding %d is less than %d",
+			party1Holding.Balance, m.TokenQty)
 	}
 
 	// Party 1: Frozen assets
@@ -71,17 +65,17 @@ func (h sendValidator) validate(ctx context.Context,
 		status := party1Holding.HoldingStatus
 
 		if !status.Expired() {
-			log.Errorf("send : Party has assets frozen")
 			// this order is in force
-			return protocol.RejectionCodeFrozen
+			return protocol.NewRejectionReason(protocol.RejectionCodeFrozen,
+				"", "holding of %s is frozen", party1Addr)
 		}
 	}
 
 	// Not enough outputs / Receiver missing
 	//
 	if len(itx.Outputs) < 2 {
-		log.Errorf("send : Not enough outputs")
-		return protocol.RejectionCodeReceiverUnspecified
+		return protocol.NewRejectionReason(protocol.RejectionCodeReceiverUnspecified,
+			"", "%d outputs, want 2", len(itx.Outputs))
 	}
 
 	// Party 2 (Receiver)
@@ -90,9 +84,9 @@ func (h sendValidator) validate(ctx context.Context,
 	// Cannot transfer to self
 	//
 	if party1Addr == party2Addr {
-		log.Errorf("send : Cannot transfer to own self contract=%s assetID=%s party1=%s", c.ID, m.AssetID, party1Addr)
-		return protocol.RejectionCodeTransferSelf
+		return protocol.NewRejectionReason(protocol.RejectionCodeTransferSelf,
+			"", "%s sends to itself", party1Addr)
 	}
 
-	return protocol.RejectionCodeOK
+	return nil
 }
@@ -4,8 +4,9 @@ import (
 	"context"
 
 	"github.com/tokenized/smart-contract/internal/app/inspector"
+	"github.com/tokenized/smart-contract/pkg/protocol"
 )
 
 type validatorInterface interface {
-	validate(context.Context, *inspector.Transaction, validatorData) uint8
+	validate(context.Context, *inspector.Transaction, validatorData) *protocol.RejectionReason
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/tokenized/smart-contract/internal/app/state"
 	"github.com/tokenized/smart-contract/internal/app/state/contract"
 	"github.com/tokenized/smart-contract/internal/app/wallet"
+	"github.com/tokenized/smart-contract/internal/pool"
 	"github.com/tokenized/smart-contract/pkg/protocol"
 	"github.com/tokenized/smart-contract/pkg/txbuilder"
 	"github.com/tokenized/smart-contract/pkg/wire"
@@ -29,6 +30,17 @@ import (
 var (
 	ErrInsufficientPayment   = errors.New("Insufficient payment")
 	ErrContractAlreadyExists = errors.New("Contract already exists at address")
+
+	// issuerMessageTypes are sent only by the issuer, or the operator, of
+	// the contract.
+	issuerMessageTypes = map[string]bool{
+		protocol.CodeContractAmendment: true,
+		protocol.CodeAssetDefinition:   true,
+		protocol.CodeAssetModification: true,
+		protocol.CodeOrder:             true,
+		protocol.CodeReferendum:        true,
+		protocol.CodeMessage:           true,
+	}
 )
 
 const (
@@ -46,6 +58,7 @@ func newRequestValidators(state state.StateInterface,
 		protocol.CodeSend:              newSendValidator(config.Fee),
 		protocol.CodeExchange:          newExchangeValidator(config.Fee),
 		protocol.CodeOrder:             newOrderValidator(config.Fee),
+		protocol.CodeMessage:           newMessageValidator(),
 		// protocol.CodeInitiative:        newInitiativeValidator(),
 		// protocol.CodeReferendum:        newReferendumValidator(),
 		// protocol.CodeBallotCast:        newBallotCastValidator(),
@@ -56,17 +69,20 @@ type ValidatorService struct {
 	Config     config.Config
 	State      state.StateInterface
 	Wallet     wallet.WalletInterface
+	Pool       pool.PoolService
 	Fees       map[string]uint64
 	validators map[string]validatorInterface
 }
 
 func NewValidatorService(config config.Config,
 	wallet wallet.WalletInterface,
-	state state.StateInterface) ValidatorService {
+	state state.StateInterface,
+	pool pool.PoolService) ValidatorService {
 	return ValidatorService{
 		Config:     config,
 		State:      state,
 		Wallet:     wallet,
+		Pool:       pool,
 		Fees:       protocol.Minimum,
 		validators: newRequestValidators(state, config),
 	}
@@ -95,16 +111,17 @@ func (s ValidatorService) CheckAndFetch(ctx context.Context,
 	// This is the message we need to create a contract for. All other
 	// messages will be able to get this contract
 	contract, err := s.findContract(ctx, itx, contractAddress)
-	if err != nil {
-		if err == ErrContractAlreadyExists {
-			code := protocol.RejectionCodeContractExists
-			newTx, err := s.reject(ctx, itx, code)
-			if err != nil {
-				return nil, nil, err
-			}
-			log.Infof("Rejecting message : Contract already exists")
-			return newTx, nil, nil
+	if err == ErrContractAlreadyExists || err == state.ErrContractNotFound {
+		code := protocol.RejectionCodeContractExists
+		if err == state.ErrContractNotFound {
+			code = protocol.RejectionCodeContractNotFound
 		}
+
+		reason := protocol.NewRejectionReason(code, "", "%s",
+			contractAddress.EncodeAddress())
+		return s.rejectReason(ctx, itx, reason)
+	}
+	if err != nil {
 		return nil, nil, err
 	}
 
@@ -121,13 +138,9 @@ func (s ValidatorService) CheckAndFetch(ctx context.Context,
 	// the txn fee value.
 	if uint64(utxos.Value()) < minimum {
 		// There is insufficient value to fund this transaction.
-		code := protocol.RejectionCodeInsufficientValue
-		newTx, err := s.reject(ctx, itx, code)
-		if err != nil {
-			return nil, nil, err
-		}
-		log.Infof("Rejecting message : Insufficient value provided")
-		return newTx, nil, nil
+		reason := protocol.NewRejectionReason(protocol.RejectionCodeInsufficientValue,
+			"", "paid %d, minimum is %d", utxos.Value(), minimum)
+		return s.rejectReason(ctx, itx, reason)
 	}
 
 	// State: I have seen this already
@@ -135,14 +148,20 @@ func (s ValidatorService) CheckAndFetch(ctx context.Context,
 		return nil, nil, nil
 	}
 
+	// Messages must be sent with the protocol version the contract was
+	// formed with.
+	if m.Protocol() != contract.Protocol() {
+		reason := protocol.NewRejectionReason(protocol.RejectionCodeProtocolVersion,
+			"", "version %#08x, contract formed with %#08x",
+			m.Protocol(), contract.Protocol())
+		return s.rejectReason(ctx, itx, reason)
+	}
+
 	// General permission check
 	if !s.isPermitted(itx, contract) {
-		code := protocol.RejectionCodeIssuerAddress
-		newTx, err := s.reject(ctx, itx, code)
-		if err != nil {
-			return nil, nil, err
-		}
-		return newTx, nil, nil
+		reason := protocol.NewRejectionReason(protocol.RejectionCodeIssuerAddress,
+			"", "%s", itx.InputAddrs[0].EncodeAddress())
+		return s.rejectReason(ctx, itx, reason)
 	}
 
 	// Action based validation
@@ -158,19 +177,30 @@ func (s ValidatorService) CheckAndFetch(ctx context.Context,
 	}
 
 	// Run the custom validator
-	vcode := h.validate(ctx, itx, vdata)
-	if vcode > 0 {
-		newTx, err := s.reject(ctx, itx, vcode)
-		if err != nil {
-			return nil, nil, err
-		}
-		log.Infof("Rejecting message : Custom validator failed")
-		return newTx, nil, nil
+	if reason := h.validate(ctx, itx, vdata); reason != nil {
+		return s.rejectReason(ctx, itx, reason)
 	}
 
 	return nil, contract, nil
 }
 
+// rejectReason builds a Rejection for the reason, as the result of
+// CheckAndFetch.
+func (s ValidatorService) rejectReason(ctx context.Context,
+	itx *inspector.Transaction,
+	reason *protocol.RejectionReason) (*wire.MsgTx, *contract.Contract, error) {
+
+	log := logger.NewLoggerFromContext(ctx).Sugar()
+	log.Infof("Rejecting message : %v", reason)
+
+	newTx, err := s.reject(ctx, itx, reason)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return newTx, nil, nil
+}
+
 // findContract returns a new Contract for a ContractOffer, or finds the
 // existing Contract for all other message types.
 func (s ValidatorService) findContract(ctx context.Context,
@@ -239,37 +269,38 @@ func (s ValidatorService) findContract(ctx context.Context,
 
 // Permission check
 //
+// Messages that only the issuer of the contract may send are refused from
+// any other sender, other than the operator.
 func (s ValidatorService) isPermitted(itx *inspector.Transaction,
 	contract *contract.Contract) bool {
 
 	msg := itx.MsgProto
 	sender := itx.InputAddrs[0]
 
-	if msg.Type() == protocol.CodeContractOffer {
-		// anyone can send a CO
+	if !issuerMessageTypes[msg.Type()] {
+		// anyone can send a CO, and holders send the rest
+		//
+		// TODO what about owners of assets? They can perform certain
+		// operations.
 		return true
 	}
 
-	if contract.IsIssuer(sender.String()) ||
-		contract.IsOperator(sender.String()) {
-
-		return true
-	}
-
-	// TODO what about owners of assets? They can perform certain
-	// operations.
-
-	return true
+	// A multisig issuer or operator is known by its P2SH address, whether
+	// the input spent a P2SH or a bare multisig output.
+	return contract.IsIssuer(sender.EncodeAddress()) ||
+		contract.IsOperator(sender.EncodeAddress())
 }
 
 // reject handles the situation where a message needs to be rejected.
 //
-// A Rejection message will be sent to the network, if there are enough
-// funds issue the message.
+// A Rejection message, with the reason, will be sent to the network. A
+// message that paid MinimumForResponse, but not enough for the Rejection,
+// is topped up from the pool of the contract. An unpaid message is not
+// rejected.
 //
 func (s ValidatorService) reject(ctx context.Context,
 	itx *inspector.Transaction,
-	code uint8) (*wire.MsgTx, error) {
+	reason *protocol.RejectionReason) (*wire.MsgTx, error) {
 
 	// sender is the address that sent the message that we are rejecting.
 	sender := itx.InputAddrs[0]
@@ -277,7 +308,8 @@ func (s ValidatorService) reject(ctx context.Context,
 	// receiver (contract) is the address sending the message (UTXO)
 	receiver := itx.Outputs[0]
 	if receiver.Value < MinimumForResponse {
-		// we did not receive enough to fund the response.
+		// we did not receive enough to fund the response, and the pool
+		// does not pay for rejecting unpaid messages.
 		return nil, ErrInsufficientPayment
 	}
 
@@ -288,7 +320,7 @@ func (s ValidatorService) reject(ctx context.Context,
 	}
 
 	// Rejection protocol message
-	rejection := buildRejectionFromCode(code)
+	rejection := reason.Rejection()
 
 	// sending the message to the sender of the message being rejected
 	outs := []txbuilder.TxOutput{
@@ -309,18 +341,111 @@ func (s ValidatorService) reject(ctx context.Context,
 		return nil, err
 	}
 
-	newTx, err := s.Wallet.BuildTX(key, utxos, outs, changeAddress, &rejection)
+	newTx, report, err := s.Wallet.BuildTX(key, utxos, outs, changeAddress, &rejection, nil)
+	if txbuilder.IsInsufficientValue(err) {
+		log := logger.NewLoggerFromContext(ctx).Sugar()
+		log.Infof("Rejection not paid for : %v", err)
+
+		newTx, report, err = s.topUp(ctx, key, receiver.Address, utxos, outs, &rejection, err)
+		if txbuilder.IsInsufficientValue(err) {
+			return nil, ErrInsufficientPayment
+		}
+	}
+
 	if err != nil {
 		return nil, err
 	}
 
+	log := logger.NewLoggerFromContext(ctx).Sugar()
+	log.Infof("Built rejection %s : %s", newTx.TxHash(), report)
+
 	return newTx, nil
 }
 
-// Build a rejection protocol message
-func buildRejectionFromCode(code uint8) protocol.Rejection {
-	r := protocol.NewRejection()
-	r.RejectionType = code
-	r.Message = protocol.RejectionCodes[code]
-	return r
+// topUp builds a Rejection that spends the pool of the contract as well as
+// the UTXOs of the message. The change is kept by the contract, and the
+// UTXOs spent from the pool are reserved.
+//
+// If the pool can not be used, short is returned.
+func (s ValidatorService) topUp(ctx context.Context,
+	key txbuilder.Signer,
+	contractAddress btcutil.Address,
+	utxos txbuilder.UTXOs,
+	outs []txbuilder.TxOutput,
+	rejection *protocol.Rejection,
+	short error) (*wire.MsgTx, *txbuilder.BuildReport, error) {
+
+	if s.Config.PoolMaxTopUp == 0 {
+		return nil, nil, short
+	}
+
+	available, err := s.Pool.Available(ctx, contractAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(available) == 0 {
+		return nil, nil, short
+	}
+
+	utxos = append(append(txbuilder.UTXOs{}, utxos...), available...)
+
+	tx, report, err := s.Wallet.BuildTX(key, utxos, outs, contractAddress, rejection, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = s.Pool.Reserve(ctx, contractAddress, tx, s.Config.PoolMaxTopUp)
+	if err == pool.ErrTopUpLimit {
+		return nil, nil, short
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log := logger.NewLoggerFromContext(ctx).Sugar()
+	log.Infof("Topped up rejection %s from the pool", tx.TxHash())
+
+	return tx, report, nil
+}
+
+// RejectFailure rejects a request that passed validation, but that could
+// not be responded to. A *protocol.RejectionReason returned by a request
+// handler is sent as it is, and an error from building the response is
+// mapped to a reason.
+//
+// If there is no reason for the error, nil is returned and the request is
+// not rejected.
+func (s ValidatorService) RejectFailure(ctx context.Context,
+	itx *inspector.Transaction,
+	failure error) (*wire.MsgTx, error) {
+
+	reason, ok := failureReason(failure)
+	if !ok {
+		return nil, nil
+	}
+
+	log := logger.NewLoggerFromContext(ctx).Sugar()
+	log.Infof("Rejecting message : %v", reason)
+
+	return s.reject(ctx, itx, reason)
+}
+
+// failureReason returns the rejection reason for an error processing a
+// request. False is returned if there is no reason for the error.
+func failureReason(err error) (*protocol.RejectionReason, bool) {
+	switch e := err.(type) {
+	case *protocol.RejectionReason:
+		return e, true
+
+	case *txbuilder.InsufficientValueError:
+		return protocol.NewRejectionReason(protocol.RejectionCodeInsufficientValue,
+			"", "%v", e), true
+
+	case *txbuilder.DustOutputError:
+		return protocol.NewRejectionReason(protocol.RejectionCodeDustOutput,
+			"", "%v", e), true
+	}
+
+	return nil, false
 }
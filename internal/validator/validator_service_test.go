@@ -0,0 +1,60 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/tokenized/smart-contract/internal/app/inspector"
+	"github.com/tokenized/smart-contract/internal/app/state/contract"
+	"github.com/tokenized/smart-contract/pkg/protocol"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+)
+
+func TestValidatorService_isPermitted(t *testing.T) {
+	params := &chaincfg.MainNetParams
+
+	holder, err := btcutil.DecodeAddress("1Af3Hu5t7HTwLHxfPcgFLB6E3puzT2Ci9C", params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a multisig issuer
+	issuer, err := btcutil.NewAddressScriptHash([]byte{0x52, 0xae}, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := &contract.Contract{
+		IssuerAddress: issuer.EncodeAddress(),
+	}
+
+	amendment := protocol.NewContractAmendment()
+	send := protocol.NewSend()
+
+	tests := []struct {
+		name   string
+		sender btcutil.Address
+		m      protocol.OpReturnMessage
+		want   bool
+	}{
+		{"issuer amendment", issuer, &amendment, true},
+		{"holder amendment", holder, &amendment, false},
+		{"holder send", holder, &send, true},
+	}
+
+	s := ValidatorService{}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			itx := &inspector.Transaction{
+				InputAddrs: []btcutil.Address{tt.sender},
+				MsgProto:   tt.m,
+			}
+
+			if got := s.isPermitted(itx, c); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
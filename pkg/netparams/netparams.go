@@ -0,0 +1,309 @@
+package netparams
+
+/**
+ * Network Parameters
+ *
+ * What is my purpose?
+ * - You tell me which network I am running on
+ * - You tell me how to talk to it, and how to encode addresses for it
+ */
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tokenized/smart-contract/pkg/wire"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+const (
+	MainNetBch wire.BitcoinNet = 0xe8f3e1e3
+	TestNetBch wire.BitcoinNet = 0xf4f3e5f4
+	RegTestBch wire.BitcoinNet = 0xfabfb5da
+	STNBch     wire.BitcoinNet = 0xf9c4cefb
+)
+
+var (
+	// ErrUnknownNetwork is returned when a network name is not recognised.
+	ErrUnknownNetwork = errors.New("Unknown network")
+
+	// ErrCheckpointConflict is returned when a checkpoint is added at the
+	// height of another, with a different hash.
+	ErrCheckpointConflict = errors.New("Checkpoint conflicts with a known checkpoint")
+)
+
+// Checkpoint is a block that is known to be in the chain.
+type Checkpoint struct {
+	Height int32
+	Hash   chainhash.Hash
+}
+
+// Params defines a network.
+type Params struct {
+	// Name is the name used to select the network.
+	Name string
+
+	// Net is the magic bytes that start every message on the network.
+	Net wire.BitcoinNet
+
+	// DefaultPort is the default peer to peer port.
+	DefaultPort string
+
+	// RPCPort is the default port of a node's RPC server.
+	RPCPort string
+
+	// GenesisHash is the hash of the first block in the chain.
+	GenesisHash chainhash.Hash
+
+	// Checkpoints are known blocks, in order of height. Headers are synced
+	// from the most recent checkpoint, and must match any checkpoint they
+	// pass.
+	Checkpoints []Checkpoint
+
+	// Chain holds the parameters used to encode and decode addresses and
+	// keys.
+	//
+	// The address prefixes for testnet, regtest and STN are the same.
+	Chain *chaincfg.Params
+}
+
+// MainNet is the production network.
+var MainNet = Params{
+	Name:        "mainnet",
+	Net:         MainNetBch,
+	DefaultPort: "8333",
+	RPCPort:     "8332",
+	GenesisHash: newHash("000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f"),
+	Checkpoints: []Checkpoint{
+		{11111, newHash("0000000069e244f73d78e8fd29ba2fd2ed618bd6fa2ee92559f542fdb26e7c1d")},
+		{33333, newHash("000000002dd5588a74784eaa7ab0507a18ad16a236e7b1ce69f00d7ddfb5d0a6")},
+		{74000, newHash("0000000000573993a3c9e41ce34471c079dcf5f52a0e824a81e7f953b8661a20")},
+		{105000, newHash("00000000000291ce28027faea320c8d2b054b2e0fe44a773f3eefb151d6bdc97")},
+		{134444, newHash("00000000000005b12ffd4cd315cd34ffd4a594f430ac814c91184a0d42d2b0fe")},
+		{168000, newHash("000000000000099e61ea72015e79632f216fe6cb33d7899acb35b75c8303b763")},
+		{193000, newHash("000000000000059f452a5f7340de6682a977387c17010ff6e6c3bd83ca8b1317")},
+		{210000, newHash("000000000000048b95347e83192f69cf0366076336c639f9b7228e9ba171342e")},
+		{216116, newHash("00000000000001b4f4b433e81ee46494af945cf96014816a4e2370f11b23df4e")},
+		{225430, newHash("00000000000001c108384350f74090433e7fcf79a606b8e797f065b130575932")},
+		{250000, newHash("000000000000003887df1f29024b06fc2200b55f8af8f35453d7be294df2d214")},
+		{267300, newHash("000000000000000a83fbd660e918f218bf37edd92b748ad940483c7c116179ac")},
+		{279000, newHash("0000000000000001ae8c72a0b0c301f67e3afca10e819efa9041e458e9bd7e40")},
+		{300255, newHash("0000000000000000162804527c6e9b9f0563a280525f9d08c12041def0a0f3b2")},
+		{319400, newHash("000000000000000021c6052e9becade189495d1c539aa37c58917305fd15f13b")},
+		{343185, newHash("0000000000000000072b8bf361d01a6ba7d445dd024203fafc78768ed4368554")},
+		{352940, newHash("000000000000000010755df42dba556bb72be6a32f3ce0b6941ce4430152c9ff")},
+		{382320, newHash("00000000000000000a8dc6ed5b133d0eb2fd6af56203e4159789b092defd8ab2")},
+		{400000, newHash("000000000000000004ec466ce4732fe6f1ed1cddc2ed4b328fff5224276e3f6f")},
+		{430000, newHash("000000000000000001868b2bb3a285f3cc6b33ea234eb70facf4dcdf22186b87")},
+		{460000, newHash("000000000000000000ef751bbce8e744ad303c47ece06c8d863e4d417efc258c")},
+
+		// the first block after the split from BTC
+		{478559, newHash("000000000000000000651ef99cb9fcbe0dadde1d424bd9f15ff20136191a5eec")},
+
+		// November 2017 difficulty adjustment
+		{504031, newHash("0000000000000000011ebf65b60d0a3de80b8175be709d653b4c1a1beeb6ab9c")},
+
+		// May 2018 upgrade
+		{530359, newHash("0000000000000000011ada8bd08f46074f44a8f155396f43e38acf9501c49103")},
+
+		// the first block after the split from ABC
+		{556767, newHash("000000000000000001d956714215d96ffc00e0afda4cd0a96c96f8d802b1662b")},
+	},
+	Chain: &chaincfg.MainNetParams,
+}
+
+// TestNet is the public test network.
+var TestNet = Params{
+	Name:        "testnet",
+	Net:         TestNetBch,
+	DefaultPort: "18333",
+	RPCPort:     "18332",
+	GenesisHash: newHash("000000000933ea01ad0ee984209779baaec3ced90fa3f408719526f8d77f4943"),
+	Checkpoints: []Checkpoint{
+		{546, newHash("000000002a936ca763904c3c35fce2f3556c559c0214345d31b1bcebf76acb70")},
+		{100000, newHash("00000000009e2958c15ff9290d571bf9459e93b19765c6801ddeccadbb160a1e")},
+		{200000, newHash("0000000000287bffd321963ef05feab753ebe274e1d78b2fd4e2bfe9ad3aa6f2")},
+		{300001, newHash("0000000000004829474748f3d1bc8fcf893c88be255e6d7f571c548aff57abf4")},
+		{400002, newHash("0000000005e2c73b8ecb82ae2dbc2e8274614ebad7172b53528aba7501f5a089")},
+		{500011, newHash("00000000000929f63977fbac92ff570a9bd9e7715401ee96f2848f7b07750b02")},
+		{600002, newHash("000000000001f471389afd6ee94dcace5ccc44adc18e8bff402443f034b07240")},
+		{700000, newHash("000000000000406178b12a4dea3b27e13b3c4fe4510994fd667d7c1e6a3f4dc1")},
+		{800010, newHash("000000000017ed35296433190b6829db01e657d80631d43f5983fa403bfdb4c1")},
+		{900000, newHash("0000000000356f8d8924556e765b7a94aaebc6b5c8685dcfa2b1ee8b41acd89b")},
+		{1000007, newHash("00000000001ccb893d8a1f25b70ad173ce955e5f50124261bbbc50379a612ddf")},
+		{1100007, newHash("00000000000abc7b2cd18768ab3dee20857326a818d1946ed6796f42d66dd1e8")},
+
+		// November 2017 difficulty adjustment
+		{1155876, newHash("00000000000e38fef93ed9582a7df43815d5c2ba9fd37ef70c9a0ea4a285b8f5")},
+
+		// May 2018 upgrade
+		{1233070, newHash("0000000000000253c6201a2076663cfe4722e4c75f537552cc4ce989d15f7cd5")},
+	},
+	Chain: &chaincfg.TestNet3Params,
+}
+
+// RegTest is the regression test network, for running a private local
+// node.
+var RegTest = Params{
+	Name:        "regtest",
+	Net:         RegTestBch,
+	DefaultPort: "18444",
+	RPCPort:     "18332",
+	GenesisHash: newHash("0f9188f13cb7b2c71f2a335e3a4fc328bf5beb436012afca590b1a11466e2206"),
+	Chain:       &chaincfg.RegressionNetParams,
+}
+
+// STN is the scaling test network.
+var STN = Params{
+	Name:        "stn",
+	Net:         STNBch,
+	DefaultPort: "9333",
+	RPCPort:     "9332",
+	GenesisHash: newHash("000000000933ea01ad0ee984209779baaec3ced90fa3f408719526f8d77f4943"),
+	Chain:       &chaincfg.TestNet3Params,
+}
+
+// networks is a lookup of Params by name.
+var networks = map[string]*Params{
+	MainNet.Name: &MainNet,
+	TestNet.Name: &TestNet,
+	RegTest.Name: &RegTest,
+	STN.Name:     &STN,
+}
+
+// ByName returns the Params for a network name. An empty name is mainnet.
+func ByName(name string) (*Params, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	if len(name) == 0 {
+		return &MainNet, nil
+	}
+
+	p, ok := networks[name]
+	if !ok {
+		return nil, ErrUnknownNetwork
+	}
+
+	return p, nil
+}
+
+// ParseCheckpoints returns the checkpoints in a list of height:hash pairs,
+// separated by commas.
+func ParseCheckpoints(s string) ([]Checkpoint, error) {
+	checkpoints := []Checkpoint{}
+
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if len(pair) == 0 {
+			continue
+		}
+
+		parts := strings.Split(pair, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("Checkpoint %q is not height:hash", pair)
+		}
+
+		height, err := strconv.ParseInt(parts[0], 10, 32)
+		if err != nil || height <= 0 {
+			return nil, fmt.Errorf("Checkpoint %q has an invalid height", pair)
+		}
+
+		hash, err := chainhash.NewHashFromStr(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("Checkpoint %q : %v", pair, err)
+		}
+
+		checkpoints = append(checkpoints, Checkpoint{
+			Height: int32(height),
+			Hash:   *hash,
+		})
+	}
+
+	return checkpoints, nil
+}
+
+// WithCheckpoints returns a copy of the Params with the checkpoints added,
+// in order of height, so a node can be pinned to a recent block.
+//
+// A checkpoint can not replace a known checkpoint with a different hash.
+func (p Params) WithCheckpoints(checkpoints []Checkpoint) (*Params, error) {
+	heights := map[int32]chainhash.Hash{}
+
+	merged := append([]Checkpoint{}, p.Checkpoints...)
+	for _, c := range merged {
+		heights[c.Height] = c.Hash
+	}
+
+	for _, c := range checkpoints {
+		hash, ok := heights[c.Height]
+		if ok && hash != c.Hash {
+			return nil, ErrCheckpointConflict
+		}
+
+		if ok {
+			continue
+		}
+
+		heights[c.Height] = c.Hash
+		merged = append(merged, c)
+	}
+
+	sort.Slice(merged, func(i, j int) bool {
+		return merged[i].Height < merged[j].Height
+	})
+
+	p.Checkpoints = merged
+
+	return &p, nil
+}
+
+// LatestCheckpoint returns the most recent checkpoint, or the genesis
+// block if the network has no checkpoints.
+func (p Params) LatestCheckpoint() Checkpoint {
+	if len(p.Checkpoints) == 0 {
+		return Checkpoint{
+			Height: 0,
+			Hash:   p.GenesisHash,
+		}
+	}
+
+	return p.Checkpoints[len(p.Checkpoints)-1]
+}
+
+// VerifyCheckpoint returns false if there is a checkpoint at the height
+// that does not match the hash, true otherwise.
+func (p Params) VerifyCheckpoint(height int32, hash chainhash.Hash) bool {
+	if height == 0 {
+		return hash == p.GenesisHash
+	}
+
+	for _, c := range p.Checkpoints {
+		if c.Height == height {
+			return c.Hash == hash
+		}
+	}
+
+	return true
+}
+
+// String returns the name of the network.
+func (p Params) String() string {
+	return p.Name
+}
+
+// newHash returns a Hash from a hex string, panicking on invalid input.
+//
+// This is only used for values known at compile time.
+func newHash(s string) chainhash.Hash {
+	h, err := chainhash.NewHashFromStr(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return *h
+}
@@ -0,0 +1,109 @@
+package netparams
+
+import (
+	"testing"
+)
+
+func TestByName(t *testing.T) {
+	tests := []struct {
+		name string
+		want *Params
+		err  error
+	}{
+		{"", &MainNet, nil},
+		{"mainnet", &MainNet, nil},
+		{"TestNet", &TestNet, nil},
+		{" regtest ", &RegTest, nil},
+		{"stn", &STN, nil},
+		{"simnet", nil, ErrUnknownNetwork},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ByName(tt.name)
+			if err != tt.err {
+				t.Fatalf("got %v, want %v", err, tt.err)
+			}
+
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParams_Net(t *testing.T) {
+	// every network must be distinct on the wire
+	seen := map[uint32]string{}
+
+	for name, p := range networks {
+		if other, ok := seen[uint32(p.Net)]; ok {
+			t.Errorf("%v has the same magic as %v", name, other)
+		}
+
+		seen[uint32(p.Net)] = name
+
+		if p.Chain == nil {
+			t.Errorf("%v has no chain params", name)
+		}
+	}
+}
+
+func TestParams_Checkpoints(t *testing.T) {
+	for name, p := range networks {
+		for i := 1; i < len(p.Checkpoints); i++ {
+			if p.Checkpoints[i].Height <= p.Checkpoints[i-1].Height {
+				t.Errorf("%v : checkpoint %d at %d is out of order", name, i,
+					p.Checkpoints[i].Height)
+			}
+		}
+	}
+
+	// mainnet is pinned to the chain after the split from ABC
+	if !MainNet.VerifyCheckpoint(556767, MainNet.LatestCheckpoint().Hash) {
+		t.Errorf("got latest checkpoint %+v, want the split at 556767", MainNet.LatestCheckpoint())
+	}
+}
+
+func TestParams_WithCheckpoints(t *testing.T) {
+	recent := "0000000000000000000000000000000000000000000000000000000000000001"
+
+	checkpoints, err := ParseCheckpoints(" 600000:" + recent + ", ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := MainNet.WithCheckpoints(checkpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	latest := p.LatestCheckpoint()
+	if latest.Height != 600000 || latest.Hash.String() != recent {
+		t.Errorf("got latest checkpoint %+v, want 600000", latest)
+	}
+
+	if len(p.Checkpoints) != len(MainNet.Checkpoints)+1 {
+		t.Errorf("got %d checkpoints, want %d", len(p.Checkpoints), len(MainNet.Checkpoints)+1)
+	}
+
+	// the known checkpoints are not changed
+	if MainNet.LatestCheckpoint().Height != 556767 {
+		t.Errorf("got mainnet latest checkpoint %+v", MainNet.LatestCheckpoint())
+	}
+
+	conflict, err := ParseCheckpoints("556767:" + recent)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := MainNet.WithCheckpoints(conflict); err != ErrCheckpointConflict {
+		t.Errorf("got err %v, want %v", err, ErrCheckpointConflict)
+	}
+
+	for _, s := range []string{"600000", "x:" + recent, "600000:zz"} {
+		if _, err := ParseCheckpoints(s); err == nil {
+			t.Errorf("parsed checkpoint %q", s)
+		}
+	}
+}
@@ -33,3 +33,30 @@ func TestAssetDefinition_Payload(t *testing.T) {
 		t.Errorf("got\n%+v\nwant\n%+v", p, want)
 	}
 }
+
+func TestAssetDefinition_PayloadMessage_assetTypes(t *testing.T) {
+	for code := range AssetTypeMapping {
+		m := NewAssetDefinition()
+		m.AssetType = []byte(code)
+		m.Payload = make([]byte, AssetTypeLen)
+
+		b, err := m.Bytes()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		decoded, err := New(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		p, err := decoded.PayloadMessage()
+		if err != nil {
+			t.Fatalf("%s : %v", code, err)
+		}
+
+		if p.Type() != code {
+			t.Errorf("got asset type %s, want %s", p.Type(), code)
+		}
+	}
+}
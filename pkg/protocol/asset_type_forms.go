@@ -3,6 +3,7 @@ package protocol
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
 )
@@ -10,27 +11,684 @@ import (
 // The code in this file is auto-generated. Do not edit it by hand as it will
 // be overwritten when code is regenerated.
 
+// AssetTypeFormMapping holds a mapping of asset type codes to constructors
+// of asset type forms.
+var AssetTypeFormMapping = map[string]func() PayloadForm{
+
+	CodeAssetTypeBond: func() PayloadForm { return NewAssetTypeBondForm() },
+
+	CodeAssetTypeCoupon: func() PayloadForm { return NewAssetTypeCouponForm() },
+
+	CodeAssetTypeCurrency: func() PayloadForm { return NewAssetTypeCurrencyForm() },
+
+	CodeAssetTypeLoyaltyPoints: func() PayloadForm { return NewAssetTypeLoyaltyPointsForm() },
+
+	CodeAssetTypeMembership: func() PayloadForm { return NewAssetTypeMembershipForm() },
+
+	CodeAssetTypeMovieTicket: func() PayloadForm { return NewAssetTypeMovieTicketForm() },
+
+	CodeAssetTypeShareCommon: func() PayloadForm { return NewAssetTypeShareCommonForm() },
+
+	CodeAssetTypeSharePreferred: func() PayloadForm { return NewAssetTypeSharePreferredForm() },
+
+	CodeAssetTypeTicketAdmission: func() PayloadForm { return NewAssetTypeTicketAdmissionForm() },
+}
+
+// AssetTypeBondForm is a JSON friendly model for an asset type.
+type AssetTypeBondForm struct {
+	Version                 uint8   `json:"version,omitempty"`
+	TradingRestriction      string  `json:"trading_restriction,omitempty"`
+	Currency                string  `json:"currency,omitempty"`
+	FaceValue               float32 `json:"face_value,omitempty"`
+	CouponRate              float32 `json:"coupon_rate,omitempty"`
+	InterestPaymentInterval string  `json:"interest_payment_interval,omitempty"`
+	IssueDate               uint64  `json:"issue_date,omitempty"`
+	MaturityDate            uint64  `json:"maturity_date,omitempty"`
+	ISIN                    string  `json:"isin,omitempty"`
+	Description             string  `json:"description,omitempty"`
+}
+
+// NewAssetTypeBondForm returns a new AssetTypeBondForm.
+func NewAssetTypeBondForm() *AssetTypeBondForm {
+	return &AssetTypeBondForm{}
+}
+
+// Validate returns FieldErrors if validation fails, nil otherwise.
+func (f AssetTypeBondForm) Validate() error {
+	errs := FieldErrors{}
+
+	errs.checkLen("trading_restriction", f.TradingRestriction, 3)
+	errs.checkLen("currency", f.Currency, 3)
+	errs.checkLen("interest_payment_interval", f.InterestPaymentInterval, 1)
+	errs.checkAfter("maturity_date", f.MaturityDate, "issue_date", f.IssueDate)
+	errs.checkLen("isin", f.ISIN, 12)
+	errs.checkLen("description", f.Description, 108)
+
+	return errs.err()
+}
+
+func (f AssetTypeBondForm) pad(b []byte, l int) []byte {
+	if len(b) == l {
+		return b
+	}
+
+	padding := []byte{}
+	c := l - len(b)
+
+	for i := 0; i < c; i++ {
+		padding = append(padding, 0)
+	}
+
+	return append(b, padding...)
+}
+
+// write writes the value to the buffer.
+func (f AssetTypeBondForm) write(buf *bytes.Buffer,
+	v interface{}) error {
+
+	return binary.Write(buf, binary.BigEndian, v)
+}
+
+// writeBytes writes a string of fixed length to the buffer. If the string
+// is longer that the length an error will be returned.
+func (f AssetTypeBondForm) writeBytes(buf *bytes.Buffer,
+	s string, l int) error {
+
+	if len(s) > l {
+		return fmt.Errorf("length exceeds %v", l)
+	}
+
+	b := f.pad([]byte(s), l)
+
+	return f.write(buf, b)
+}
+
+// Bytes returns the form as a []byte that can be read by a protocol message.
+func (f AssetTypeBondForm) Bytes() ([]byte, error) {
+	buf := new(bytes.Buffer)
+
+	if err := f.write(buf, f.Version); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeBytes(buf, f.TradingRestriction, 3); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeBytes(buf, f.Currency, 3); err != nil {
+		return nil, err
+	}
+
+	if err := f.write(buf, f.FaceValue); err != nil {
+		return nil, err
+	}
+
+	if err := f.write(buf, f.CouponRate); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeBytes(buf, f.InterestPaymentInterval, 1); err != nil {
+		return nil, err
+	}
+
+	if err := f.write(buf, f.IssueDate); err != nil {
+		return nil, err
+	}
+
+	if err := f.write(buf, f.MaturityDate); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeBytes(buf, f.ISIN, 12); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeBytes(buf, f.Description, 108); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// PayloadMessage returns a PayloadMessage from the form, if any.
+func (f AssetTypeBondForm) PayloadMessage(code []byte) (PayloadMessage, error) {
+	m, err := NewPayloadMessageFromCode(code)
+	if err != nil {
+		return nil, err
+	}
+
+	if m != nil {
+		return m, nil
+	}
+
+	return nil, errors.New("Not implemented")
+}
+
+// Form returns the form of the asset type, which is its JSON
+// representation.
+func (m AssetTypeBond) Form() *AssetTypeBondForm {
+	return &AssetTypeBondForm{
+		Version:                 m.Version,
+		TradingRestriction:      text(m.TradingRestriction),
+		Currency:                text(m.Currency),
+		FaceValue:               m.FaceValue,
+		CouponRate:              m.CouponRate,
+		InterestPaymentInterval: charText(m.InterestPaymentInterval),
+		IssueDate:               m.IssueDate,
+		MaturityDate:            m.MaturityDate,
+		ISIN:                    text(m.ISIN),
+		Description:             text(m.Description),
+	}
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the asset
+// type as its form.
+func (m AssetTypeBond) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Form())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// asset type from its form.
+func (m *AssetTypeBond) UnmarshalJSON(b []byte) error {
+	f := NewAssetTypeBondForm()
+	if err := json.Unmarshal(b, f); err != nil {
+		return err
+	}
+
+	if err := f.Validate(); err != nil {
+		return err
+	}
+
+	data, err := f.Bytes()
+	if err != nil {
+		return err
+	}
+
+	_, err = m.Write(data)
+	return err
+}
+
 // AssetTypeCouponForm is a JSON friendly model for an asset type.
 type AssetTypeCouponForm struct {
 	Version            uint8  `json:"version,omitempty"`
 	TradingRestriction string `json:"trading_restriction,omitempty"`
 	RedeemingEntity    string `json:"redeeming_entity,omitempty"`
-	ExpiryDate         string `json:"expiry_date,omitempty"`
-	IssueDate          string `json:"issue_date,omitempty"`
+	ExpiryDate         uint64 `json:"expiry_date,omitempty"`
+	IssueDate          uint64 `json:"issue_date,omitempty"`
+	Description        string `json:"description,omitempty"`
+}
+
+// NewAssetTypeCouponForm returns a new AssetTypeCouponForm.
+func NewAssetTypeCouponForm() *AssetTypeCouponForm {
+	return &AssetTypeCouponForm{}
+}
+
+// Validate returns FieldErrors if validation fails, nil otherwise.
+func (f AssetTypeCouponForm) Validate() error {
+	errs := FieldErrors{}
+
+	errs.checkLen("trading_restriction", f.TradingRestriction, 3)
+	errs.checkLen("redeeming_entity", f.RedeemingEntity, 32)
+	errs.checkAfter("expiry_date", f.ExpiryDate, "issue_date", f.IssueDate)
+	errs.checkLen("description", f.Description, 100)
+
+	return errs.err()
+}
+
+func (f AssetTypeCouponForm) pad(b []byte, l int) []byte {
+	if len(b) == l {
+		return b
+	}
+
+	padding := []byte{}
+	c := l - len(b)
+
+	for i := 0; i < c; i++ {
+		padding = append(padding, 0)
+	}
+
+	return append(b, padding...)
+}
+
+// write writes the value to the buffer.
+func (f AssetTypeCouponForm) write(buf *bytes.Buffer,
+	v interface{}) error {
+
+	return binary.Write(buf, binary.BigEndian, v)
+}
+
+// writeBytes writes a string of fixed length to the buffer. If the string
+// is longer that the length an error will be returned.
+func (f AssetTypeCouponForm) writeBytes(buf *bytes.Buffer,
+	s string, l int) error {
+
+	if len(s) > l {
+		return fmt.Errorf("length exceeds %v", l)
+	}
+
+	b := f.pad([]byte(s), l)
+
+	return f.write(buf, b)
+}
+
+// Bytes returns the form as a []byte that can be read by a protocol message.
+func (f AssetTypeCouponForm) Bytes() ([]byte, error) {
+	buf := new(bytes.Buffer)
+
+	if err := f.write(buf, f.Version); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeBytes(buf, f.TradingRestriction, 3); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeBytes(buf, f.RedeemingEntity, 32); err != nil {
+		return nil, err
+	}
+
+	if err := f.write(buf, f.ExpiryDate); err != nil {
+		return nil, err
+	}
+
+	if err := f.write(buf, f.IssueDate); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeBytes(buf, f.Description, 100); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// PayloadMessage returns a PayloadMessage from the form, if any.
+func (f AssetTypeCouponForm) PayloadMessage(code []byte) (PayloadMessage, error) {
+	m, err := NewPayloadMessageFromCode(code)
+	if err != nil {
+		return nil, err
+	}
+
+	if m != nil {
+		return m, nil
+	}
+
+	return nil, errors.New("Not implemented")
+}
+
+// Form returns the form of the asset type, which is its JSON
+// representation.
+func (m AssetTypeCoupon) Form() *AssetTypeCouponForm {
+	return &AssetTypeCouponForm{
+		Version:            m.Version,
+		TradingRestriction: text(m.TradingRestriction),
+		RedeemingEntity:    text(m.RedeemingEntity),
+		ExpiryDate:         m.ExpiryDate,
+		IssueDate:          m.IssueDate,
+		Description:        text(m.Description),
+	}
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the asset
+// type as its form.
+func (m AssetTypeCoupon) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Form())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// asset type from its form.
+func (m *AssetTypeCoupon) UnmarshalJSON(b []byte) error {
+	f := NewAssetTypeCouponForm()
+	if err := json.Unmarshal(b, f); err != nil {
+		return err
+	}
+
+	if err := f.Validate(); err != nil {
+		return err
+	}
+
+	data, err := f.Bytes()
+	if err != nil {
+		return err
+	}
+
+	_, err = m.Write(data)
+	return err
+}
+
+// AssetTypeCurrencyForm is a JSON friendly model for an asset type.
+type AssetTypeCurrencyForm struct {
+	Version            uint8  `json:"version,omitempty"`
+	TradingRestriction string `json:"trading_restriction,omitempty"`
+	ISOCode            string `json:"iso_code,omitempty"`
+	MonetaryAuthority  string `json:"monetary_authority,omitempty"`
+	Precision          uint8  `json:"precision,omitempty"`
 	Description        string `json:"description,omitempty"`
 }
 
-// NewAssetTypeCouponForm returns a new AssetTypeCouponForm.
-func NewAssetTypeCouponForm() *AssetTypeCouponForm {
-	return &AssetTypeCouponForm{}
+// NewAssetTypeCurrencyForm returns a new AssetTypeCurrencyForm.
+func NewAssetTypeCurrencyForm() *AssetTypeCurrencyForm {
+	return &AssetTypeCurrencyForm{}
+}
+
+// Validate returns FieldErrors if validation fails, nil otherwise.
+func (f AssetTypeCurrencyForm) Validate() error {
+	errs := FieldErrors{}
+
+	errs.checkLen("trading_restriction", f.TradingRestriction, 3)
+	errs.checkLen("iso_code", f.ISOCode, 3)
+	errs.checkLen("monetary_authority", f.MonetaryAuthority, 32)
+	errs.checkLen("description", f.Description, 112)
+
+	return errs.err()
+}
+
+func (f AssetTypeCurrencyForm) pad(b []byte, l int) []byte {
+	if len(b) == l {
+		return b
+	}
+
+	padding := []byte{}
+	c := l - len(b)
+
+	for i := 0; i < c; i++ {
+		padding = append(padding, 0)
+	}
+
+	return append(b, padding...)
+}
+
+// write writes the value to the buffer.
+func (f AssetTypeCurrencyForm) write(buf *bytes.Buffer,
+	v interface{}) error {
+
+	return binary.Write(buf, binary.BigEndian, v)
+}
+
+// writeBytes writes a string of fixed length to the buffer. If the string
+// is longer that the length an error will be returned.
+func (f AssetTypeCurrencyForm) writeBytes(buf *bytes.Buffer,
+	s string, l int) error {
+
+	if len(s) > l {
+		return fmt.Errorf("length exceeds %v", l)
+	}
+
+	b := f.pad([]byte(s), l)
+
+	return f.write(buf, b)
+}
+
+// Bytes returns the form as a []byte that can be read by a protocol message.
+func (f AssetTypeCurrencyForm) Bytes() ([]byte, error) {
+	buf := new(bytes.Buffer)
+
+	if err := f.write(buf, f.Version); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeBytes(buf, f.TradingRestriction, 3); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeBytes(buf, f.ISOCode, 3); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeBytes(buf, f.MonetaryAuthority, 32); err != nil {
+		return nil, err
+	}
+
+	if err := f.write(buf, f.Precision); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeBytes(buf, f.Description, 112); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// PayloadMessage returns a PayloadMessage from the form, if any.
+func (f AssetTypeCurrencyForm) PayloadMessage(code []byte) (PayloadMessage, error) {
+	m, err := NewPayloadMessageFromCode(code)
+	if err != nil {
+		return nil, err
+	}
+
+	if m != nil {
+		return m, nil
+	}
+
+	return nil, errors.New("Not implemented")
+}
+
+// Form returns the form of the asset type, which is its JSON
+// representation.
+func (m AssetTypeCurrency) Form() *AssetTypeCurrencyForm {
+	return &AssetTypeCurrencyForm{
+		Version:            m.Version,
+		TradingRestriction: text(m.TradingRestriction),
+		ISOCode:            text(m.ISOCode),
+		MonetaryAuthority:  text(m.MonetaryAuthority),
+		Precision:          m.Precision,
+		Description:        text(m.Description),
+	}
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the asset
+// type as its form.
+func (m AssetTypeCurrency) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Form())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// asset type from its form.
+func (m *AssetTypeCurrency) UnmarshalJSON(b []byte) error {
+	f := NewAssetTypeCurrencyForm()
+	if err := json.Unmarshal(b, f); err != nil {
+		return err
+	}
+
+	if err := f.Validate(); err != nil {
+		return err
+	}
+
+	data, err := f.Bytes()
+	if err != nil {
+		return err
+	}
+
+	_, err = m.Write(data)
+	return err
+}
+
+// AssetTypeLoyaltyPointsForm is a JSON friendly model for an asset type.
+type AssetTypeLoyaltyPointsForm struct {
+	Version             uint8  `json:"version,omitempty"`
+	TradingRestriction  string `json:"trading_restriction,omitempty"`
+	AgeRestriction      string `json:"age_restriction,omitempty"`
+	OfferName           string `json:"offer_name,omitempty"`
+	ValidFrom           uint64 `json:"valid_from,omitempty"`
+	ExpirationTimestamp uint64 `json:"expiration_timestamp,omitempty"`
+	Description         string `json:"description,omitempty"`
+}
+
+// NewAssetTypeLoyaltyPointsForm returns a new AssetTypeLoyaltyPointsForm.
+func NewAssetTypeLoyaltyPointsForm() *AssetTypeLoyaltyPointsForm {
+	return &AssetTypeLoyaltyPointsForm{}
+}
+
+// Validate returns FieldErrors if validation fails, nil otherwise.
+func (f AssetTypeLoyaltyPointsForm) Validate() error {
+	errs := FieldErrors{}
+
+	errs.checkLen("trading_restriction", f.TradingRestriction, 3)
+	errs.checkLen("age_restriction", f.AgeRestriction, 5)
+	errs.checkLen("offer_name", f.OfferName, 32)
+	errs.checkAfter("expiration_timestamp", f.ExpirationTimestamp, "valid_from", f.ValidFrom)
+	errs.checkLen("description", f.Description, 95)
+
+	return errs.err()
+}
+
+func (f AssetTypeLoyaltyPointsForm) pad(b []byte, l int) []byte {
+	if len(b) == l {
+		return b
+	}
+
+	padding := []byte{}
+	c := l - len(b)
+
+	for i := 0; i < c; i++ {
+		padding = append(padding, 0)
+	}
+
+	return append(b, padding...)
+}
+
+// write writes the value to the buffer.
+func (f AssetTypeLoyaltyPointsForm) write(buf *bytes.Buffer,
+	v interface{}) error {
+
+	return binary.Write(buf, binary.BigEndian, v)
+}
+
+// writeBytes writes a string of fixed length to the buffer. If the string
+// is longer that the length an error will be returned.
+func (f AssetTypeLoyaltyPointsForm) writeBytes(buf *bytes.Buffer,
+	s string, l int) error {
+
+	if len(s) > l {
+		return fmt.Errorf("length exceeds %v", l)
+	}
+
+	b := f.pad([]byte(s), l)
+
+	return f.write(buf, b)
+}
+
+// Bytes returns the form as a []byte that can be read by a protocol message.
+func (f AssetTypeLoyaltyPointsForm) Bytes() ([]byte, error) {
+	buf := new(bytes.Buffer)
+
+	if err := f.write(buf, f.Version); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeBytes(buf, f.TradingRestriction, 3); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeBytes(buf, f.AgeRestriction, 5); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeBytes(buf, f.OfferName, 32); err != nil {
+		return nil, err
+	}
+
+	if err := f.write(buf, f.ValidFrom); err != nil {
+		return nil, err
+	}
+
+	if err := f.write(buf, f.ExpirationTimestamp); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeBytes(buf, f.Description, 95); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// PayloadMessage returns a PayloadMessage from the form, if any.
+func (f AssetTypeLoyaltyPointsForm) PayloadMessage(code []byte) (PayloadMessage, error) {
+	m, err := NewPayloadMessageFromCode(code)
+	if err != nil {
+		return nil, err
+	}
+
+	if m != nil {
+		return m, nil
+	}
+
+	return nil, errors.New("Not implemented")
 }
 
-// Validate returns an error if validation fails, nil otherwise.
-func (f AssetTypeCouponForm) Validate() error {
-	return nil
+// Form returns the form of the asset type, which is its JSON
+// representation.
+func (m AssetTypeLoyaltyPoints) Form() *AssetTypeLoyaltyPointsForm {
+	return &AssetTypeLoyaltyPointsForm{
+		Version:             m.Version,
+		TradingRestriction:  text(m.TradingRestriction),
+		AgeRestriction:      text(m.AgeRestriction),
+		OfferName:           text(m.OfferName),
+		ValidFrom:           m.ValidFrom,
+		ExpirationTimestamp: m.ExpirationTimestamp,
+		Description:         text(m.Description),
+	}
 }
 
-func (f AssetTypeCouponForm) pad(b []byte, l int) []byte {
+// MarshalJSON implements the json.Marshaler interface, writing the asset
+// type as its form.
+func (m AssetTypeLoyaltyPoints) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Form())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// asset type from its form.
+func (m *AssetTypeLoyaltyPoints) UnmarshalJSON(b []byte) error {
+	f := NewAssetTypeLoyaltyPointsForm()
+	if err := json.Unmarshal(b, f); err != nil {
+		return err
+	}
+
+	if err := f.Validate(); err != nil {
+		return err
+	}
+
+	data, err := f.Bytes()
+	if err != nil {
+		return err
+	}
+
+	_, err = m.Write(data)
+	return err
+}
+
+// AssetTypeMembershipForm is a JSON friendly model for an asset type.
+type AssetTypeMembershipForm struct {
+	Version             uint8  `json:"version,omitempty"`
+	TradingRestriction  string `json:"trading_restriction,omitempty"`
+	AgeRestriction      string `json:"age_restriction,omitempty"`
+	ValidFrom           uint64 `json:"valid_from,omitempty"`
+	ExpirationTimestamp uint64 `json:"expiration_timestamp,omitempty"`
+	MembershipType      string `json:"membership_type,omitempty"`
+	Description         string `json:"description,omitempty"`
+}
+
+// NewAssetTypeMembershipForm returns a new AssetTypeMembershipForm.
+func NewAssetTypeMembershipForm() *AssetTypeMembershipForm {
+	return &AssetTypeMembershipForm{}
+}
+
+// Validate returns FieldErrors if validation fails, nil otherwise.
+func (f AssetTypeMembershipForm) Validate() error {
+	errs := FieldErrors{}
+
+	errs.checkLen("trading_restriction", f.TradingRestriction, 3)
+	errs.checkLen("age_restriction", f.AgeRestriction, 5)
+	errs.checkAfter("expiration_timestamp", f.ExpirationTimestamp, "valid_from", f.ValidFrom)
+	errs.checkLen("membership_type", f.MembershipType, 16)
+	errs.checkLen("description", f.Description, 111)
+
+	return errs.err()
+}
+
+func (f AssetTypeMembershipForm) pad(b []byte, l int) []byte {
 	if len(b) == l {
 		return b
 	}
@@ -46,7 +704,7 @@ func (f AssetTypeCouponForm) pad(b []byte, l int) []byte {
 }
 
 // write writes the value to the buffer.
-func (f AssetTypeCouponForm) write(buf *bytes.Buffer,
+func (f AssetTypeMembershipForm) write(buf *bytes.Buffer,
 	v interface{}) error {
 
 	return binary.Write(buf, binary.BigEndian, v)
@@ -54,7 +712,7 @@ func (f AssetTypeCouponForm) write(buf *bytes.Buffer,
 
 // writeBytes writes a string of fixed length to the buffer. If the string
 // is longer that the length an error will be returned.
-func (f AssetTypeCouponForm) writeBytes(buf *bytes.Buffer,
+func (f AssetTypeMembershipForm) writeBytes(buf *bytes.Buffer,
 	s string, l int) error {
 
 	if len(s) > l {
@@ -67,7 +725,7 @@ func (f AssetTypeCouponForm) writeBytes(buf *bytes.Buffer,
 }
 
 // Bytes returns the form as a []byte that can be read by a protocol message.
-func (f AssetTypeCouponForm) Bytes() ([]byte, error) {
+func (f AssetTypeMembershipForm) Bytes() ([]byte, error) {
 	buf := new(bytes.Buffer)
 
 	if err := f.write(buf, f.Version); err != nil {
@@ -78,19 +736,23 @@ func (f AssetTypeCouponForm) Bytes() ([]byte, error) {
 		return nil, err
 	}
 
-	if err := f.writeBytes(buf, f.RedeemingEntity, 32); err != nil {
+	if err := f.writeBytes(buf, f.AgeRestriction, 5); err != nil {
 		return nil, err
 	}
 
-	if err := f.writeBytes(buf, f.ExpiryDate, 8); err != nil {
+	if err := f.write(buf, f.ValidFrom); err != nil {
 		return nil, err
 	}
 
-	if err := f.writeBytes(buf, f.IssueDate, 8); err != nil {
+	if err := f.write(buf, f.ExpirationTimestamp); err != nil {
 		return nil, err
 	}
 
-	if err := f.writeBytes(buf, f.Description, 100); err != nil {
+	if err := f.writeBytes(buf, f.MembershipType, 16); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeBytes(buf, f.Description, 111); err != nil {
 		return nil, err
 	}
 
@@ -98,7 +760,7 @@ func (f AssetTypeCouponForm) Bytes() ([]byte, error) {
 }
 
 // PayloadMessage returns a PayloadMessage from the form, if any.
-func (f AssetTypeCouponForm) PayloadMessage(code []byte) (PayloadMessage, error) {
+func (f AssetTypeMembershipForm) PayloadMessage(code []byte) (PayloadMessage, error) {
 	m, err := NewPayloadMessageFromCode(code)
 	if err != nil {
 		return nil, err
@@ -111,14 +773,55 @@ func (f AssetTypeCouponForm) PayloadMessage(code []byte) (PayloadMessage, error)
 	return nil, errors.New("Not implemented")
 }
 
+// Form returns the form of the asset type, which is its JSON
+// representation.
+func (m AssetTypeMembership) Form() *AssetTypeMembershipForm {
+	return &AssetTypeMembershipForm{
+		Version:             m.Version,
+		TradingRestriction:  text(m.TradingRestriction),
+		AgeRestriction:      text(m.AgeRestriction),
+		ValidFrom:           m.ValidFrom,
+		ExpirationTimestamp: m.ExpirationTimestamp,
+		MembershipType:      text(m.MembershipType),
+		Description:         text(m.Description),
+	}
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the asset
+// type as its form.
+func (m AssetTypeMembership) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Form())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// asset type from its form.
+func (m *AssetTypeMembership) UnmarshalJSON(b []byte) error {
+	f := NewAssetTypeMembershipForm()
+	if err := json.Unmarshal(b, f); err != nil {
+		return err
+	}
+
+	if err := f.Validate(); err != nil {
+		return err
+	}
+
+	data, err := f.Bytes()
+	if err != nil {
+		return err
+	}
+
+	_, err = m.Write(data)
+	return err
+}
+
 // AssetTypeMovieTicketForm is a JSON friendly model for an asset type.
 type AssetTypeMovieTicketForm struct {
 	Version             uint8  `json:"version,omitempty"`
 	TradingRestriction  string `json:"trading_restriction,omitempty"`
 	AgeRestriction      string `json:"age_restriction,omitempty"`
 	Venue               string `json:"venue,omitempty"`
-	ValidFrom           string `json:"valid_from,omitempty"`
-	ExpirationTimestamp string `json:"expiration_timestamp,omitempty"`
+	ValidFrom           uint64 `json:"valid_from,omitempty"`
+	ExpirationTimestamp uint64 `json:"expiration_timestamp,omitempty"`
 	Description         string `json:"description,omitempty"`
 }
 
@@ -127,9 +830,17 @@ func NewAssetTypeMovieTicketForm() *AssetTypeMovieTicketForm {
 	return &AssetTypeMovieTicketForm{}
 }
 
-// Validate returns an error if validation fails, nil otherwise.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f AssetTypeMovieTicketForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("trading_restriction", f.TradingRestriction, 3)
+	errs.checkLen("age_restriction", f.AgeRestriction, 5)
+	errs.checkLen("venue", f.Venue, 32)
+	errs.checkAfter("expiration_timestamp", f.ExpirationTimestamp, "valid_from", f.ValidFrom)
+	errs.checkLen("description", f.Description, 95)
+
+	return errs.err()
 }
 
 func (f AssetTypeMovieTicketForm) pad(b []byte, l int) []byte {
@@ -188,11 +899,11 @@ func (f AssetTypeMovieTicketForm) Bytes() ([]byte, error) {
 		return nil, err
 	}
 
-	if err := f.writeBytes(buf, f.ValidFrom, 8); err != nil {
+	if err := f.write(buf, f.ValidFrom); err != nil {
 		return nil, err
 	}
 
-	if err := f.writeBytes(buf, f.ExpirationTimestamp, 8); err != nil {
+	if err := f.write(buf, f.ExpirationTimestamp); err != nil {
 		return nil, err
 	}
 
@@ -217,6 +928,47 @@ func (f AssetTypeMovieTicketForm) PayloadMessage(code []byte) (PayloadMessage, e
 	return nil, errors.New("Not implemented")
 }
 
+// Form returns the form of the asset type, which is its JSON
+// representation.
+func (m AssetTypeMovieTicket) Form() *AssetTypeMovieTicketForm {
+	return &AssetTypeMovieTicketForm{
+		Version:             m.Version,
+		TradingRestriction:  text(m.TradingRestriction),
+		AgeRestriction:      text(m.AgeRestriction),
+		Venue:               text(m.Venue),
+		ValidFrom:           m.ValidFrom,
+		ExpirationTimestamp: m.ExpirationTimestamp,
+		Description:         text(m.Description),
+	}
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the asset
+// type as its form.
+func (m AssetTypeMovieTicket) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Form())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// asset type from its form.
+func (m *AssetTypeMovieTicket) UnmarshalJSON(b []byte) error {
+	f := NewAssetTypeMovieTicketForm()
+	if err := json.Unmarshal(b, f); err != nil {
+		return err
+	}
+
+	if err := f.Validate(); err != nil {
+		return err
+	}
+
+	data, err := f.Bytes()
+	if err != nil {
+		return err
+	}
+
+	_, err = m.Write(data)
+	return err
+}
+
 // AssetTypeShareCommonForm is a JSON friendly model for an asset type.
 type AssetTypeShareCommonForm struct {
 	Version              uint8   `json:"version,omitempty"`
@@ -236,9 +988,19 @@ func NewAssetTypeShareCommonForm() *AssetTypeShareCommonForm {
 	return &AssetTypeShareCommonForm{}
 }
 
-// Validate returns an error if validation fails, nil otherwise.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f AssetTypeShareCommonForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("trading_restriction", f.TradingRestriction, 3)
+	errs.checkLen("dividend_type", f.DividendType, 1)
+	errs.checkLen("distribution_interval", f.DistributionInterval, 1)
+	errs.checkLen("guaranteed", f.Guaranteed, 1)
+	errs.checkLen("ticker", f.Ticker, 5)
+	errs.checkLen("isin", f.ISIN, 12)
+	errs.checkLen("description", f.Description, 120)
+
+	return errs.err()
 }
 
 func (f AssetTypeShareCommonForm) pad(b []byte, l int) []byte {
@@ -338,13 +1100,239 @@ func (f AssetTypeShareCommonForm) PayloadMessage(code []byte) (PayloadMessage, e
 	return nil, errors.New("Not implemented")
 }
 
+// Form returns the form of the asset type, which is its JSON
+// representation.
+func (m AssetTypeShareCommon) Form() *AssetTypeShareCommonForm {
+	return &AssetTypeShareCommonForm{
+		Version:              m.Version,
+		TradingRestriction:   text(m.TradingRestriction),
+		DividendType:         charText(m.DividendType),
+		DividendVar:          m.DividendVar,
+		DividendFixed:        m.DividendFixed,
+		DistributionInterval: charText(m.DistributionInterval),
+		Guaranteed:           charText(m.Guaranteed),
+		Ticker:               text(m.Ticker),
+		ISIN:                 text(m.ISIN),
+		Description:          text(m.Description),
+	}
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the asset
+// type as its form.
+func (m AssetTypeShareCommon) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Form())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// asset type from its form.
+func (m *AssetTypeShareCommon) UnmarshalJSON(b []byte) error {
+	f := NewAssetTypeShareCommonForm()
+	if err := json.Unmarshal(b, f); err != nil {
+		return err
+	}
+
+	if err := f.Validate(); err != nil {
+		return err
+	}
+
+	data, err := f.Bytes()
+	if err != nil {
+		return err
+	}
+
+	_, err = m.Write(data)
+	return err
+}
+
+// AssetTypeSharePreferredForm is a JSON friendly model for an asset type.
+type AssetTypeSharePreferredForm struct {
+	Version              uint8   `json:"version,omitempty"`
+	TradingRestriction   string  `json:"trading_restriction,omitempty"`
+	DividendType         string  `json:"dividend_type,omitempty"`
+	DividendVar          float32 `json:"dividend_var,omitempty"`
+	DividendFixed        float32 `json:"dividend_fixed,omitempty"`
+	DistributionInterval string  `json:"distribution_interval,omitempty"`
+	Cumulative           string  `json:"cumulative,omitempty"`
+	Convertible          string  `json:"convertible,omitempty"`
+	Ticker               string  `json:"ticker,omitempty"`
+	ISIN                 string  `json:"isin,omitempty"`
+	Description          string  `json:"description,omitempty"`
+}
+
+// NewAssetTypeSharePreferredForm returns a new AssetTypeSharePreferredForm.
+func NewAssetTypeSharePreferredForm() *AssetTypeSharePreferredForm {
+	return &AssetTypeSharePreferredForm{}
+}
+
+// Validate returns FieldErrors if validation fails, nil otherwise.
+func (f AssetTypeSharePreferredForm) Validate() error {
+	errs := FieldErrors{}
+
+	errs.checkLen("trading_restriction", f.TradingRestriction, 3)
+	errs.checkLen("dividend_type", f.DividendType, 1)
+	errs.checkLen("distribution_interval", f.DistributionInterval, 1)
+	errs.checkLen("cumulative", f.Cumulative, 1)
+	errs.checkLen("convertible", f.Convertible, 1)
+	errs.checkLen("ticker", f.Ticker, 5)
+	errs.checkLen("isin", f.ISIN, 12)
+	errs.checkLen("description", f.Description, 119)
+
+	return errs.err()
+}
+
+func (f AssetTypeSharePreferredForm) pad(b []byte, l int) []byte {
+	if len(b) == l {
+		return b
+	}
+
+	padding := []byte{}
+	c := l - len(b)
+
+	for i := 0; i < c; i++ {
+		padding = append(padding, 0)
+	}
+
+	return append(b, padding...)
+}
+
+// write writes the value to the buffer.
+func (f AssetTypeSharePreferredForm) write(buf *bytes.Buffer,
+	v interface{}) error {
+
+	return binary.Write(buf, binary.BigEndian, v)
+}
+
+// writeBytes writes a string of fixed length to the buffer. If the string
+// is longer that the length an error will be returned.
+func (f AssetTypeSharePreferredForm) writeBytes(buf *bytes.Buffer,
+	s string, l int) error {
+
+	if len(s) > l {
+		return fmt.Errorf("length exceeds %v", l)
+	}
+
+	b := f.pad([]byte(s), l)
+
+	return f.write(buf, b)
+}
+
+// Bytes returns the form as a []byte that can be read by a protocol message.
+func (f AssetTypeSharePreferredForm) Bytes() ([]byte, error) {
+	buf := new(bytes.Buffer)
+
+	if err := f.write(buf, f.Version); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeBytes(buf, f.TradingRestriction, 3); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeBytes(buf, f.DividendType, 1); err != nil {
+		return nil, err
+	}
+
+	if err := f.write(buf, f.DividendVar); err != nil {
+		return nil, err
+	}
+
+	if err := f.write(buf, f.DividendFixed); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeBytes(buf, f.DistributionInterval, 1); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeBytes(buf, f.Cumulative, 1); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeBytes(buf, f.Convertible, 1); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeBytes(buf, f.Ticker, 5); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeBytes(buf, f.ISIN, 12); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeBytes(buf, f.Description, 119); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// PayloadMessage returns a PayloadMessage from the form, if any.
+func (f AssetTypeSharePreferredForm) PayloadMessage(code []byte) (PayloadMessage, error) {
+	m, err := NewPayloadMessageFromCode(code)
+	if err != nil {
+		return nil, err
+	}
+
+	if m != nil {
+		return m, nil
+	}
+
+	return nil, errors.New("Not implemented")
+}
+
+// Form returns the form of the asset type, which is its JSON
+// representation.
+func (m AssetTypeSharePreferred) Form() *AssetTypeSharePreferredForm {
+	return &AssetTypeSharePreferredForm{
+		Version:              m.Version,
+		TradingRestriction:   text(m.TradingRestriction),
+		DividendType:         charText(m.DividendType),
+		DividendVar:          m.DividendVar,
+		DividendFixed:        m.DividendFixed,
+		DistributionInterval: charText(m.DistributionInterval),
+		Cumulative:           charText(m.Cumulative),
+		Convertible:          charText(m.Convertible),
+		Ticker:               text(m.Ticker),
+		ISIN:                 text(m.ISIN),
+		Description:          text(m.Description),
+	}
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the asset
+// type as its form.
+func (m AssetTypeSharePreferred) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Form())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// asset type from its form.
+func (m *AssetTypeSharePreferred) UnmarshalJSON(b []byte) error {
+	f := NewAssetTypeSharePreferredForm()
+	if err := json.Unmarshal(b, f); err != nil {
+		return err
+	}
+
+	if err := f.Validate(); err != nil {
+		return err
+	}
+
+	data, err := f.Bytes()
+	if err != nil {
+		return err
+	}
+
+	_, err = m.Write(data)
+	return err
+}
+
 // AssetTypeTicketAdmissionForm is a JSON friendly model for an asset type.
 type AssetTypeTicketAdmissionForm struct {
 	Version             uint8  `json:"version,omitempty"`
 	TradingRestriction  string `json:"trading_restriction,omitempty"`
 	AgeRestriction      string `json:"age_restriction,omitempty"`
-	ValidFrom           string `json:"valid_from,omitempty"`
-	ExpirationTimestamp string `json:"expiration_timestamp,omitempty"`
+	ValidFrom           uint64 `json:"valid_from,omitempty"`
+	ExpirationTimestamp uint64 `json:"expiration_timestamp,omitempty"`
 	Description         string `json:"description,omitempty"`
 }
 
@@ -353,9 +1341,16 @@ func NewAssetTypeTicketAdmissionForm() *AssetTypeTicketAdmissionForm {
 	return &AssetTypeTicketAdmissionForm{}
 }
 
-// Validate returns an error if validation fails, nil otherwise.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f AssetTypeTicketAdmissionForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("trading_restriction", f.TradingRestriction, 3)
+	errs.checkLen("age_restriction", f.AgeRestriction, 5)
+	errs.checkAfter("expiration_timestamp", f.ExpirationTimestamp, "valid_from", f.ValidFrom)
+	errs.checkLen("description", f.Description, 127)
+
+	return errs.err()
 }
 
 func (f AssetTypeTicketAdmissionForm) pad(b []byte, l int) []byte {
@@ -410,11 +1405,11 @@ func (f AssetTypeTicketAdmissionForm) Bytes() ([]byte, error) {
 		return nil, err
 	}
 
-	if err := f.writeBytes(buf, f.ValidFrom, 8); err != nil {
+	if err := f.write(buf, f.ValidFrom); err != nil {
 		return nil, err
 	}
 
-	if err := f.writeBytes(buf, f.ExpirationTimestamp, 8); err != nil {
+	if err := f.write(buf, f.ExpirationTimestamp); err != nil {
 		return nil, err
 	}
 
@@ -438,3 +1433,43 @@ func (f AssetTypeTicketAdmissionForm) PayloadMessage(code []byte) (PayloadMessag
 
 	return nil, errors.New("Not implemented")
 }
+
+// Form returns the form of the asset type, which is its JSON
+// representation.
+func (m AssetTypeTicketAdmission) Form() *AssetTypeTicketAdmissionForm {
+	return &AssetTypeTicketAdmissionForm{
+		Version:             m.Version,
+		TradingRestriction:  text(m.TradingRestriction),
+		AgeRestriction:      text(m.AgeRestriction),
+		ValidFrom:           m.ValidFrom,
+		ExpirationTimestamp: m.ExpirationTimestamp,
+		Description:         text(m.Description),
+	}
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the asset
+// type as its form.
+func (m AssetTypeTicketAdmission) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Form())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// asset type from its form.
+func (m *AssetTypeTicketAdmission) UnmarshalJSON(b []byte) error {
+	f := NewAssetTypeTicketAdmissionForm()
+	if err := json.Unmarshal(b, f); err != nil {
+		return err
+	}
+
+	if err := f.Validate(); err != nil {
+		return err
+	}
+
+	data, err := f.Bytes()
+	if err != nil {
+		return err
+	}
+
+	_, err = m.Write(data)
+	return err
+}
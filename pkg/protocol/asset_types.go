@@ -9,48 +9,593 @@ const (
 	// AssetTypeLen is the size in bytes of all asset type variants.
 	AssetTypeLen = 152
 
+	// CodeAssetTypeBond identifies data as a Bond message.
+	CodeAssetTypeBond = "BON"
+
 	// CodeAssetTypeCoupon identifies data as a Coupon message.
 	CodeAssetTypeCoupon = "COU"
 
-	// CodeAssetTypeMovieTicket identifies data as a Movie Ticket message.
-	CodeAssetTypeMovieTicket = "MOV"
+	// CodeAssetTypeCurrency identifies data as a Currency message.
+	CodeAssetTypeCurrency = "CUR"
+
+	// CodeAssetTypeLoyaltyPoints identifies data as a Loyalty Points message.
+	CodeAssetTypeLoyaltyPoints = "LOY"
+
+	// CodeAssetTypeMembership identifies data as a Membership message.
+	CodeAssetTypeMembership = "MEM"
+
+	// CodeAssetTypeMovieTicket identifies data as a Movie Ticket message.
+	CodeAssetTypeMovieTicket = "MOV"
+
+	// CodeAssetTypeShareCommon identifies data as a Share - Common message.
+	CodeAssetTypeShareCommon = "SHC"
+
+	// CodeAssetTypeSharePreferred identifies data as a Share - Preferred message.
+	CodeAssetTypeSharePreferred = "SHP"
+
+	// CodeAssetTypeTicketAdmission identifies data as a Ticket (Admission) message.
+	CodeAssetTypeTicketAdmission = "TIC"
+)
+
+// AssetTypeMapping holds a mapping of asset type codes to constructors of
+// asset types.
+var AssetTypeMapping = map[string]func() PayloadMessage{
+
+	CodeAssetTypeBond: func() PayloadMessage { return NewAssetTypeBond() },
+
+	CodeAssetTypeCoupon: func() PayloadMessage { return NewAssetTypeCoupon() },
+
+	CodeAssetTypeCurrency: func() PayloadMessage { return NewAssetTypeCurrency() },
+
+	CodeAssetTypeLoyaltyPoints: func() PayloadMessage { return NewAssetTypeLoyaltyPoints() },
+
+	CodeAssetTypeMembership: func() PayloadMessage { return NewAssetTypeMembership() },
+
+	CodeAssetTypeMovieTicket: func() PayloadMessage { return NewAssetTypeMovieTicket() },
+
+	CodeAssetTypeShareCommon: func() PayloadMessage { return NewAssetTypeShareCommon() },
+
+	CodeAssetTypeSharePreferred: func() PayloadMessage { return NewAssetTypeSharePreferred() },
+
+	CodeAssetTypeTicketAdmission: func() PayloadMessage { return NewAssetTypeTicketAdmission() },
+}
+
+// AssetTypeBond asset type.
+type AssetTypeBond struct {
+	BaseMessage
+
+	Version                 uint8
+	TradingRestriction      []byte
+	Currency                []byte
+	FaceValue               float32
+	CouponRate              float32
+	InterestPaymentInterval byte
+	IssueDate               uint64
+	MaturityDate            uint64
+	ISIN                    []byte
+	Description             []byte
+}
+
+// NewAssetTypeBond returns a new AssetTypeBond.
+func NewAssetTypeBond() *AssetTypeBond {
+	return &AssetTypeBond{}
+}
+
+// Type returns the type identifer for this message.
+func (m AssetTypeBond) Type() string {
+	return CodeAssetTypeBond
+}
+
+// Len returns the byte size of this message.
+func (m AssetTypeBond) Len() int64 {
+	return AssetTypeLen
+}
+
+// Bytes returns the message in bytes.
+func (m AssetTypeBond) Bytes() ([]byte, error) {
+	buf := new(bytes.Buffer)
+
+	if err := m.write(buf, m.Version); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.pad(m.TradingRestriction, 3)); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.pad(m.Currency, 3)); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.FaceValue); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.CouponRate); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.InterestPaymentInterval); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.IssueDate); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.MaturityDate); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.pad(m.ISIN, 12)); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.pad(m.Description, 108)); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Write implements the io.Writer interface, writing the data in []byte to
+// the receiver.
+func (m *AssetTypeBond) Write(b []byte) (int, error) {
+	buf := bytes.NewBuffer(b)
+
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
+
+	m.TradingRestriction = make([]byte, 3)
+	if err := m.readLen(buf, m.TradingRestriction); err != nil {
+		return 0, err
+	}
+
+	m.TradingRestriction = bytes.Trim(m.TradingRestriction, "\x00")
+
+	m.Currency = make([]byte, 3)
+	if err := m.readLen(buf, m.Currency); err != nil {
+		return 0, err
+	}
+
+	m.Currency = bytes.Trim(m.Currency, "\x00")
+
+	if err := m.read(buf, &m.FaceValue); err != nil {
+		return 0, err
+	}
+
+	if err := m.read(buf, &m.CouponRate); err != nil {
+		return 0, err
+	}
+
+	if err := m.read(buf, &m.InterestPaymentInterval); err != nil {
+		return 0, err
+	}
+
+	if err := m.read(buf, &m.IssueDate); err != nil {
+		return 0, err
+	}
+
+	if err := m.read(buf, &m.MaturityDate); err != nil {
+		return 0, err
+	}
+
+	m.ISIN = make([]byte, 12)
+	if err := m.readLen(buf, m.ISIN); err != nil {
+		return 0, err
+	}
+
+	m.ISIN = bytes.Trim(m.ISIN, "\x00")
+
+	m.Description = make([]byte, 108)
+	if err := m.readLen(buf, m.Description); err != nil {
+		return 0, err
+	}
+
+	m.Description = bytes.Trim(m.Description, "\x00")
+
+	return int(m.Len()), nil
+}
+
+// Read implements the io.Reader interface, writing the receiver to the
+// []byte.
+func (m AssetTypeBond) Read(b []byte) (int, error) {
+	data, err := m.Bytes()
+
+	if err != nil {
+		return 0, err
+	}
+
+	copy(b, data)
+
+	return len(b), nil
+}
+
+// AssetTypeCoupon asset type.
+type AssetTypeCoupon struct {
+	BaseMessage
+
+	Version            uint8
+	TradingRestriction []byte
+	RedeemingEntity    []byte
+	ExpiryDate         uint64
+	IssueDate          uint64
+	Description        []byte
+}
+
+// NewAssetTypeCoupon returns a new AssetTypeCoupon.
+func NewAssetTypeCoupon() *AssetTypeCoupon {
+	return &AssetTypeCoupon{}
+}
+
+// Type returns the type identifer for this message.
+func (m AssetTypeCoupon) Type() string {
+	return CodeAssetTypeCoupon
+}
+
+// Len returns the byte size of this message.
+func (m AssetTypeCoupon) Len() int64 {
+	return AssetTypeLen
+}
+
+// Bytes returns the message in bytes.
+func (m AssetTypeCoupon) Bytes() ([]byte, error) {
+	buf := new(bytes.Buffer)
+
+	if err := m.write(buf, m.Version); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.pad(m.TradingRestriction, 3)); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.pad(m.RedeemingEntity, 32)); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.ExpiryDate); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.IssueDate); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.pad(m.Description, 100)); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Write implements the io.Writer interface, writing the data in []byte to
+// the receiver.
+func (m *AssetTypeCoupon) Write(b []byte) (int, error) {
+	buf := bytes.NewBuffer(b)
+
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
+
+	m.TradingRestriction = make([]byte, 3)
+	if err := m.readLen(buf, m.TradingRestriction); err != nil {
+		return 0, err
+	}
+
+	m.TradingRestriction = bytes.Trim(m.TradingRestriction, "\x00")
+
+	m.RedeemingEntity = make([]byte, 32)
+	if err := m.readLen(buf, m.RedeemingEntity); err != nil {
+		return 0, err
+	}
+
+	m.RedeemingEntity = bytes.Trim(m.RedeemingEntity, "\x00")
+
+	if err := m.read(buf, &m.ExpiryDate); err != nil {
+		return 0, err
+	}
+
+	if err := m.read(buf, &m.IssueDate); err != nil {
+		return 0, err
+	}
+
+	m.Description = make([]byte, 100)
+	if err := m.readLen(buf, m.Description); err != nil {
+		return 0, err
+	}
+
+	m.Description = bytes.Trim(m.Description, "\x00")
+
+	return int(m.Len()), nil
+}
+
+// Read implements the io.Reader interface, writing the receiver to the
+// []byte.
+func (m AssetTypeCoupon) Read(b []byte) (int, error) {
+	data, err := m.Bytes()
+
+	if err != nil {
+		return 0, err
+	}
+
+	copy(b, data)
+
+	return len(b), nil
+}
+
+// AssetTypeCurrency asset type.
+type AssetTypeCurrency struct {
+	BaseMessage
+
+	Version            uint8
+	TradingRestriction []byte
+	ISOCode            []byte
+	MonetaryAuthority  []byte
+	Precision          uint8
+	Description        []byte
+}
+
+// NewAssetTypeCurrency returns a new AssetTypeCurrency.
+func NewAssetTypeCurrency() *AssetTypeCurrency {
+	return &AssetTypeCurrency{}
+}
+
+// Type returns the type identifer for this message.
+func (m AssetTypeCurrency) Type() string {
+	return CodeAssetTypeCurrency
+}
+
+// Len returns the byte size of this message.
+func (m AssetTypeCurrency) Len() int64 {
+	return AssetTypeLen
+}
+
+// Bytes returns the message in bytes.
+func (m AssetTypeCurrency) Bytes() ([]byte, error) {
+	buf := new(bytes.Buffer)
+
+	if err := m.write(buf, m.Version); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.pad(m.TradingRestriction, 3)); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.pad(m.ISOCode, 3)); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.pad(m.MonetaryAuthority, 32)); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.Precision); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.pad(m.Description, 112)); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Write implements the io.Writer interface, writing the data in []byte to
+// the receiver.
+func (m *AssetTypeCurrency) Write(b []byte) (int, error) {
+	buf := bytes.NewBuffer(b)
+
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
+
+	m.TradingRestriction = make([]byte, 3)
+	if err := m.readLen(buf, m.TradingRestriction); err != nil {
+		return 0, err
+	}
+
+	m.TradingRestriction = bytes.Trim(m.TradingRestriction, "\x00")
+
+	m.ISOCode = make([]byte, 3)
+	if err := m.readLen(buf, m.ISOCode); err != nil {
+		return 0, err
+	}
+
+	m.ISOCode = bytes.Trim(m.ISOCode, "\x00")
+
+	m.MonetaryAuthority = make([]byte, 32)
+	if err := m.readLen(buf, m.MonetaryAuthority); err != nil {
+		return 0, err
+	}
+
+	m.MonetaryAuthority = bytes.Trim(m.MonetaryAuthority, "\x00")
+
+	if err := m.read(buf, &m.Precision); err != nil {
+		return 0, err
+	}
+
+	m.Description = make([]byte, 112)
+	if err := m.readLen(buf, m.Description); err != nil {
+		return 0, err
+	}
+
+	m.Description = bytes.Trim(m.Description, "\x00")
+
+	return int(m.Len()), nil
+}
+
+// Read implements the io.Reader interface, writing the receiver to the
+// []byte.
+func (m AssetTypeCurrency) Read(b []byte) (int, error) {
+	data, err := m.Bytes()
+
+	if err != nil {
+		return 0, err
+	}
+
+	copy(b, data)
+
+	return len(b), nil
+}
+
+// AssetTypeLoyaltyPoints asset type.
+type AssetTypeLoyaltyPoints struct {
+	BaseMessage
+
+	Version             uint8
+	TradingRestriction  []byte
+	AgeRestriction      []byte
+	OfferName           []byte
+	ValidFrom           uint64
+	ExpirationTimestamp uint64
+	Description         []byte
+}
+
+// NewAssetTypeLoyaltyPoints returns a new AssetTypeLoyaltyPoints.
+func NewAssetTypeLoyaltyPoints() *AssetTypeLoyaltyPoints {
+	return &AssetTypeLoyaltyPoints{}
+}
+
+// Type returns the type identifer for this message.
+func (m AssetTypeLoyaltyPoints) Type() string {
+	return CodeAssetTypeLoyaltyPoints
+}
+
+// Len returns the byte size of this message.
+func (m AssetTypeLoyaltyPoints) Len() int64 {
+	return AssetTypeLen
+}
+
+// Bytes returns the message in bytes.
+func (m AssetTypeLoyaltyPoints) Bytes() ([]byte, error) {
+	buf := new(bytes.Buffer)
+
+	if err := m.write(buf, m.Version); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.pad(m.TradingRestriction, 3)); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.pad(m.AgeRestriction, 5)); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.pad(m.OfferName, 32)); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.ValidFrom); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.ExpirationTimestamp); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.pad(m.Description, 95)); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
 
-	// CodeAssetTypeShareCommon identifies data as a Share - Common message.
-	CodeAssetTypeShareCommon = "SHC"
+// Write implements the io.Writer interface, writing the data in []byte to
+// the receiver.
+func (m *AssetTypeLoyaltyPoints) Write(b []byte) (int, error) {
+	buf := bytes.NewBuffer(b)
 
-	// CodeAssetTypeTicketAdmission identifies data as a Ticket (Admission) message.
-	CodeAssetTypeTicketAdmission = "TIC"
-)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
-// AssetTypeCoupon asset type.
-type AssetTypeCoupon struct {
+	m.TradingRestriction = make([]byte, 3)
+	if err := m.readLen(buf, m.TradingRestriction); err != nil {
+		return 0, err
+	}
+
+	m.TradingRestriction = bytes.Trim(m.TradingRestriction, "\x00")
+
+	m.AgeRestriction = make([]byte, 5)
+	if err := m.readLen(buf, m.AgeRestriction); err != nil {
+		return 0, err
+	}
+
+	m.AgeRestriction = bytes.Trim(m.AgeRestriction, "\x00")
+
+	m.OfferName = make([]byte, 32)
+	if err := m.readLen(buf, m.OfferName); err != nil {
+		return 0, err
+	}
+
+	m.OfferName = bytes.Trim(m.OfferName, "\x00")
+
+	if err := m.read(buf, &m.ValidFrom); err != nil {
+		return 0, err
+	}
+
+	if err := m.read(buf, &m.ExpirationTimestamp); err != nil {
+		return 0, err
+	}
+
+	m.Description = make([]byte, 95)
+	if err := m.readLen(buf, m.Description); err != nil {
+		return 0, err
+	}
+
+	m.Description = bytes.Trim(m.Description, "\x00")
+
+	return int(m.Len()), nil
+}
+
+// Read implements the io.Reader interface, writing the receiver to the
+// []byte.
+func (m AssetTypeLoyaltyPoints) Read(b []byte) (int, error) {
+	data, err := m.Bytes()
+
+	if err != nil {
+		return 0, err
+	}
+
+	copy(b, data)
+
+	return len(b), nil
+}
+
+// AssetTypeMembership asset type.
+type AssetTypeMembership struct {
 	BaseMessage
 
-	Version            uint8
-	TradingRestriction []byte
-	RedeemingEntity    []byte
-	ExpiryDate         uint64
-	IssueDate          uint64
-	Description        []byte
+	Version             uint8
+	TradingRestriction  []byte
+	AgeRestriction      []byte
+	ValidFrom           uint64
+	ExpirationTimestamp uint64
+	MembershipType      []byte
+	Description         []byte
 }
 
-// NewAssetTypeCoupon returns a new AssetTypeCoupon.
-func NewAssetTypeCoupon() *AssetTypeCoupon {
-	return &AssetTypeCoupon{}
+// NewAssetTypeMembership returns a new AssetTypeMembership.
+func NewAssetTypeMembership() *AssetTypeMembership {
+	return &AssetTypeMembership{}
 }
 
 // Type returns the type identifer for this message.
-func (m AssetTypeCoupon) Type() string {
-	return CodeAssetTypeCoupon
+func (m AssetTypeMembership) Type() string {
+	return CodeAssetTypeMembership
 }
 
 // Len returns the byte size of this message.
-func (m AssetTypeCoupon) Len() int64 {
+func (m AssetTypeMembership) Len() int64 {
 	return AssetTypeLen
 }
 
 // Bytes returns the message in bytes.
-func (m AssetTypeCoupon) Bytes() ([]byte, error) {
+func (m AssetTypeMembership) Bytes() ([]byte, error) {
 	buf := new(bytes.Buffer)
 
 	if err := m.write(buf, m.Version); err != nil {
@@ -61,19 +606,23 @@ func (m AssetTypeCoupon) Bytes() ([]byte, error) {
 		return nil, err
 	}
 
-	if err := m.write(buf, m.pad(m.RedeemingEntity, 32)); err != nil {
+	if err := m.write(buf, m.pad(m.AgeRestriction, 5)); err != nil {
 		return nil, err
 	}
 
-	if err := m.write(buf, m.ExpiryDate); err != nil {
+	if err := m.write(buf, m.ValidFrom); err != nil {
 		return nil, err
 	}
 
-	if err := m.write(buf, m.IssueDate); err != nil {
+	if err := m.write(buf, m.ExpirationTimestamp); err != nil {
 		return nil, err
 	}
 
-	if err := m.write(buf, m.pad(m.Description, 100)); err != nil {
+	if err := m.write(buf, m.pad(m.MembershipType, 16)); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.pad(m.Description, 111)); err != nil {
 		return nil, err
 	}
 
@@ -82,7 +631,7 @@ func (m AssetTypeCoupon) Bytes() ([]byte, error) {
 
 // Write implements the io.Writer interface, writing the data in []byte to
 // the receiver.
-func (m *AssetTypeCoupon) Write(b []byte) (int, error) {
+func (m *AssetTypeMembership) Write(b []byte) (int, error) {
 	buf := bytes.NewBuffer(b)
 
 	if err := m.read(buf, &m.Version); err != nil {
@@ -96,22 +645,29 @@ func (m *AssetTypeCoupon) Write(b []byte) (int, error) {
 
 	m.TradingRestriction = bytes.Trim(m.TradingRestriction, "\x00")
 
-	m.RedeemingEntity = make([]byte, 32)
-	if err := m.readLen(buf, m.RedeemingEntity); err != nil {
+	m.AgeRestriction = make([]byte, 5)
+	if err := m.readLen(buf, m.AgeRestriction); err != nil {
 		return 0, err
 	}
 
-	m.RedeemingEntity = bytes.Trim(m.RedeemingEntity, "\x00")
+	m.AgeRestriction = bytes.Trim(m.AgeRestriction, "\x00")
 
-	if err := m.read(buf, &m.ExpiryDate); err != nil {
+	if err := m.read(buf, &m.ValidFrom); err != nil {
 		return 0, err
 	}
 
-	if err := m.read(buf, &m.IssueDate); err != nil {
+	if err := m.read(buf, &m.ExpirationTimestamp); err != nil {
 		return 0, err
 	}
 
-	m.Description = make([]byte, 100)
+	m.MembershipType = make([]byte, 16)
+	if err := m.readLen(buf, m.MembershipType); err != nil {
+		return 0, err
+	}
+
+	m.MembershipType = bytes.Trim(m.MembershipType, "\x00")
+
+	m.Description = make([]byte, 111)
 	if err := m.readLen(buf, m.Description); err != nil {
 		return 0, err
 	}
@@ -123,7 +679,7 @@ func (m *AssetTypeCoupon) Write(b []byte) (int, error) {
 
 // Read implements the io.Reader interface, writing the receiver to the
 // []byte.
-func (m AssetTypeCoupon) Read(b []byte) (int, error) {
+func (m AssetTypeMembership) Read(b []byte) (int, error) {
 	data, err := m.Bytes()
 
 	if err != nil {
@@ -412,6 +968,167 @@ func (m AssetTypeShareCommon) Read(b []byte) (int, error) {
 	return len(b), nil
 }
 
+// AssetTypeSharePreferred asset type.
+type AssetTypeSharePreferred struct {
+	BaseMessage
+
+	Version              uint8
+	TradingRestriction   []byte
+	DividendType         byte
+	DividendVar          float32
+	DividendFixed        float32
+	DistributionInterval byte
+	Cumulative           byte
+	Convertible          byte
+	Ticker               []byte
+	ISIN                 []byte
+	Description          []byte
+}
+
+// NewAssetTypeSharePreferred returns a new AssetTypeSharePreferred.
+func NewAssetTypeSharePreferred() *AssetTypeSharePreferred {
+	return &AssetTypeSharePreferred{}
+}
+
+// Type returns the type identifer for this message.
+func (m AssetTypeSharePreferred) Type() string {
+	return CodeAssetTypeSharePreferred
+}
+
+// Len returns the byte size of this message.
+func (m AssetTypeSharePreferred) Len() int64 {
+	return AssetTypeLen
+}
+
+// Bytes returns the message in bytes.
+func (m AssetTypeSharePreferred) Bytes() ([]byte, error) {
+	buf := new(bytes.Buffer)
+
+	if err := m.write(buf, m.Version); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.pad(m.TradingRestriction, 3)); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.DividendType); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.DividendVar); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.DividendFixed); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.DistributionInterval); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.Cumulative); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.Convertible); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.pad(m.Ticker, 5)); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.pad(m.ISIN, 12)); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.pad(m.Description, 119)); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Write implements the io.Writer interface, writing the data in []byte to
+// the receiver.
+func (m *AssetTypeSharePreferred) Write(b []byte) (int, error) {
+	buf := bytes.NewBuffer(b)
+
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
+
+	m.TradingRestriction = make([]byte, 3)
+	if err := m.readLen(buf, m.TradingRestriction); err != nil {
+		return 0, err
+	}
+
+	m.TradingRestriction = bytes.Trim(m.TradingRestriction, "\x00")
+
+	if err := m.read(buf, &m.DividendType); err != nil {
+		return 0, err
+	}
+
+	if err := m.read(buf, &m.DividendVar); err != nil {
+		return 0, err
+	}
+
+	if err := m.read(buf, &m.DividendFixed); err != nil {
+		return 0, err
+	}
+
+	if err := m.read(buf, &m.DistributionInterval); err != nil {
+		return 0, err
+	}
+
+	if err := m.read(buf, &m.Cumulative); err != nil {
+		return 0, err
+	}
+
+	if err := m.read(buf, &m.Convertible); err != nil {
+		return 0, err
+	}
+
+	m.Ticker = make([]byte, 5)
+	if err := m.readLen(buf, m.Ticker); err != nil {
+		return 0, err
+	}
+
+	m.Ticker = bytes.Trim(m.Ticker, "\x00")
+
+	m.ISIN = make([]byte, 12)
+	if err := m.readLen(buf, m.ISIN); err != nil {
+		return 0, err
+	}
+
+	m.ISIN = bytes.Trim(m.ISIN, "\x00")
+
+	m.Description = make([]byte, 119)
+	if err := m.readLen(buf, m.Description); err != nil {
+		return 0, err
+	}
+
+	m.Description = bytes.Trim(m.Description, "\x00")
+
+	return int(m.Len()), nil
+}
+
+// Read implements the io.Reader interface, writing the receiver to the
+// []byte.
+func (m AssetTypeSharePreferred) Read(b []byte) (int, error) {
+	data, err := m.Bytes()
+
+	if err != nil {
+		return 0, err
+	}
+
+	copy(b, data)
+
+	return len(b), nil
+}
+
 // AssetTypeTicketAdmission asset type.
 type AssetTypeTicketAdmission struct {
 	BaseMessage
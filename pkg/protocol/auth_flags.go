@@ -86,6 +86,38 @@ const (
 	AssetUserTransfer = 1 << 9
 )
 
+// ContractFlags are the names of the contract authorization flags, as shown
+// in the JSON of a message.
+var ContractFlags = map[uint16]string{
+	ContractIssuerUpdate:       "issuer_update",
+	ContractOwnerAmendments:    "owner_amendments",
+	ContractAuthFlagsIssuer:    "auth_flags_issuer",
+	ContractAuthFlagReferendum: "auth_flag_referendum",
+	ContractUserInitiatives:    "user_initiatives",
+	ContractQuantityUpdate:     "quantity_update",
+	ContractReferendum:         "referendum",
+	ContractAssetConfiscate:    "asset_confiscate",
+	ContractAssetFreezeThaw:    "asset_freeze_thaw",
+	ContractAssetWhitelist:     "asset_whitelist",
+	ContractBindingInitiatives: "binding_initiatives",
+	ContractExpirationUpdate:   "expiration_update",
+}
+
+// AssetFlags are the names of the asset authorization flags, as shown in
+// the JSON of a message.
+var AssetFlags = map[uint16]string{
+	AssetIssuerModification: "issuer_modification",
+	AssetVoteRequired:       "vote_required",
+	AssetIsserAmendFlags:    "issuer_amend_flags",
+	AssetAuthFlagAmendment:  "auth_flag_amendment",
+	AssetUserInitiative:     "user_initiative",
+	AssetIssuerMintBurn:     "issuer_mint_burn",
+	AssetTokenOwnerVote:     "token_owner_vote",
+	AssetIssuerConfiscate:   "issuer_confiscate",
+	AssetIsserFreeThaw:      "issuer_freeze_thaw",
+	AssetUserTransfer:       "user_transfer",
+}
+
 // IsAuthorized returns true if the given flags match the existing state,
 // false otherwise.
 func IsAuthorized(state uint16, flags uint16) bool {
@@ -1,15 +0,0 @@
-package protocol
-
-const (
-	// ComplianceActionFreeze indicates a Freeze Order
-	ComplianceActionFreeze = byte('F')
-
-	// ComplianceActionThaw indicates a Thaw Order
-	ComplianceActionThaw = byte('T')
-
-	// ComplianceActionConfiscation indicates a Confiscation Order
-	ComplianceActionConfiscation = byte('C')
-
-	// ComplianceActionReconciliation indicates a Reconciliation Order
-	ComplianceActionReconciliation = byte('R')
-)
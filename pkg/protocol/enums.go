@@ -0,0 +1,116 @@
+package protocol
+
+// The code in this file is auto-generated. Do not edit it by hand as it will
+// be overwritten when code is regenerated.
+
+const (
+	// ComplianceActionFreeze indicates a Freeze Order.
+	ComplianceActionFreeze = byte('F')
+
+	// ComplianceActionThaw indicates a Thaw Order.
+	ComplianceActionThaw = byte('T')
+
+	// ComplianceActionConfiscation indicates a Confiscation Order.
+	ComplianceActionConfiscation = byte('C')
+
+	// ComplianceActionReconciliation indicates a Reconciliation Order.
+	ComplianceActionReconciliation = byte('R')
+)
+
+// ComplianceActions are the names of the codes of the action of an Order.
+var ComplianceActions = map[byte]string{
+	ComplianceActionFreeze:         "Freeze",
+	ComplianceActionThaw:           "Thaw",
+	ComplianceActionConfiscation:   "Confiscation",
+	ComplianceActionReconciliation: "Reconciliation",
+}
+
+const (
+	// IssuerTypePublicCompany identifies the issuer as a public company limited by shares.
+	IssuerTypePublicCompany = byte('P')
+
+	// IssuerTypePrivateCompany identifies the issuer as a private company limited by shares.
+	IssuerTypePrivateCompany = byte('C')
+
+	// IssuerTypeIndividual identifies the issuer as an individual.
+	IssuerTypeIndividual = byte('I')
+
+	// IssuerTypeLimitedPartnership identifies the issuer as a limited partnership.
+	IssuerTypeLimitedPartnership = byte('L')
+
+	// IssuerTypeUnlimitedPartnership identifies the issuer as an unlimited partnership.
+	IssuerTypeUnlimitedPartnership = byte('U')
+
+	// IssuerTypeSoleProprietor identifies the issuer as a sole proprietor, or trader.
+	IssuerTypeSoleProprietor = byte('T')
+
+	// IssuerTypeStatutoryCompany identifies the issuer as a company created by statute.
+	IssuerTypeStatutoryCompany = byte('S')
+
+	// IssuerTypeNonProfit identifies the issuer as a non-profit organization.
+	IssuerTypeNonProfit = byte('O')
+
+	// IssuerTypeNationState identifies the issuer as a nation state.
+	IssuerTypeNationState = byte('N')
+
+	// IssuerTypeGovernmentAgency identifies the issuer as a government agency.
+	IssuerTypeGovernmentAgency = byte('G')
+
+	// IssuerTypeUnitTrust identifies the issuer as a unit trust.
+	IssuerTypeUnitTrust = byte('B')
+
+	// IssuerTypeDiscretionaryTrust identifies the issuer as a discretionary trust.
+	IssuerTypeDiscretionaryTrust = byte('D')
+)
+
+// IssuerTypes are the names of the codes of the legal form of the issuer of a contract.
+var IssuerTypes = map[byte]string{
+	IssuerTypePublicCompany:        "PublicCompany",
+	IssuerTypePrivateCompany:       "PrivateCompany",
+	IssuerTypeIndividual:           "Individual",
+	IssuerTypeLimitedPartnership:   "LimitedPartnership",
+	IssuerTypeUnlimitedPartnership: "UnlimitedPartnership",
+	IssuerTypeSoleProprietor:       "SoleProprietor",
+	IssuerTypeStatutoryCompany:     "StatutoryCompany",
+	IssuerTypeNonProfit:            "NonProfit",
+	IssuerTypeNationState:          "NationState",
+	IssuerTypeGovernmentAgency:     "GovernmentAgency",
+	IssuerTypeUnitTrust:            "UnitTrust",
+	IssuerTypeDiscretionaryTrust:   "DiscretionaryTrust",
+}
+
+const (
+	// VoteLogicStandard identifies a vote as having a standard count.
+	VoteLogicStandard = byte('0')
+
+	// VoteLogicWeighted identifies a vote as using a weighted count.
+	VoteLogicWeighted = byte('1')
+)
+
+// VoteLogics are the names of the codes of how the ballots of a vote are counted.
+var VoteLogics = map[byte]string{
+	VoteLogicStandard: "Standard",
+	VoteLogicWeighted: "Weighted",
+}
+
+const (
+	// VotingSystemMajority passes a vote with more than half of the votes cast.
+	VotingSystemMajority = byte('M')
+
+	// VotingSystemSuperMajority passes a vote with at least two thirds of the votes cast.
+	VotingSystemSuperMajority = byte('S')
+
+	// VotingSystemUnanimous passes a vote only if every vote cast is in favour.
+	VotingSystemUnanimous = byte('U')
+
+	// VotingSystemNone gives no voting rights.
+	VotingSystemNone = byte('N')
+)
+
+// VotingSystems are the names of the codes of the share of votes needed to pass a vote.
+var VotingSystems = map[byte]string{
+	VotingSystemMajority:      "Majority",
+	VotingSystemSuperMajority: "SuperMajority",
+	VotingSystemUnanimous:     "Unanimous",
+	VotingSystemNone:          "None",
+}
@@ -3,9 +3,13 @@ package protocol
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
 )
 
 var (
@@ -28,6 +32,7 @@ type Form interface {
 // PayloadForm is the interface for payload sub-forms, such as required for
 // the Asset messages.
 type PayloadForm interface {
+	Validate() error
 	PayloadMessage([]byte) (PayloadMessage, error)
 	Bytes() ([]byte, error)
 }
@@ -36,23 +41,12 @@ type PayloadForm interface {
 //
 // Example codes : SHC, COU, etc
 func NewPayloadFormByCode(code string) (PayloadForm, error) {
-	if code == CodeAssetTypeCoupon {
-		return NewAssetTypeCouponForm(), nil
-	}
-
-	if code == CodeAssetTypeMovieTicket {
-		return NewAssetTypeMovieTicketForm(), nil
-	}
-
-	if code == CodeAssetTypeShareCommon {
-		return NewAssetTypeShareCommonForm(), nil
-	}
-
-	if code == CodeAssetTypeTicketAdmission {
-		return NewAssetTypeTicketAdmissionForm(), nil
+	newForm, ok := AssetTypeFormMapping[code]
+	if !ok {
+		return nil, fmt.Errorf("No matching form for code %s", code)
 	}
 
-	return nil, fmt.Errorf("No matching form for code %s", code)
+	return newForm(), nil
 }
 
 // BaseForm is the common struct for all ProtocolForm's.
@@ -116,3 +110,273 @@ func (f BaseForm) ensureByte(s string) (byte, error) {
 
 	return b[0], err
 }
+
+// enumByte returns the code of an enum field, from the name or the code.
+func (f BaseForm) enumByte(s string, codes map[byte]string) (byte, error) {
+	for code, name := range codes {
+		if name == s {
+			return code, nil
+		}
+	}
+
+	return f.ensureByte(s)
+}
+
+// hexBytes returns a []byte of length l, from the hex string.
+//
+// An error will be returned if the decoded length exceeds l.
+func (f BaseForm) hexBytes(s string, l int) ([]byte, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) > l {
+		return nil, ErrLengthExceeded
+	}
+
+	return append(b, make([]byte, l-len(b))...), nil
+}
+
+// flagBytes returns the bit field of the flags, from their names.
+func (f BaseForm) flagBytes(flags []string, names map[uint16]string) ([]byte, error) {
+	var v uint16
+
+	for _, name := range flags {
+		flag, ok := flagBit(name, names)
+		if !ok {
+			return nil, fmt.Errorf("Unknown flag %q", name)
+		}
+
+		v |= flag
+	}
+
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, v)
+
+	return b, nil
+}
+
+// text returns a padded field of a message as text.
+func text(b []byte) string {
+	return string(bytes.Trim(b, "\x00"))
+}
+
+// charText returns a char field of a message as text, which is empty if the
+// char is not set.
+func charText(b byte) string {
+	if b == 0 {
+		return ""
+	}
+
+	return string(b)
+}
+
+// enumText returns the name of the code of an enum field, or the code if it
+// has no name.
+func enumText(b byte, codes map[byte]string) string {
+	if name, ok := codes[b]; ok {
+		return name
+	}
+
+	return charText(b)
+}
+
+// hashText returns a hash field of a message in hex, which is empty if the
+// hash is not set.
+func hashText(b []byte) string {
+	if len(bytes.Trim(b, "\x00")) == 0 {
+		return ""
+	}
+
+	return hex.EncodeToString(b)
+}
+
+// flagNames returns the names of the flags set in a bit field. A flag with
+// no name is named by its bit, such as "bit_12".
+func flagNames(b []byte, names map[uint16]string) []string {
+	field := make([]byte, 2)
+	copy(field, b)
+
+	v := binary.BigEndian.Uint16(field)
+
+	flags := []string{}
+	for bit := uint(0); bit < 16; bit++ {
+		flag := uint16(1) << bit
+		if v&flag == 0 {
+			continue
+		}
+
+		name, ok := names[flag]
+		if !ok {
+			name = fmt.Sprintf("bit_%d", bit)
+		}
+
+		flags = append(flags, name)
+	}
+
+	return flags
+}
+
+// flagBit returns the flag with the name, or named by its bit.
+func flagBit(name string, names map[uint16]string) (uint16, bool) {
+	for flag, n := range names {
+		if n == name {
+			return flag, true
+		}
+	}
+
+	if !strings.HasPrefix(name, "bit_") {
+		return 0, false
+	}
+
+	bit, err := strconv.Atoi(strings.TrimPrefix(name, "bit_"))
+	if err != nil || bit < 0 || bit > 15 {
+		return 0, false
+	}
+
+	return uint16(1) << uint(bit), true
+}
+
+// FieldError is a field of a Form that is not valid.
+type FieldError struct {
+	// Field is the JSON name of the field. Fields of a payload are prefixed
+	// with the name of the payload, such as "payload.ticker".
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+}
+
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s : %s", e.Field, e.Reason)
+}
+
+// FieldErrors is returned by Validate when fields of a Form are not valid.
+type FieldErrors []FieldError
+
+func (e FieldErrors) Error() string {
+	s := make([]string, len(e))
+	for i, fe := range e {
+		s[i] = fe.Error()
+	}
+
+	return strings.Join(s, ", ")
+}
+
+// err returns the FieldErrors as an error, or nil if there are none.
+func (e FieldErrors) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+func (e *FieldErrors) add(field string, format string, args ...interface{}) {
+	*e = append(*e, FieldError{
+		Field:  field,
+		Reason: fmt.Sprintf(format, args...),
+	})
+}
+
+// checkLen adds an error if the string is longer than l bytes.
+func (e *FieldErrors) checkLen(field string, s string, l int) {
+	if len(s) > l {
+		e.add(field, "length %d exceeds %d", len(s), l)
+	}
+}
+
+// checkEnum adds an error if the string is set, and is not one of the
+// codes, or the name of one.
+func (e *FieldErrors) checkEnum(field string, s string, codes map[byte]string) {
+	if len(s) == 0 {
+		return
+	}
+
+	for _, name := range codes {
+		if name == s {
+			return
+		}
+	}
+
+	if len(s) > 1 {
+		e.checkLen(field, s, 1)
+		return
+	}
+
+	if _, ok := codes[s[0]]; !ok {
+		e.add(field, "unknown code %q", s)
+	}
+}
+
+// checkHex adds an error if the string is not hex, or is longer than l
+// bytes when decoded.
+func (e *FieldErrors) checkHex(field string, s string, l int) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		e.add(field, "is not hex")
+		return
+	}
+
+	if len(b) > l {
+		e.add(field, "length %d exceeds %d", len(b), l)
+	}
+}
+
+// checkFlags adds an error for each flag that has no name, and is not
+// named by its bit.
+func (e *FieldErrors) checkFlags(field string, flags []string, names map[uint16]string) {
+	for _, name := range flags {
+		if _, ok := flagBit(name, names); !ok {
+			e.add(field, "unknown flag %q", name)
+		}
+	}
+}
+
+// checkAfter adds an error if both timestamps are set, and the timestamp
+// is before the other.
+func (e *FieldErrors) checkAfter(field string,
+	t uint64,
+	otherField string,
+	other uint64) {
+
+	if t != 0 && other != 0 && t < other {
+		e.add(field, "is before %s", otherField)
+	}
+}
+
+// checkPayload adds the errors of the payload form of the asset type, if
+// there is a payload.
+func (e *FieldErrors) checkPayload(field string,
+	assetType string,
+	payload json.RawMessage) {
+
+	if len(payload) == 0 {
+		return
+	}
+
+	pf, err := NewPayloadFormByCode(assetType)
+	if err != nil {
+		e.add("asset_type", "no payload for asset type %q", assetType)
+		return
+	}
+
+	if err := json.Unmarshal(payload, pf); err != nil {
+		e.add(field, "%v", err)
+		return
+	}
+
+	err = pf.Validate()
+	if err == nil {
+		return
+	}
+
+	fieldErrs, ok := err.(FieldErrors)
+	if !ok {
+		e.add(field, "%v", err)
+		return
+	}
+
+	for _, fe := range fieldErrs {
+		e.add(field+"."+fe.Field, "%s", fe.Reason)
+	}
+}
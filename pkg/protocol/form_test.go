@@ -0,0 +1,95 @@
+package protocol
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAssetDefinitionForm_Validate(t *testing.T) {
+	f := AssetDefinitionForm{
+		AssetType:    CodeAssetTypeShareCommon,
+		AssetID:      strings.Repeat("a", 40),
+		VotingSystem: "X",
+		Payload:      json.RawMessage(`{"ticker":"TOOLONG","description":"Blue Pants"}`),
+	}
+
+	err := f.Validate()
+
+	errs, ok := err.(FieldErrors)
+	if !ok {
+		t.Fatalf("got err %v, want FieldErrors", err)
+	}
+
+	want := []string{"asset_id", "voting_system", "payload.ticker"}
+
+	got := []string{}
+	for _, fe := range errs {
+		got = append(got, fe.Field)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got fields %v, want %v", got, want)
+	}
+
+	if _, err := f.BuildMessage(); err == nil {
+		t.Fatal("built message from invalid form")
+	}
+
+	f.AssetID = "apm2qsznhks23z8d83u41s8019hyri3i"
+	f.VotingSystem = string(VotingSystemMajority)
+	f.Payload = json.RawMessage(`{"ticker":"PTSBL","description":"Blue Pants"}`)
+
+	if err := f.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := f.BuildMessage(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFreezeForm_Validate(t *testing.T) {
+	f := FreezeForm{
+		Timestamp:  1546300800,
+		Expiration: 1546300000,
+	}
+
+	err := f.Validate()
+
+	errs, ok := err.(FieldErrors)
+	if !ok || len(errs) != 1 || errs[0].Field != "expiration" {
+		t.Fatalf("got err %v, want expiration error", err)
+	}
+
+	// no expiration is valid
+	f.Expiration = 0
+
+	if err := f.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOrderForm_Validate(t *testing.T) {
+	tests := []struct {
+		action string
+		valid  bool
+	}{
+		{string(ComplianceActionFreeze), true},
+		{string(ComplianceActionConfiscation), true},
+		{"", true},
+		{"X", false},
+		{"FT", false},
+	}
+
+	for _, tt := range tests {
+		f := OrderForm{
+			ComplianceAction: tt.action,
+		}
+
+		if err := f.Validate(); (err == nil) != tt.valid {
+			t.Errorf("action %q : got err %v, want valid %v", tt.action, err, tt.valid)
+		}
+	}
+}
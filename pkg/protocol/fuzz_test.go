@@ -0,0 +1,103 @@
+//go:build go1.18
+// +build go1.18
+
+package protocol
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// Run a target with, for example :
+//
+//	go test ./pkg/protocol -run XXX -fuzz FuzzNew -fuzztime 1m
+
+// addFuzzSeeds adds the fixtures of every version as seeds.
+func addFuzzSeeds(f *testing.F) {
+	for _, fixtures := range versionFixtures {
+		for _, fixture := range fixtures {
+			b := decodeFixture(f, fixture.hex)
+			f.Add(b)
+			f.Add(append([]byte{0x00}, b...))
+		}
+	}
+
+	f.Add([]byte{})
+	f.Add([]byte{0x6a})
+	f.Add([]byte{0x6a, 0x4e, 0xff, 0xff, 0xff, 0xff})
+}
+
+func FuzzNew(f *testing.F) {
+	addFuzzSeeds(f)
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		m, err := New(b)
+		if err != nil {
+			return
+		}
+
+		// a decoded message must encode, and decode to the same message
+		enc := make([]byte, m.Len())
+		if _, err := m.Read(enc); err != nil {
+			t.Fatal(err)
+		}
+
+		again, err := New(enc)
+		if err != nil {
+			t.Fatalf("decoding %x : %v", enc, err)
+		}
+
+		if again.String() != m.String() {
+			t.Fatalf("got\n    %v\nwant\n    %v", again, m)
+		}
+
+		m.PayloadMessage()
+		json.Marshal(m)
+	})
+}
+
+func FuzzMessageWrite(f *testing.F) {
+	addFuzzSeeds(f)
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		for _, newMessage := range TypeMapping {
+			m := newMessage()
+			if _, err := m.Write(b); err != nil {
+				continue
+			}
+
+			_ = m.String()
+			m.PayloadMessage()
+			json.Marshal(m)
+		}
+	})
+}
+
+func FuzzPayloadMessage(f *testing.F) {
+	f.Add(make([]byte, AssetTypeLen))
+	f.Add([]byte{})
+
+	for _, newAssetType := range AssetTypeMapping {
+		b := make([]byte, AssetTypeLen)
+		if _, err := newAssetType().Read(b); err != nil {
+			f.Fatal(err)
+		}
+
+		f.Add(b)
+	}
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		for code := range AssetTypeMapping {
+			p, err := NewPayloadMessageFromCode([]byte(code))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := p.Write(b); err != nil {
+				continue
+			}
+
+			json.Marshal(p)
+		}
+	})
+}
@@ -0,0 +1,3 @@
+package protocol
+
+//go:generate go run ./internal/generate -schema schema/v32 -out .
@@ -0,0 +1,88 @@
+// Command generate writes the messages, forms and asset types of the
+// protocol package from the schema files.
+//
+// Usage, from the protocol package :
+//
+//	go run ./internal/generate -schema schema/v32 -out .
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+// outputs are the files written, and the template of each.
+var outputs = []struct {
+	file     string
+	template string
+}{
+	{"messages.go", messagesTemplate},
+	{"protocol_forms.go", protocolFormsTemplate},
+	{"asset_types.go", assetTypesTemplate},
+	{"asset_type_forms.go", assetTypeFormsTemplate},
+	{"limits.go", limitsTemplate},
+	{"enums.go", enumsTemplate},
+	{"roundtrip_test.go", roundTripTestTemplate},
+}
+
+func main() {
+	schema := flag.String("schema", "", "directory of the schema files")
+	out := flag.String("out", ".", "directory to write the generated files to")
+	flag.Parse()
+
+	if len(*schema) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(*schema, *out); err != nil {
+		fmt.Fprintf(os.Stderr, "generate : %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(schema, out string) error {
+	p, err := loadProtocol(schema)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range outputs {
+		b, err := render(o.file, o.template, p)
+		if err != nil {
+			return err
+		}
+
+		if err := ioutil.WriteFile(filepath.Join(out, o.file), b, 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// render executes the template for the protocol, and formats the result.
+func render(name, text string, p *Protocol) ([]byte, error) {
+	t, err := template.New(name).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.Buffer{}
+	if err := t.Execute(&buf, p); err != nil {
+		return nil, err
+	}
+
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%s : %v", name, err)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,584 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Field types of the schema.
+const (
+	typeUint8   = "uint8"
+	typeUint16  = "uint16"
+	typeUint32  = "uint32"
+	typeUint64  = "uint64"
+	typeFloat32 = "float32"
+
+	// typeTimestamp is a uint64 time.
+	typeTimestamp = "timestamp"
+
+	// typeChar is a single character, such as a code.
+	typeChar = "char"
+
+	// typeString is text, padded with 0x00 to the size of the field.
+	typeString = "string"
+
+	// typeHash is a hash, shown in hex.
+	typeHash = "hash"
+
+	// typeFlags is a bit field, such as the authorization flags.
+	typeFlags = "flags"
+
+	// typePayload is the raw payload of an asset type. It is not trimmed.
+	typePayload = "payload"
+)
+
+// fieldSizes are the sizes of the field types that have a fixed size.
+var fieldSizes = map[string]int{
+	typeUint8:     1,
+	typeUint16:    2,
+	typeUint32:    4,
+	typeUint64:    8,
+	typeFloat32:   4,
+	typeTimestamp: 8,
+	typeChar:      1,
+}
+
+// minimums are the Go names of the minimum values of a message.
+var minimums = map[string]string{
+	"default": "LimitDefault",
+	"dust":    "DustLimit",
+}
+
+// Protocol is a version of the protocol, as defined by the files in a
+// schema directory.
+type Protocol struct {
+	ProtocolID   uint32 `json:"protocol_id"`
+	AssetTypeLen int    `json:"asset_type_len"`
+
+	Enums      []*Enum      `json:"-"`
+	Messages   []*Message   `json:"-"`
+	AssetTypes []*AssetType `json:"-"`
+}
+
+// Enum is a set of codes a char field may hold.
+type Enum struct {
+	Name        string       `json:"name"`
+	Plural      string       `json:"plural"`
+	Description string       `json:"description"`
+	Values      []*EnumValue `json:"values"`
+}
+
+// EnumValue is a code of an Enum.
+type EnumValue struct {
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// Message is an action of the protocol.
+type Message struct {
+	Code        string   `json:"code"`
+	Name        string   `json:"name"`
+	Section     string   `json:"section"`
+	Description string   `json:"description"`
+	Minimum     string   `json:"minimum"`
+	Fields      []*Field `json:"fields"`
+}
+
+// AssetType is an asset type, carried in the payload of an asset message.
+type AssetType struct {
+	Code   string   `json:"code"`
+	Name   string   `json:"name"`
+	Label  string   `json:"label"`
+	Fields []*Field `json:"fields"`
+}
+
+// Field is a field of a Message or AssetType.
+type Field struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Size int    `json:"size"`
+
+	// Enum is the name of the Enum of a char field, if any.
+	Enum string `json:"enum"`
+
+	// EnumType is the Enum named by Enum.
+	EnumType *Enum `json:"-"`
+
+	// After is the name of a timestamp field that a timestamp field must not
+	// be before, if both are set.
+	After string `json:"after"`
+
+	// AfterField is the field named by After.
+	AfterField *Field `json:"-"`
+
+	// Flags is the name of the map of the flag names of a flags field,
+	// such as ContractFlags.
+	Flags string `json:"flags"`
+}
+
+// loadProtocol reads the protocol from the schema directory.
+func loadProtocol(dir string) (*Protocol, error) {
+	p := Protocol{}
+	if err := readJSON(filepath.Join(dir, "protocol.json"), &p); err != nil {
+		return nil, err
+	}
+
+	if err := readJSON(filepath.Join(dir, "enums.json"), &p.Enums); err != nil {
+		return nil, err
+	}
+
+	enums := map[string]*Enum{}
+	for _, e := range p.Enums {
+		if err := e.validate(); err != nil {
+			return nil, err
+		}
+
+		enums[e.Name] = e
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "messages", "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		m := Message{}
+		if err := readJSON(file, &m); err != nil {
+			return nil, err
+		}
+
+		if err := m.validate(enums); err != nil {
+			return nil, fmt.Errorf("%s : %v", file, err)
+		}
+
+		p.Messages = append(p.Messages, &m)
+	}
+
+	files, err = filepath.Glob(filepath.Join(dir, "assets", "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		a := AssetType{}
+		if err := readJSON(file, &a); err != nil {
+			return nil, err
+		}
+
+		if err := validateFields(a.Fields, enums); err != nil {
+			return nil, fmt.Errorf("%s : %v", file, err)
+		}
+
+		if a.Len() != p.AssetTypeLen {
+			return nil, fmt.Errorf("%s : size %d is not the asset type size %d",
+				file, a.Len(), p.AssetTypeLen)
+		}
+
+		p.AssetTypes = append(p.AssetTypes, &a)
+	}
+
+	sort.Slice(p.Messages, func(i, j int) bool {
+		return p.Messages[i].Code < p.Messages[j].Code
+	})
+
+	sort.Slice(p.AssetTypes, func(i, j int) bool {
+		return p.AssetTypes[i].Code < p.AssetTypes[j].Code
+	})
+
+	return &p, nil
+}
+
+func readJSON(file string, v interface{}) error {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("%s : %v", file, err)
+	}
+
+	return nil
+}
+
+func (e Enum) validate() error {
+	seen := map[string]bool{}
+
+	for _, v := range e.Values {
+		if len(v.Code) != 1 {
+			return fmt.Errorf("%s : code %q is not 1 character", e.Name, v.Code)
+		}
+
+		if seen[v.Code] {
+			return fmt.Errorf("%s : code %q is defined twice", e.Name, v.Code)
+		}
+		seen[v.Code] = true
+	}
+
+	return nil
+}
+
+func (m Message) validate(enums map[string]*Enum) error {
+	if len(m.Code) != 2 {
+		return fmt.Errorf("code %q is not 2 characters", m.Code)
+	}
+
+	if len(m.Minimum) > 0 {
+		if _, ok := minimums[m.Minimum]; !ok {
+			return fmt.Errorf("unknown minimum %q", m.Minimum)
+		}
+	}
+
+	return validateFields(m.Fields, enums)
+}
+
+func validateFields(fields []*Field, enums map[string]*Enum) error {
+	seen := map[string]*Field{}
+
+	for _, f := range fields {
+		if seen[f.Name] != nil {
+			return fmt.Errorf("field %s is defined twice", f.Name)
+		}
+		seen[f.Name] = f
+	}
+
+	for _, f := range fields {
+		if len(f.Enum) > 0 {
+			f.EnumType = enums[f.Enum]
+			if f.Type != typeChar || f.EnumType == nil {
+				return fmt.Errorf("field %s has unknown enum %q", f.Name, f.Enum)
+			}
+		}
+
+		if (f.Type == typeFlags) != (len(f.Flags) > 0) {
+			return fmt.Errorf("field %s of type %s has flags %q", f.Name, f.Type, f.Flags)
+		}
+
+		if f.Type == typeFlags && f.Size != 2 {
+			return fmt.Errorf("flags field %s has size %d, not 2", f.Name, f.Size)
+		}
+
+		if len(f.After) > 0 {
+			f.AfterField = seen[f.After]
+			if f.Type != typeTimestamp || f.AfterField == nil ||
+				f.AfterField.Type != typeTimestamp {
+				return fmt.Errorf("field %s is after unknown timestamp %q", f.Name, f.After)
+			}
+		}
+
+		if size, ok := fieldSizes[f.Type]; ok {
+			if f.Size != 0 && f.Size != size {
+				return fmt.Errorf("field %s of type %s has size %d", f.Name, f.Type, f.Size)
+			}
+
+			f.Size = size
+			continue
+		}
+
+		switch f.Type {
+		case typeString, typeHash, typeFlags, typePayload:
+			if f.Size <= 0 {
+				return fmt.Errorf("field %s has no size", f.Name)
+			}
+
+		default:
+			return fmt.Errorf("field %s has unknown type %q", f.Name, f.Type)
+		}
+	}
+
+	return nil
+}
+
+// MaxMessageLen returns the size of the largest message, excluding the
+// header.
+func (p Protocol) MaxMessageLen() int {
+	l := 0
+	for _, m := range p.Messages {
+		if m.Len() > l {
+			l = m.Len()
+		}
+	}
+
+	return l
+}
+
+// Len returns the size of the message, excluding the header.
+//
+// The size includes the protocol ID and action prefix, which are not in the
+// fields of the schema.
+func (m Message) Len() int {
+	return 6 + fieldsLen(m.Fields)
+}
+
+// Header returns the OP_RETURN header of the message, as Go source.
+func (m Message) Header() string {
+	if m.Len() < 0x4c {
+		return fmt.Sprintf("0x6a, %#x", m.Len())
+	}
+
+	return fmt.Sprintf("0x6a, 0x4c, %#x", m.Len())
+}
+
+// HeaderLen returns the size of the OP_RETURN header of the message.
+func (m Message) HeaderLen() int {
+	if m.Len() < 0x4c {
+		return 2
+	}
+
+	return 3
+}
+
+// FullLen returns the size of the message, including the header.
+func (m Message) FullLen() int {
+	return m.HeaderLen() + m.Len()
+}
+
+// MinimumName returns the name of the minimum value of the message.
+func (m Message) MinimumName() string {
+	return minimums[m.Minimum]
+}
+
+// HasPayload returns true if the message carries an asset type payload.
+func (m Message) HasPayload() bool {
+	for _, f := range m.Fields {
+		if f.Type == typePayload {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Doc returns the description as comment lines.
+func (m Message) Doc() string {
+	return comment(m.Name+" : "+m.Description, 75)
+}
+
+// Len returns the size of the asset type.
+func (a AssetType) Len() int {
+	return fieldsLen(a.Fields)
+}
+
+func fieldsLen(fields []*Field) int {
+	l := 0
+	for _, f := range fields {
+		l += f.Size
+	}
+
+	return l
+}
+
+// GoType returns the type of the field in a message.
+func (f Field) GoType() string {
+	switch f.Type {
+	case typeTimestamp:
+		return "uint64"
+	case typeChar:
+		return "byte"
+	case typeString, typeHash, typeFlags, typePayload:
+		return "[]byte"
+	}
+
+	return f.Type
+}
+
+// FormType returns the type of the field in a form.
+func (f Field) FormType() string {
+	switch f.Type {
+	case typeChar, typeString, typeHash:
+		return "string"
+	case typeFlags:
+		return "[]string"
+	case typePayload:
+		return "json.RawMessage"
+	}
+
+	return f.GoType()
+}
+
+// IsBytes returns true if the field is a []byte in a message.
+func (f Field) IsBytes() bool {
+	return f.GoType() == "[]byte"
+}
+
+// IsText returns true if the field is written to a form as padded text.
+func (f Field) IsText() bool {
+	return f.Type == typeChar || f.Type == typeString
+}
+
+// IsTrimmed returns true if the padding of the field is trimmed when a
+// message is read. Hashes, flags and payloads are binary, and keep every
+// byte.
+func (f Field) IsTrimmed() bool {
+	return f.Type == typeString
+}
+
+// ToForm returns the value of the field of a message in a form, as Go
+// source.
+func (f Field) ToForm() string {
+	switch {
+	case len(f.Enum) > 0:
+		return fmt.Sprintf("enumText(m.%s, %s)", f.Name, f.EnumType.Plural)
+	case f.Type == typeChar:
+		return fmt.Sprintf("charText(m.%s)", f.Name)
+	case f.Type == typeString:
+		return fmt.Sprintf("text(m.%s)", f.Name)
+	case f.Type == typeHash:
+		return fmt.Sprintf("hashText(m.%s)", f.Name)
+	case f.Type == typeFlags:
+		return fmt.Sprintf("flagNames(m.%s, %s)", f.Name, f.Flags)
+	}
+
+	return "m." + f.Name
+}
+
+// FromForm returns the expression that converts the field of a form to the
+// value in a message, returning the value and an error, as Go source. An
+// empty string is returned if the field is assigned as it is.
+func (f Field) FromForm() string {
+	switch {
+	case len(f.Enum) > 0:
+		return fmt.Sprintf("f.enumByte(f.%s, %s)", f.Name, f.EnumType.Plural)
+	case f.Type == typeChar:
+		return fmt.Sprintf("f.ensureByte(f.%s)", f.Name)
+	case f.Type == typeString:
+		return fmt.Sprintf("f.pad(f.%s, %d)", f.Name, f.Size)
+	case f.Type == typeHash:
+		return fmt.Sprintf("f.hexBytes(f.%s, %d)", f.Name, f.Size)
+	case f.Type == typeFlags:
+		return fmt.Sprintf("f.flagBytes(f.%s, %s)", f.Name, f.Flags)
+	}
+
+	return ""
+}
+
+// Format returns the format of the field in the String of a message, or
+// an empty string if it is not shown.
+func (f Field) Format() string {
+	switch f.Type {
+	case typeFlags, typePayload:
+		return ""
+	case typeChar, typeString:
+		return `\"%v\"`
+	case typeHash:
+		return `\"%x\"`
+	}
+
+	return "%v"
+}
+
+// Value returns the value of the field in the String of a message, as Go
+// source.
+func (f Field) Value() string {
+	switch f.Type {
+	case typeChar, typeString:
+		return fmt.Sprintf("string(m.%s)", f.Name)
+	}
+
+	return "m." + f.Name
+}
+
+// TestValue returns a value for the field to use in a test, as Go source.
+// The seed varies the value between fields.
+func (f Field) TestValue(seed int) string {
+	if f.EnumType != nil && len(f.EnumType.Values) > 0 {
+		return fmt.Sprintf("byte('%s')", f.EnumType.Values[seed%len(f.EnumType.Values)].Code)
+	}
+
+	switch f.Type {
+	case typeChar:
+		return fmt.Sprintf("byte('%c')", 'A'+seed%26)
+	case typeString:
+		return fmt.Sprintf("[]byte(%q)", testText(seed, f.Size))
+	case typeHash:
+		// binary, so it fills the field
+		return fmt.Sprintf("[]byte(%q)", strings.Repeat(string('a'+rune(seed%26)), f.Size))
+	case typeFlags:
+		// the first byte is empty, so it must not be trimmed
+		return fmt.Sprintf("[]byte{0x00, %#02x}", seed+1)
+	case typePayload:
+		return fmt.Sprintf("make([]byte, %d)", f.Size)
+	case typeFloat32:
+		return fmt.Sprintf("float32(%d.5)", seed)
+	case typeTimestamp:
+		if f.AfterField != nil {
+			// later than the value of any other field
+			return fmt.Sprintf("uint64(%d)", 1000+seed)
+		}
+	}
+
+	return fmt.Sprintf("%s(%d)", f.GoType(), seed+1)
+}
+
+// testText returns text that fills a field of the size.
+func testText(seed, size int) string {
+	s := strings.Repeat(string('a'+rune(seed%26)), size)
+	if size > 1 {
+		// leave a byte of padding
+		s = s[:size-1]
+	}
+
+	return s
+}
+
+// JSONName returns the name of the field in a form.
+func (f Field) JSONName() string {
+	s := acronymBoundary.ReplaceAllString(f.Name, "${1}_${2}")
+	s = wordBoundary.ReplaceAllString(s, "${1}_${2}")
+
+	return strings.ToLower(s)
+}
+
+var (
+	acronymBoundary = regexp.MustCompile("([A-Z]+)([A-Z][a-z])")
+	wordBoundary    = regexp.MustCompile("([a-z0-9])([A-Z])")
+)
+
+// comment wraps the text into comment lines no longer than width.
+func comment(text string, width int) string {
+	lines := []string{}
+	line := "//"
+
+	for _, word := range strings.Fields(text) {
+		if line != "//" && len(line)+1+len(word) > width {
+			lines = append(lines, line)
+			line = "//"
+		}
+
+		line += " " + word
+	}
+
+	lines = append(lines, line)
+
+	return strings.Join(lines, "\n")
+}
+
+// Check returns the statement that validates the field of a form, as Go
+// source, or an empty string if there is nothing to check.
+func (f Field) Check() string {
+	name := f.JSONName()
+
+	switch {
+	case len(f.Enum) > 0:
+		return fmt.Sprintf("errs.checkEnum(%q, f.%s, %s)", name, f.Name, f.EnumType.Plural)
+	case f.Type == typePayload:
+		return fmt.Sprintf("errs.checkPayload(%q, f.AssetType, f.%s)", name, f.Name)
+	case f.Type == typeHash:
+		return fmt.Sprintf("errs.checkHex(%q, f.%s, %d)", name, f.Name, f.Size)
+	case f.Type == typeFlags:
+		return fmt.Sprintf("errs.checkFlags(%q, f.%s, %s)", name, f.Name, f.Flags)
+	case f.AfterField != nil:
+		return fmt.Sprintf("errs.checkAfter(%q, f.%s, %q, f.%s)",
+			name, f.Name, f.AfterField.JSONName(), f.AfterField.Name)
+	case f.IsText():
+		return fmt.Sprintf("errs.checkLen(%q, f.%s, %d)", name, f.Name, f.Size)
+	}
+
+	return ""
+}
@@ -0,0 +1,864 @@
+package main
+
+// generatedNotice is the notice at the top of each generated file.
+const generatedNotice = `// The code in this file is auto-generated. Do not edit it by hand as it will
+// be overwritten when code is regenerated.`
+
+const messagesTemplate = `// Package protocol provides base level structs and validation for
+// the protocol.
+//
+` + generatedNotice + `
+package protocol
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/tokenized/smart-contract/pkg/txscript"
+)
+
+const (
+	// ProtocolID is the current protocol ID
+	ProtocolID uint32 = {{printf "0x%08x" .ProtocolID}}
+
+	// MaxMessageLen is the size of the largest message, excluding the
+	// OP_RETURN header.
+	MaxMessageLen = {{.MaxMessageLen}}
+{{range .Messages}}
+	// Code{{.Name}} identifies data as a {{.Name}} message.
+	Code{{.Name}} = "{{.Code}}"
+{{end}})
+
+// TypeMapping holds a mapping of message codes to constructors of message
+// types. Each call to a constructor returns a new message, so messages
+// decoded by New are never shared.
+var TypeMapping = map[string]func() OpReturnMessage{
+{{range .Messages}}
+	Code{{.Name}}: func() OpReturnMessage { return &{{.Name}}{} },
+{{end}}}
+
+// PayloadMessage is the interface for messages that are derived from
+// payloads, such as asset types.
+type PayloadMessage interface {
+	io.ReadWriter
+	Type() string
+	Len() int64
+}
+
+// OpReturnMessage implements a base interface for all message types.
+type OpReturnMessage interface {
+	PayloadMessage
+	String() string
+	PayloadMessage() (PayloadMessage, error)
+	Protocol() uint32
+}
+
+// New returns a new message, as an OpReturnMessage, from the OP_RETURN
+// payload. The message is decoded by the codec of the protocol version
+// embedded in the payload.
+func New(b []byte) (OpReturnMessage, error) {
+	return decode(Codecs, b)
+}
+
+const (
+	// headerLen is the size of the protocol ID and message code at the
+	// start of every payload.
+	headerLen = 6
+
+	// maxScriptLen is the size of the largest OP_RETURN script that is
+	// parsed. It allows for OP_FALSE OP_RETURN, and the largest message
+	// pushed a byte at a time, which takes 2 bytes a push.
+	maxScriptLen = 2 + 2*MaxMessageLen
+)
+
+// Payload returns the data pushed by the OP_RETURN script. The script may
+// start with OP_FALSE OP_RETURN, and the data may be split across several
+// pushes.
+func Payload(b []byte) ([]byte, error) {
+	if len(b) > maxScriptLen {
+		return nil, fmt.Errorf("OP_RETURN script size %d exceeds %d", len(b), maxScriptLen)
+	}
+
+	data, err := txscript.NullDataPayload(b)
+	if err != nil {
+		return nil, fmt.Errorf("Not an OP_RETURN payload : %v", err)
+	}
+
+	if len(data) < headerLen {
+		return nil, errors.New("OP_RETURN payload is too short")
+	}
+
+	if len(data) > MaxMessageLen {
+		return nil, fmt.Errorf("OP_RETURN payload size %d exceeds %d", len(data), MaxMessageLen)
+	}
+
+	return data, nil
+}
+
+// Version returns the protocol ID from the OP_RETURN payload.
+func Version(b []byte) (uint32, error) {
+	data, err := Payload(b)
+	if err != nil {
+		return 0, err
+	}
+
+	return binary.BigEndian.Uint32(data[0:4]), nil
+}
+
+// Code returns the identifying code from the OP_RETURN payload.
+func Code(b []byte) (string, error) {
+	data, err := Payload(b)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data[4:headerLen]), nil
+}
+
+// BaseMessage is a common struct for all messages.
+type BaseMessage struct {
+}
+
+// pad returns a []byte with the given length.
+func (bm BaseMessage) pad(b []byte, l int) []byte {
+	if len(b) == l {
+		return b
+	}
+
+	padding := []byte{}
+	c := l - len(b)
+
+	for i := 0; i < c; i++ {
+		padding = append(padding, 0)
+	}
+
+	return append(b, padding...)
+}
+
+// write writes the  value to the buffer.
+func (bm BaseMessage) write(buf *bytes.Buffer, v interface{}) error {
+	return binary.Write(buf, binary.BigEndian, v)
+}
+
+// read fills the value with the appropriate number of bytes from the buffer.
+//
+// This is useful for fixed size types such as int, float etc.
+func (bm BaseMessage) read(buf *bytes.Buffer, v interface{}) error {
+	return binary.Read(buf, binary.BigEndian, v)
+}
+
+// readLen reads the number of bytes from the buffer to fill the slice of
+// []byte.
+func (bm BaseMessage) readLen(buf *bytes.Buffer, b []byte) error {
+	_, err := io.ReadFull(buf, b)
+	return err
+}
+{{$section := ""}}{{range .Messages}}{{if ne .Section $section}}{{$section = .Section}}
+//
+// {{.Section}}
+//
+{{end}}
+{{.Doc}}
+type {{.Name}} struct {
+	BaseMessage
+	Header       []byte
+	ProtocolID   uint32
+	ActionPrefix []byte
+{{- range .Fields}}
+	{{.Name}} {{.GoType}}
+{{- end}}
+}
+
+// New{{.Name}} returns a new {{.Name}} with defaults set.
+func New{{.Name}}() {{.Name}} {
+	return {{.Name}}{
+		Header:       []byte{ {{- .Header -}} },
+		ProtocolID:   ProtocolID,
+		ActionPrefix: []byte(Code{{.Name}}),
+	}
+}
+
+// Type returns the type identifer for this message.
+func (m {{.Name}}) Type() string {
+	return Code{{.Name}}
+}
+
+// Protocol returns the protocol ID of this message.
+func (m {{.Name}}) Protocol() uint32 {
+	return m.ProtocolID
+}
+
+// Len returns the byte size of this message.
+func (m {{.Name}}) Len() int64 {
+	return int64(len(m.Header)) + {{.Len}}
+}
+
+// Read implements the io.Reader interface, writing the receiver to the
+// []byte.
+func (m {{.Name}}) Read(b []byte) (int, error) {
+	data, err := m.Bytes()
+
+	if err != nil {
+		return 0, err
+	}
+
+	copy(b, data)
+
+	return len(b), nil
+}
+
+// Bytes returns the full OP_RETURN payload in bytes.
+func (m {{.Name}}) Bytes() ([]byte, error) {
+	buf := new(bytes.Buffer)
+
+	if err := m.write(buf, m.pad(m.Header, len(m.Header))); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.ProtocolID); err != nil {
+		return nil, err
+	}
+
+	if err := m.write(buf, m.pad(m.ActionPrefix, 2)); err != nil {
+		return nil, err
+	}
+{{range .Fields}}
+	if err := m.write(buf, {{if .IsBytes}}m.pad(m.{{.Name}}, {{.Size}}){{else}}m.{{.Name}}{{end}}); err != nil {
+		return nil, err
+	}
+{{end}}
+	return buf.Bytes(), nil
+}
+
+// Write implements the io.Writer interface, writing the data in []byte to
+// the receiver.
+func (m *{{.Name}}) Write(b []byte) (int, error) {
+	buf := bytes.NewBuffer(b)
+
+	m.Header = make([]byte, {{.HeaderLen}})
+	if err := m.readLen(buf, m.Header); err != nil {
+		return 0, err
+	}
+
+	m.Header = bytes.Trim(m.Header, "\x00")
+
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
+
+	m.ActionPrefix = make([]byte, 2)
+	if err := m.readLen(buf, m.ActionPrefix); err != nil {
+		return 0, err
+	}
+
+	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
+{{range .Fields}}
+{{- if .IsBytes}}
+	m.{{.Name}} = make([]byte, {{.Size}})
+	if err := m.readLen(buf, m.{{.Name}}); err != nil {
+		return 0, err
+	}
+{{if .IsTrimmed}}
+	m.{{.Name}} = bytes.Trim(m.{{.Name}}, "\x00")
+{{end}}
+{{- else}}
+	if err := m.read(buf, &m.{{.Name}}); err != nil {
+		return 0, err
+	}
+{{end}}
+{{- end}}
+	return {{.FullLen}}, nil
+}
+
+// PayloadMessage returns the PayloadMessage, if any.
+func (m {{.Name}}) PayloadMessage() (PayloadMessage, error) {
+{{- if .HasPayload}}
+	p, err := NewPayloadMessageFromCode(m.AssetType)
+	if p == nil || err != nil {
+		return nil, err
+	}
+
+	if _, err := p.Write(m.Payload); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+{{- else}}
+	return nil, nil
+{{- end}}
+}
+
+func (m {{.Name}}) String() string {
+	vals := []string{}
+
+	vals = append(vals, fmt.Sprintf("ProtocolID:%v", m.ProtocolID))
+
+	vals = append(vals, fmt.Sprintf("ActionPrefix:\"%v\"", string(m.ActionPrefix)))
+{{range .Fields}}{{if .Format}}
+	vals = append(vals, fmt.Sprintf("{{.Name}}:{{.Format}}", {{.Value}}))
+{{end}}{{end}}
+	return fmt.Sprintf("{%s}", strings.Join(vals, " "))
+}
+{{end}}`
+
+const protocolFormsTemplate = `package protocol
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+` + generatedNotice + `
+
+// NewFormByCode returns a new ProtocolForm by code.
+//
+// An error will be returned if there is no matching Form.
+func NewFormByCode(code string) (Form, error) {
+{{range .Messages}}
+	if code == Code{{.Name}} {
+		return &{{.Name}}Form{}, nil
+	}
+{{end}}
+	return nil, ErrUnknownMessage
+}
+{{range .Messages}}
+// {{.Name}}Form is the JSON friendly version of a {{.Name}}.
+type {{.Name}}Form struct {
+	BaseForm
+{{range .Fields}}
+	{{.Name}} {{.FormType}} ` + "`" + `json:"{{.JSONName}},omitempty"` + "`" + `
+{{- end}}
+}
+
+// Write implements the io.Writer interface, writing the data in []byte to
+// the receiver.
+func (f *{{.Name}}Form) Write(b []byte) (n int, err error) {
+	if err := json.Unmarshal(b, f); err != nil {
+		return 0, err
+	}
+
+	return len(b), nil
+}
+
+// Validate returns FieldErrors if validation fails, nil otherwise.
+func (f {{.Name}}Form) Validate() error {
+	errs := FieldErrors{}
+{{range .Fields}}{{with .Check}}
+	{{.}}{{end}}{{end}}
+
+	return errs.err()
+}
+
+// PayloadForm returns a PayloadForm for this Form, or nil if this Form has
+// no matching PayloadForm.
+func (f {{.Name}}Form) PayloadForm() (PayloadForm, error) {
+{{- if .HasPayload}}
+	if len(f.Payload) == 0 {
+		return nil, nil
+	}
+
+	pf, err := NewPayloadFormByCode(f.AssetType)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(f.Payload, pf); err != nil {
+		return nil, err
+	}
+
+	b, err := pf.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	f.Payload = b
+
+	return pf, nil
+{{- else}}
+	return nil, nil
+{{- end}}
+}
+
+// BuildMessage returns an OpReturnMessage from the form.
+func (f {{.Name}}Form) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
+	var err error
+
+	m := New{{.Name}}()
+{{range .Fields}}
+{{- if .FromForm}}
+	m.{{.Name}}, err = {{.FromForm}}
+	if err != nil {
+		return nil, err
+	}
+{{else}}
+	m.{{.Name}} = f.{{.Name}}
+{{end}}
+{{- end}}
+{{- if .HasPayload}}
+	pf, err := f.PayloadForm()
+	if err != nil {
+		return nil, err
+	}
+
+	if pf == nil {
+		return &m, nil
+	}
+
+	b, err := pf.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	m.Payload = b
+{{end}}
+	return &m, nil
+}
+
+// Form returns the form of the message, which is its JSON representation.
+func (m {{.Name}}) Form() (*{{.Name}}Form, error) {
+	f := {{.Name}}Form{
+{{- range .Fields}}{{if ne .Type "payload"}}
+		{{.Name}}: {{.ToForm}},
+{{- end}}{{end}}
+	}
+{{- if .HasPayload}}
+
+	if len(bytes.Trim(m.Payload, "\x00")) > 0 {
+		p, err := m.PayloadMessage()
+		if err != nil {
+			return nil, err
+		}
+
+		f.Payload, err = json.Marshal(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+{{- end}}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m {{.Name}}) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *{{.Name}}) UnmarshalJSON(b []byte) error {
+	f := {{.Name}}Form{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*{{.Name}})
+
+	return nil
+}
+{{end}}`
+
+const assetTypesTemplate = `package protocol
+
+import "bytes"
+
+` + generatedNotice + `
+
+const (
+	// AssetTypeLen is the size in bytes of all asset type variants.
+	AssetTypeLen = {{.AssetTypeLen}}
+{{range .AssetTypes}}
+	// CodeAssetType{{.Name}} identifies data as a {{.Label}} message.
+	CodeAssetType{{.Name}} = "{{.Code}}"
+{{end}})
+
+// AssetTypeMapping holds a mapping of asset type codes to constructors of
+// asset types.
+var AssetTypeMapping = map[string]func() PayloadMessage{
+{{range .AssetTypes}}
+	CodeAssetType{{.Name}}: func() PayloadMessage { return NewAssetType{{.Name}}() },
+{{end}}}
+{{range .AssetTypes}}
+// AssetType{{.Name}} asset type.
+type AssetType{{.Name}} struct {
+	BaseMessage
+{{range .Fields}}
+	{{.Name}} {{.GoType}}
+{{- end}}
+}
+
+// NewAssetType{{.Name}} returns a new AssetType{{.Name}}.
+func NewAssetType{{.Name}}() *AssetType{{.Name}} {
+	return &AssetType{{.Name}}{}
+}
+
+// Type returns the type identifer for this message.
+func (m AssetType{{.Name}}) Type() string {
+	return CodeAssetType{{.Name}}
+}
+
+// Len returns the byte size of this message.
+func (m AssetType{{.Name}}) Len() int64 {
+	return AssetTypeLen
+}
+
+// Bytes returns the message in bytes.
+func (m AssetType{{.Name}}) Bytes() ([]byte, error) {
+	buf := new(bytes.Buffer)
+{{range .Fields}}
+	if err := m.write(buf, {{if .IsBytes}}m.pad(m.{{.Name}}, {{.Size}}){{else}}m.{{.Name}}{{end}}); err != nil {
+		return nil, err
+	}
+{{end}}
+	return buf.Bytes(), nil
+}
+
+// Write implements the io.Writer interface, writing the data in []byte to
+// the receiver.
+func (m *AssetType{{.Name}}) Write(b []byte) (int, error) {
+	buf := bytes.NewBuffer(b)
+{{range .Fields}}
+{{- if .IsBytes}}
+	m.{{.Name}} = make([]byte, {{.Size}})
+	if err := m.readLen(buf, m.{{.Name}}); err != nil {
+		return 0, err
+	}
+
+	m.{{.Name}} = bytes.Trim(m.{{.Name}}, "\x00")
+{{else}}
+	if err := m.read(buf, &m.{{.Name}}); err != nil {
+		return 0, err
+	}
+{{end}}
+{{- end}}
+	return int(m.Len()), nil
+}
+
+// Read implements the io.Reader interface, writing the receiver to the
+// []byte.
+func (m AssetType{{.Name}}) Read(b []byte) (int, error) {
+	data, err := m.Bytes()
+
+	if err != nil {
+		return 0, err
+	}
+
+	copy(b, data)
+
+	return len(b), nil
+}
+{{end}}`
+
+const assetTypeFormsTemplate = `package protocol
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+` + generatedNotice + `
+
+// AssetTypeFormMapping holds a mapping of asset type codes to constructors
+// of asset type forms.
+var AssetTypeFormMapping = map[string]func() PayloadForm{
+{{range .AssetTypes}}
+	CodeAssetType{{.Name}}: func() PayloadForm { return NewAssetType{{.Name}}Form() },
+{{end}}}
+{{range .AssetTypes}}
+// AssetType{{.Name}}Form is a JSON friendly model for an asset type.
+type AssetType{{.Name}}Form struct {
+{{- range .Fields}}
+	{{.Name}} {{.FormType}} ` + "`" + `json:"{{.JSONName}},omitempty"` + "`" + `
+{{- end}}
+}
+
+// NewAssetType{{.Name}}Form returns a new AssetType{{.Name}}Form.
+func NewAssetType{{.Name}}Form() *AssetType{{.Name}}Form {
+	return &AssetType{{.Name}}Form{}
+}
+
+// Validate returns FieldErrors if validation fails, nil otherwise.
+func (f AssetType{{.Name}}Form) Validate() error {
+	errs := FieldErrors{}
+{{range .Fields}}{{with .Check}}
+	{{.}}{{end}}{{end}}
+
+	return errs.err()
+}
+
+func (f AssetType{{.Name}}Form) pad(b []byte, l int) []byte {
+	if len(b) == l {
+		return b
+	}
+
+	padding := []byte{}
+	c := l - len(b)
+
+	for i := 0; i < c; i++ {
+		padding = append(padding, 0)
+	}
+
+	return append(b, padding...)
+}
+
+// write writes the value to the buffer.
+func (f AssetType{{.Name}}Form) write(buf *bytes.Buffer,
+	v interface{}) error {
+
+	return binary.Write(buf, binary.BigEndian, v)
+}
+
+// writeBytes writes a string of fixed length to the buffer. If the string
+// is longer that the length an error will be returned.
+func (f AssetType{{.Name}}Form) writeBytes(buf *bytes.Buffer,
+	s string, l int) error {
+
+	if len(s) > l {
+		return fmt.Errorf("length exceeds %v", l)
+	}
+
+	b := f.pad([]byte(s), l)
+
+	return f.write(buf, b)
+}
+
+// Bytes returns the form as a []byte that can be read by a protocol message.
+func (f AssetType{{.Name}}Form) Bytes() ([]byte, error) {
+	buf := new(bytes.Buffer)
+{{range .Fields}}
+	if err := {{if .IsText}}f.writeBytes(buf, f.{{.Name}}, {{.Size}}){{else}}f.write(buf, f.{{.Name}}){{end}}; err != nil {
+		return nil, err
+	}
+{{end}}
+	return buf.Bytes(), nil
+}
+
+// PayloadMessage returns a PayloadMessage from the form, if any.
+func (f AssetType{{.Name}}Form) PayloadMessage(code []byte) (PayloadMessage, error) {
+	m, err := NewPayloadMessageFromCode(code)
+	if err != nil {
+		return nil, err
+	}
+
+	if m != nil {
+		return m, nil
+	}
+
+	return nil, errors.New("Not implemented")
+}
+
+// Form returns the form of the asset type, which is its JSON
+// representation.
+func (m AssetType{{.Name}}) Form() *AssetType{{.Name}}Form {
+	return &AssetType{{.Name}}Form{
+{{- range .Fields}}
+		{{.Name}}: {{.ToForm}},
+{{- end}}
+	}
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the asset
+// type as its form.
+func (m AssetType{{.Name}}) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Form())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// asset type from its form.
+func (m *AssetType{{.Name}}) UnmarshalJSON(b []byte) error {
+	f := NewAssetType{{.Name}}Form()
+	if err := json.Unmarshal(b, f); err != nil {
+		return err
+	}
+
+	if err := f.Validate(); err != nil {
+		return err
+	}
+
+	data, err := f.Bytes()
+	if err != nil {
+		return err
+	}
+
+	_, err = m.Write(data)
+	return err
+}
+{{end}}`
+
+const limitsTemplate = `package protocol
+
+` + generatedNotice + `
+
+const (
+	DustLimit    = uint64(546)
+	LimitDefault = uint64(2000)
+)
+
+var (
+	// Minimum is the minimum value of a transaction for the type.
+	Minimum = map[string]uint64{
+{{- range .Messages}}{{if .Minimum}}
+		Code{{.Name}}: {{.MinimumName}},
+{{- end}}{{end}}
+	}
+)
+`
+
+const roundTripTestTemplate = `package protocol
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+` + generatedNotice + `
+{{range .Messages}}
+func Test{{.Name}}_roundTrip(t *testing.T) {
+	m := New{{.Name}}()
+{{- range $i, $f := .Fields}}
+	m.{{$f.Name}} = {{$f.TestValue $i}}
+{{- end}}
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func Test{{.Name}}_json(t *testing.T) {
+	m := New{{.Name}}()
+{{- range $i, $f := .Fields}}
+	m.{{$f.Name}} = {{$f.TestValue $i}}
+{{- end}}
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := {{.Name}}{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+{{end}}
+{{- range .AssetTypes}}
+func TestAssetType{{.Name}}_roundTrip(t *testing.T) {
+	m := NewAssetType{{.Name}}()
+{{- range $i, $f := .Fields}}
+	m.{{$f.Name}} = {{$f.TestValue $i}}
+{{- end}}
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := NewPayloadMessageFromCode([]byte(CodeAssetType{{.Name}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := got.Write(b); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, m)
+	}
+}
+
+func TestAssetType{{.Name}}_json(t *testing.T) {
+	m := NewAssetType{{.Name}}()
+{{- range $i, $f := .Fields}}
+	m.{{$f.Name}} = {{$f.TestValue $i}}
+{{- end}}
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := NewAssetType{{.Name}}()
+	if err := json.Unmarshal(b, got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, m)
+	}
+}
+{{end}}`
+
+const enumsTemplate = `package protocol
+
+` + generatedNotice + `
+{{range .Enums}}
+const (
+{{- $enum := .}}{{range .Values}}
+	// {{$enum.Name}}{{.Name}} {{.Description}}.
+	{{$enum.Name}}{{.Name}} = byte('{{.Code}}')
+{{end}})
+
+// {{.Plural}} are the names of the codes of {{.Description}}.
+var {{.Plural}} = map[byte]string{
+{{- $enum := .}}{{range .Values}}
+	{{$enum.Name}}{{.Name}}: "{{.Name}}",
+{{- end}}
+}
+{{end}}`
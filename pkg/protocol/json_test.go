@@ -0,0 +1,123 @@
+package protocol
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestContractOffer_MarshalJSON(t *testing.T) {
+	m, err := New(decodeFixture(t, versionFixtures[ProtocolID][0].hex))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"contract_name":"Tesla - Shareholder Agreement","governing_law":"AUS","jurisdiction":"AUS","voting_system":"Majority","restricted_qty":1}`
+
+	if string(b) != want {
+		t.Errorf("got\n    %s\nwant\n    %s", b, want)
+	}
+}
+
+func TestAssetDefinition_MarshalJSON(t *testing.T) {
+	p := NewAssetTypeShareCommon()
+	p.Ticker = []byte("TSLA")
+	p.Description = []byte("Tesla common shares")
+
+	payload, err := p.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewAssetDefinition()
+	m.AssetType = []byte(CodeAssetTypeShareCommon)
+	m.AssetID = []byte("apm2qsznhks23z8d83u41s8019hyri3i")
+	m.AuthorizationFlags = []byte{0x00, AssetIssuerModification | AssetIssuerMintBurn}
+	m.VotingSystem = VotingSystemMajority
+	m.Qty = 1000
+	m.Payload = payload
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"asset_type":"SHC","asset_id":"apm2qsznhks23z8d83u41s8019hyri3i","authorization_flags":["issuer_modification","issuer_mint_burn"],"voting_system":"Majority","qty":1000,"payload":{"ticker":"TSLA","description":"Tesla common shares"}}`
+
+	if string(b) != want {
+		t.Errorf("got\n    %s\nwant\n    %s", b, want)
+	}
+
+	// the JSON is the form of the message
+	f := AssetDefinitionForm{}
+	if _, err := f.Write(b); err != nil {
+		t.Fatal(err)
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantBytes, _ := m.Bytes()
+	gotBytes, _ := built.(*AssetDefinition).Bytes()
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestOrder_MarshalJSON_hash(t *testing.T) {
+	m := NewOrder()
+	m.ComplianceAction = ComplianceActionFreeze
+	m.SupportingEvidenceHash = append([]byte{0x00, 0x01}, make([]byte, 30)...)
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Order{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	// leading and trailing zeros are part of the hash
+	if !bytes.Equal(got.SupportingEvidenceHash, m.SupportingEvidenceHash) {
+		t.Errorf("got hash %x, want %x", got.SupportingEvidenceHash, m.SupportingEvidenceHash)
+	}
+
+	if got.ComplianceAction != ComplianceActionFreeze {
+		t.Errorf("got action %c, want %c", got.ComplianceAction, ComplianceActionFreeze)
+	}
+}
+
+func TestFlagNames(t *testing.T) {
+	// bit 15 has no name
+	b := []byte{0x80, ContractIssuerUpdate}
+
+	names := flagNames(b, ContractFlags)
+
+	want := []string{"issuer_update", "bit_15"}
+	if len(names) != len(want) || names[0] != want[0] || names[1] != want[1] {
+		t.Fatalf("got %v, want %v", names, want)
+	}
+
+	got, err := BaseForm{}.flagBytes(names, ContractFlags)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(got, b) {
+		t.Errorf("got %x, want %x", got, b)
+	}
+
+	if _, err := (BaseForm{}).flagBytes([]string{"bit_16"}, ContractFlags); err == nil {
+		t.Error("got flags for bit 16")
+	}
+}
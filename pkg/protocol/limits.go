@@ -1,5 +1,8 @@
 package protocol
 
+// The code in this file is auto-generated. Do not edit it by hand as it will
+// be overwritten when code is regenerated.
+
 const (
 	DustLimit    = uint64(546)
 	LimitDefault = uint64(2000)
@@ -8,29 +11,29 @@ const (
 var (
 	// Minimum is the minimum value of a transaction for the type.
 	Minimum = map[string]uint64{
-		"C1":                   LimitDefault,
-		"C2":                   DustLimit,
-		"C3":                   LimitDefault,
-		"A1":                   LimitDefault,
-		"A2":                   DustLimit,
-		"A3":                   LimitDefault,
-		"T1":                   LimitDefault,
-		"T2":                   LimitDefault,
-		"T3":                   LimitDefault,
-		"T4":                   DustLimit,
-		"G1":                   LimitDefault,
-		"G2":                   LimitDefault,
-		"G3":                   DustLimit,
-		"G4":                   LimitDefault,
-		"E1":                   LimitDefault,
-		"E2":                   DustLimit,
-		"E3":                   DustLimit,
-		"E4":                   DustLimit,
-		"R1":                   DustLimit,
-		"R2":                   DustLimit,
-		"R3":                   DustLimit,
-		"R4":                   DustLimit,
-		"M1":                   LimitDefault,
-		CodeRejection:          DustLimit,
+		CodeAssetDefinition:   LimitDefault,
+		CodeAssetCreation:     DustLimit,
+		CodeAssetModification: LimitDefault,
+		CodeContractOffer:     LimitDefault,
+		CodeContractFormation: DustLimit,
+		CodeContractAmendment: LimitDefault,
+		CodeOrder:             LimitDefault,
+		CodeFreeze:            DustLimit,
+		CodeThaw:              DustLimit,
+		CodeConfiscation:      DustLimit,
+		CodeInitiative:        LimitDefault,
+		CodeReferendum:        LimitDefault,
+		CodeVote:              DustLimit,
+		CodeBallotCast:        LimitDefault,
+		CodeMessage:           LimitDefault,
+		CodeRejection:         DustLimit,
+		CodeEstablishment:     DustLimit,
+		CodeAddition:          DustLimit,
+		CodeAlteration:        DustLimit,
+		CodeRemoval:           DustLimit,
+		CodeSend:              LimitDefault,
+		CodeExchange:          LimitDefault,
+		CodeSwap:              LimitDefault,
+		CodeSettlement:        DustLimit,
 	}
 )
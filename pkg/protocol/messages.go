@@ -12,12 +12,18 @@ import (
 	"fmt"
 	"io"
 	"strings"
+
+	"github.com/tokenized/smart-contract/pkg/txscript"
 )
 
 const (
 	// ProtocolID is the current protocol ID
 	ProtocolID uint32 = 0x00000020
 
+	// MaxMessageLen is the size of the largest message, excluding the
+	// OP_RETURN header.
+	MaxMessageLen = 220
+
 	// CodeAssetDefinition identifies data as a AssetDefinition message.
 	CodeAssetDefinition = "A1"
 
@@ -100,62 +106,64 @@ const (
 	CodeSettlement = "T4"
 )
 
-// TypeMapping holds a mapping of message codes to message types.
-var TypeMapping = map[string]OpReturnMessage{
+// TypeMapping holds a mapping of message codes to constructors of message
+// types. Each call to a constructor returns a new message, so messages
+// decoded by New are never shared.
+var TypeMapping = map[string]func() OpReturnMessage{
 
-	CodeAssetDefinition: &AssetDefinition{},
+	CodeAssetDefinition: func() OpReturnMessage { return &AssetDefinition{} },
 
-	CodeAssetCreation: &AssetCreation{},
+	CodeAssetCreation: func() OpReturnMessage { return &AssetCreation{} },
 
-	CodeAssetModification: &AssetModification{},
+	CodeAssetModification: func() OpReturnMessage { return &AssetModification{} },
 
-	CodeContractOffer: &ContractOffer{},
+	CodeContractOffer: func() OpReturnMessage { return &ContractOffer{} },
 
-	CodeContractFormation: &ContractFormation{},
+	CodeContractFormation: func() OpReturnMessage { return &ContractFormation{} },
 
-	CodeContractAmendment: &ContractAmendment{},
+	CodeContractAmendment: func() OpReturnMessage { return &ContractAmendment{} },
 
-	CodeOrder: &Order{},
+	CodeOrder: func() OpReturnMessage { return &Order{} },
 
-	CodeFreeze: &Freeze{},
+	CodeFreeze: func() OpReturnMessage { return &Freeze{} },
 
-	CodeThaw: &Thaw{},
+	CodeThaw: func() OpReturnMessage { return &Thaw{} },
 
-	CodeConfiscation: &Confiscation{},
+	CodeConfiscation: func() OpReturnMessage { return &Confiscation{} },
 
-	CodeReconciliation: &Reconciliation{},
+	CodeReconciliation: func() OpReturnMessage { return &Reconciliation{} },
 
-	CodeInitiative: &Initiative{},
+	CodeInitiative: func() OpReturnMessage { return &Initiative{} },
 
-	CodeReferendum: &Referendum{},
+	CodeReferendum: func() OpReturnMessage { return &Referendum{} },
 
-	CodeVote: &Vote{},
+	CodeVote: func() OpReturnMessage { return &Vote{} },
 
-	CodeBallotCast: &BallotCast{},
+	CodeBallotCast: func() OpReturnMessage { return &BallotCast{} },
 
-	CodeBallotCounted: &BallotCounted{},
+	CodeBallotCounted: func() OpReturnMessage { return &BallotCounted{} },
 
-	CodeResult: &Result{},
+	CodeResult: func() OpReturnMessage { return &Result{} },
 
-	CodeMessage: &Message{},
+	CodeMessage: func() OpReturnMessage { return &Message{} },
 
-	CodeRejection: &Rejection{},
+	CodeRejection: func() OpReturnMessage { return &Rejection{} },
 
-	CodeEstablishment: &Establishment{},
+	CodeEstablishment: func() OpReturnMessage { return &Establishment{} },
 
-	CodeAddition: &Addition{},
+	CodeAddition: func() OpReturnMessage { return &Addition{} },
 
-	CodeAlteration: &Alteration{},
+	CodeAlteration: func() OpReturnMessage { return &Alteration{} },
 
-	CodeRemoval: &Removal{},
+	CodeRemoval: func() OpReturnMessage { return &Removal{} },
 
-	CodeSend: &Send{},
+	CodeSend: func() OpReturnMessage { return &Send{} },
 
-	CodeExchange: &Exchange{},
+	CodeExchange: func() OpReturnMessage { return &Exchange{} },
 
-	CodeSwap: &Swap{},
+	CodeSwap: func() OpReturnMessage { return &Swap{} },
 
-	CodeSettlement: &Settlement{},
+	CodeSettlement: func() OpReturnMessage { return &Settlement{} },
 }
 
 // PayloadMessage is the interface for messages that are derived from
@@ -171,41 +179,69 @@ type OpReturnMessage interface {
 	PayloadMessage
 	String() string
 	PayloadMessage() (PayloadMessage, error)
+	Protocol() uint32
 }
 
 // New returns a new message, as an OpReturnMessage, from the OP_RETURN
-// payload.
+// payload. The message is decoded by the codec of the protocol version
+// embedded in the payload.
 func New(b []byte) (OpReturnMessage, error) {
-	code, err := Code(b)
+	return decode(Codecs, b)
+}
+
+const (
+	// headerLen is the size of the protocol ID and message code at the
+	// start of every payload.
+	headerLen = 6
+
+	// maxScriptLen is the size of the largest OP_RETURN script that is
+	// parsed. It allows for OP_FALSE OP_RETURN, and the largest message
+	// pushed a byte at a time, which takes 2 bytes a push.
+	maxScriptLen = 2 + 2*MaxMessageLen
+)
+
+// Payload returns the data pushed by the OP_RETURN script. The script may
+// start with OP_FALSE OP_RETURN, and the data may be split across several
+// pushes.
+func Payload(b []byte) ([]byte, error) {
+	if len(b) > maxScriptLen {
+		return nil, fmt.Errorf("OP_RETURN script size %d exceeds %d", len(b), maxScriptLen)
+	}
+
+	data, err := txscript.NullDataPayload(b)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("Not an OP_RETURN payload : %v", err)
 	}
 
-	t, ok := TypeMapping[code]
-	if !ok {
-		return nil, fmt.Errorf("Unknown code :  %v", code)
+	if len(data) < headerLen {
+		return nil, errors.New("OP_RETURN payload is too short")
 	}
 
-	if _, err := t.Write(b); err != nil {
-		return nil, err
+	if len(data) > MaxMessageLen {
+		return nil, fmt.Errorf("OP_RETURN payload size %d exceeds %d", len(data), MaxMessageLen)
 	}
 
-	return t, nil
+	return data, nil
 }
 
-// Code returns the identifying code from the OP_RETURN payload.
-func Code(b []byte) (string, error) {
-	if len(b) < 9 || b[0] != 0x6a {
-		return "", errors.New("Not an OP_RETURN payload")
+// Version returns the protocol ID from the OP_RETURN payload.
+func Version(b []byte) (uint32, error) {
+	data, err := Payload(b)
+	if err != nil {
+		return 0, err
 	}
 
-	offset := 7
+	return binary.BigEndian.Uint32(data[0:4]), nil
+}
 
-	if b[1] < 0x4c {
-		offset = 6
+// Code returns the identifying code from the OP_RETURN payload.
+func Code(b []byte) (string, error) {
+	data, err := Payload(b)
+	if err != nil {
+		return "", err
 	}
 
-	return string(b[offset : offset+2]), nil
+	return string(data[4:headerLen]), nil
 }
 
 // BaseMessage is a common struct for all messages.
@@ -285,6 +321,11 @@ func (m AssetDefinition) Type() string {
 	return CodeAssetDefinition
 }
 
+// Protocol returns the protocol ID of this message.
+func (m AssetDefinition) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m AssetDefinition) Len() int64 {
 	return int64(len(m.Header)) + 217
@@ -379,7 +420,9 @@ func (m *AssetDefinition) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -388,7 +431,9 @@ func (m *AssetDefinition) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
 	m.AssetType = make([]byte, 3)
 	if err := m.readLen(buf, m.AssetType); err != nil {
@@ -409,13 +454,17 @@ func (m *AssetDefinition) Write(b []byte) (int, error) {
 		return 0, err
 	}
 
-	m.AuthorizationFlags = bytes.Trim(m.AuthorizationFlags, "\x00")
-
-	m.read(buf, &m.VotingSystem)
+	if err := m.read(buf, &m.VotingSystem); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.VoteMultiplier)
+	if err := m.read(buf, &m.VoteMultiplier); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Qty)
+	if err := m.read(buf, &m.Qty); err != nil {
+		return 0, err
+	}
 
 	m.ContractFeeCurrency = make([]byte, 3)
 	if err := m.readLen(buf, m.ContractFeeCurrency); err != nil {
@@ -424,9 +473,13 @@ func (m *AssetDefinition) Write(b []byte) (int, error) {
 
 	m.ContractFeeCurrency = bytes.Trim(m.ContractFeeCurrency, "\x00")
 
-	m.read(buf, &m.ContractFeeVar)
+	if err := m.read(buf, &m.ContractFeeVar); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.ContractFeeFixed)
+	if err := m.read(buf, &m.ContractFeeFixed); err != nil {
+		return 0, err
+	}
 
 	m.Payload = make([]byte, 152)
 	if err := m.readLen(buf, m.Payload); err != nil {
@@ -513,6 +566,11 @@ func (m AssetCreation) Type() string {
 	return CodeAssetCreation
 }
 
+// Protocol returns the protocol ID of this message.
+func (m AssetCreation) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m AssetCreation) Len() int64 {
 	return int64(len(m.Header)) + 219
@@ -611,7 +669,9 @@ func (m *AssetCreation) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -620,7 +680,9 @@ func (m *AssetCreation) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
 	m.AssetType = make([]byte, 3)
 	if err := m.readLen(buf, m.AssetType); err != nil {
@@ -636,20 +698,26 @@ func (m *AssetCreation) Write(b []byte) (int, error) {
 
 	m.AssetID = bytes.Trim(m.AssetID, "\x00")
 
-	m.read(buf, &m.AssetRevision)
+	if err := m.read(buf, &m.AssetRevision); err != nil {
+		return 0, err
+	}
 
 	m.AuthorizationFlags = make([]byte, 2)
 	if err := m.readLen(buf, m.AuthorizationFlags); err != nil {
 		return 0, err
 	}
 
-	m.AuthorizationFlags = bytes.Trim(m.AuthorizationFlags, "\x00")
-
-	m.read(buf, &m.VotingSystem)
+	if err := m.read(buf, &m.VotingSystem); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.VoteMultiplier)
+	if err := m.read(buf, &m.VoteMultiplier); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Qty)
+	if err := m.read(buf, &m.Qty); err != nil {
+		return 0, err
+	}
 
 	m.ContractFeeCurrency = make([]byte, 3)
 	if err := m.readLen(buf, m.ContractFeeCurrency); err != nil {
@@ -658,9 +726,13 @@ func (m *AssetCreation) Write(b []byte) (int, error) {
 
 	m.ContractFeeCurrency = bytes.Trim(m.ContractFeeCurrency, "\x00")
 
-	m.read(buf, &m.ContractFeeVar)
+	if err := m.read(buf, &m.ContractFeeVar); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.ContractFeeFixed)
+	if err := m.read(buf, &m.ContractFeeFixed); err != nil {
+		return 0, err
+	}
 
 	m.Payload = make([]byte, 152)
 	if err := m.readLen(buf, m.Payload); err != nil {
@@ -755,6 +827,11 @@ func (m AssetModification) Type() string {
 	return CodeAssetModification
 }
 
+// Protocol returns the protocol ID of this message.
+func (m AssetModification) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m AssetModification) Len() int64 {
 	return int64(len(m.Header)) + 219
@@ -853,7 +930,9 @@ func (m *AssetModification) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -862,7 +941,9 @@ func (m *AssetModification) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
 	m.AssetType = make([]byte, 3)
 	if err := m.readLen(buf, m.AssetType); err != nil {
@@ -878,20 +959,26 @@ func (m *AssetModification) Write(b []byte) (int, error) {
 
 	m.AssetID = bytes.Trim(m.AssetID, "\x00")
 
-	m.read(buf, &m.AssetRevision)
+	if err := m.read(buf, &m.AssetRevision); err != nil {
+		return 0, err
+	}
 
 	m.AuthorizationFlags = make([]byte, 2)
 	if err := m.readLen(buf, m.AuthorizationFlags); err != nil {
 		return 0, err
 	}
 
-	m.AuthorizationFlags = bytes.Trim(m.AuthorizationFlags, "\x00")
-
-	m.read(buf, &m.VotingSystem)
+	if err := m.read(buf, &m.VotingSystem); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.VoteMultiplier)
+	if err := m.read(buf, &m.VoteMultiplier); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Qty)
+	if err := m.read(buf, &m.Qty); err != nil {
+		return 0, err
+	}
 
 	m.ContractFeeCurrency = make([]byte, 3)
 	if err := m.readLen(buf, m.ContractFeeCurrency); err != nil {
@@ -900,9 +987,13 @@ func (m *AssetModification) Write(b []byte) (int, error) {
 
 	m.ContractFeeCurrency = bytes.Trim(m.ContractFeeCurrency, "\x00")
 
-	m.read(buf, &m.ContractFeeVar)
+	if err := m.read(buf, &m.ContractFeeVar); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.ContractFeeFixed)
+	if err := m.read(buf, &m.ContractFeeFixed); err != nil {
+		return 0, err
+	}
 
 	m.Payload = make([]byte, 152)
 	if err := m.readLen(buf, m.Payload); err != nil {
@@ -1003,6 +1094,11 @@ func (m ContractOffer) Type() string {
 	return CodeContractOffer
 }
 
+// Protocol returns the protocol ID of this message.
+func (m ContractOffer) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m ContractOffer) Len() int64 {
 	return int64(len(m.Header)) + 218
@@ -1113,7 +1209,9 @@ func (m *ContractOffer) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -1122,7 +1220,9 @@ func (m *ContractOffer) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
 	m.ContractName = make([]byte, 32)
 	if err := m.readLen(buf, m.ContractName); err != nil {
@@ -1136,8 +1236,6 @@ func (m *ContractOffer) Write(b []byte) (int, error) {
 		return 0, err
 	}
 
-	m.ContractFileHash = bytes.Trim(m.ContractFileHash, "\x00")
-
 	m.GoverningLaw = make([]byte, 5)
 	if err := m.readLen(buf, m.GoverningLaw); err != nil {
 		return 0, err
@@ -1152,7 +1250,9 @@ func (m *ContractOffer) Write(b []byte) (int, error) {
 
 	m.Jurisdiction = bytes.Trim(m.Jurisdiction, "\x00")
 
-	m.read(buf, &m.ContractExpiration)
+	if err := m.read(buf, &m.ContractExpiration); err != nil {
+		return 0, err
+	}
 
 	m.URI = make([]byte, 78)
 	if err := m.readLen(buf, m.URI); err != nil {
@@ -1168,7 +1268,9 @@ func (m *ContractOffer) Write(b []byte) (int, error) {
 
 	m.IssuerID = bytes.Trim(m.IssuerID, "\x00")
 
-	m.read(buf, &m.IssuerType)
+	if err := m.read(buf, &m.IssuerType); err != nil {
+		return 0, err
+	}
 
 	m.ContractOperatorID = make([]byte, 16)
 	if err := m.readLen(buf, m.ContractOperatorID); err != nil {
@@ -1182,11 +1284,13 @@ func (m *ContractOffer) Write(b []byte) (int, error) {
 		return 0, err
 	}
 
-	m.AuthorizationFlags = bytes.Trim(m.AuthorizationFlags, "\x00")
-
-	m.read(buf, &m.VotingSystem)
+	if err := m.read(buf, &m.VotingSystem); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.InitiativeThreshold)
+	if err := m.read(buf, &m.InitiativeThreshold); err != nil {
+		return 0, err
+	}
 
 	m.InitiativeThresholdCurrency = make([]byte, 3)
 	if err := m.readLen(buf, m.InitiativeThresholdCurrency); err != nil {
@@ -1195,7 +1299,9 @@ func (m *ContractOffer) Write(b []byte) (int, error) {
 
 	m.InitiativeThresholdCurrency = bytes.Trim(m.InitiativeThresholdCurrency, "\x00")
 
-	m.read(buf, &m.RestrictedQty)
+	if err := m.read(buf, &m.RestrictedQty); err != nil {
+		return 0, err
+	}
 
 	return 221, nil
 }
@@ -1285,6 +1391,11 @@ func (m ContractFormation) Type() string {
 	return CodeContractFormation
 }
 
+// Protocol returns the protocol ID of this message.
+func (m ContractFormation) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m ContractFormation) Len() int64 {
 	return int64(len(m.Header)) + 220
@@ -1399,7 +1510,9 @@ func (m *ContractFormation) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -1408,7 +1521,9 @@ func (m *ContractFormation) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
 	m.ContractName = make([]byte, 32)
 	if err := m.readLen(buf, m.ContractName); err != nil {
@@ -1422,8 +1537,6 @@ func (m *ContractFormation) Write(b []byte) (int, error) {
 		return 0, err
 	}
 
-	m.ContractFileHash = bytes.Trim(m.ContractFileHash, "\x00")
-
 	m.GoverningLaw = make([]byte, 5)
 	if err := m.readLen(buf, m.GoverningLaw); err != nil {
 		return 0, err
@@ -1438,7 +1551,9 @@ func (m *ContractFormation) Write(b []byte) (int, error) {
 
 	m.Jurisdiction = bytes.Trim(m.Jurisdiction, "\x00")
 
-	m.read(buf, &m.ContractExpiration)
+	if err := m.read(buf, &m.ContractExpiration); err != nil {
+		return 0, err
+	}
 
 	m.URI = make([]byte, 78)
 	if err := m.readLen(buf, m.URI); err != nil {
@@ -1447,7 +1562,9 @@ func (m *ContractFormation) Write(b []byte) (int, error) {
 
 	m.URI = bytes.Trim(m.URI, "\x00")
 
-	m.read(buf, &m.ContractRevision)
+	if err := m.read(buf, &m.ContractRevision); err != nil {
+		return 0, err
+	}
 
 	m.IssuerID = make([]byte, 16)
 	if err := m.readLen(buf, m.IssuerID); err != nil {
@@ -1456,7 +1573,9 @@ func (m *ContractFormation) Write(b []byte) (int, error) {
 
 	m.IssuerID = bytes.Trim(m.IssuerID, "\x00")
 
-	m.read(buf, &m.IssuerType)
+	if err := m.read(buf, &m.IssuerType); err != nil {
+		return 0, err
+	}
 
 	m.ContractOperatorID = make([]byte, 16)
 	if err := m.readLen(buf, m.ContractOperatorID); err != nil {
@@ -1470,11 +1589,13 @@ func (m *ContractFormation) Write(b []byte) (int, error) {
 		return 0, err
 	}
 
-	m.AuthorizationFlags = bytes.Trim(m.AuthorizationFlags, "\x00")
-
-	m.read(buf, &m.VotingSystem)
+	if err := m.read(buf, &m.VotingSystem); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.InitiativeThreshold)
+	if err := m.read(buf, &m.InitiativeThreshold); err != nil {
+		return 0, err
+	}
 
 	m.InitiativeThresholdCurrency = make([]byte, 3)
 	if err := m.readLen(buf, m.InitiativeThresholdCurrency); err != nil {
@@ -1483,7 +1604,9 @@ func (m *ContractFormation) Write(b []byte) (int, error) {
 
 	m.InitiativeThresholdCurrency = bytes.Trim(m.InitiativeThresholdCurrency, "\x00")
 
-	m.read(buf, &m.RestrictedQty)
+	if err := m.read(buf, &m.RestrictedQty); err != nil {
+		return 0, err
+	}
 
 	return 223, nil
 }
@@ -1581,6 +1704,11 @@ func (m ContractAmendment) Type() string {
 	return CodeContractAmendment
 }
 
+// Protocol returns the protocol ID of this message.
+func (m ContractAmendment) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m ContractAmendment) Len() int64 {
 	return int64(len(m.Header)) + 220
@@ -1695,7 +1823,9 @@ func (m *ContractAmendment) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -1704,7 +1834,9 @@ func (m *ContractAmendment) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
 	m.ContractName = make([]byte, 32)
 	if err := m.readLen(buf, m.ContractName); err != nil {
@@ -1718,8 +1850,6 @@ func (m *ContractAmendment) Write(b []byte) (int, error) {
 		return 0, err
 	}
 
-	m.ContractFileHash = bytes.Trim(m.ContractFileHash, "\x00")
-
 	m.GoverningLaw = make([]byte, 5)
 	if err := m.readLen(buf, m.GoverningLaw); err != nil {
 		return 0, err
@@ -1734,7 +1864,9 @@ func (m *ContractAmendment) Write(b []byte) (int, error) {
 
 	m.Jurisdiction = bytes.Trim(m.Jurisdiction, "\x00")
 
-	m.read(buf, &m.ContractExpiration)
+	if err := m.read(buf, &m.ContractExpiration); err != nil {
+		return 0, err
+	}
 
 	m.URI = make([]byte, 78)
 	if err := m.readLen(buf, m.URI); err != nil {
@@ -1743,7 +1875,9 @@ func (m *ContractAmendment) Write(b []byte) (int, error) {
 
 	m.URI = bytes.Trim(m.URI, "\x00")
 
-	m.read(buf, &m.ContractRevision)
+	if err := m.read(buf, &m.ContractRevision); err != nil {
+		return 0, err
+	}
 
 	m.IssuerID = make([]byte, 16)
 	if err := m.readLen(buf, m.IssuerID); err != nil {
@@ -1752,7 +1886,9 @@ func (m *ContractAmendment) Write(b []byte) (int, error) {
 
 	m.IssuerID = bytes.Trim(m.IssuerID, "\x00")
 
-	m.read(buf, &m.IssuerType)
+	if err := m.read(buf, &m.IssuerType); err != nil {
+		return 0, err
+	}
 
 	m.ContractOperatorID = make([]byte, 16)
 	if err := m.readLen(buf, m.ContractOperatorID); err != nil {
@@ -1766,11 +1902,13 @@ func (m *ContractAmendment) Write(b []byte) (int, error) {
 		return 0, err
 	}
 
-	m.AuthorizationFlags = bytes.Trim(m.AuthorizationFlags, "\x00")
-
-	m.read(buf, &m.VotingSystem)
+	if err := m.read(buf, &m.VotingSystem); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.InitiativeThreshold)
+	if err := m.read(buf, &m.InitiativeThreshold); err != nil {
+		return 0, err
+	}
 
 	m.InitiativeThresholdCurrency = make([]byte, 3)
 	if err := m.readLen(buf, m.InitiativeThresholdCurrency); err != nil {
@@ -1779,7 +1917,9 @@ func (m *ContractAmendment) Write(b []byte) (int, error) {
 
 	m.InitiativeThresholdCurrency = bytes.Trim(m.InitiativeThresholdCurrency, "\x00")
 
-	m.read(buf, &m.RestrictedQty)
+	if err := m.read(buf, &m.RestrictedQty); err != nil {
+		return 0, err
+	}
 
 	return 223, nil
 }
@@ -1866,6 +2006,11 @@ func (m Order) Type() string {
 	return CodeOrder
 }
 
+// Protocol returns the protocol ID of this message.
+func (m Order) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m Order) Len() int64 {
 	return int64(len(m.Header)) + 220
@@ -1956,7 +2101,9 @@ func (m *Order) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -1965,7 +2112,9 @@ func (m *Order) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
 	m.AssetType = make([]byte, 3)
 	if err := m.readLen(buf, m.AssetType); err != nil {
@@ -1981,7 +2130,9 @@ func (m *Order) Write(b []byte) (int, error) {
 
 	m.AssetID = bytes.Trim(m.AssetID, "\x00")
 
-	m.read(buf, &m.ComplianceAction)
+	if err := m.read(buf, &m.ComplianceAction); err != nil {
+		return 0, err
+	}
 
 	m.TargetAddress = make([]byte, 34)
 	if err := m.readLen(buf, m.TargetAddress); err != nil {
@@ -2002,11 +2153,13 @@ func (m *Order) Write(b []byte) (int, error) {
 		return 0, err
 	}
 
-	m.SupportingEvidenceHash = bytes.Trim(m.SupportingEvidenceHash, "\x00")
-
-	m.read(buf, &m.Qty)
+	if err := m.read(buf, &m.Qty); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Expiration)
+	if err := m.read(buf, &m.Expiration); err != nil {
+		return 0, err
+	}
 
 	m.Message = make([]byte, 61)
 	if err := m.readLen(buf, m.Message); err != nil {
@@ -2086,6 +2239,11 @@ func (m Freeze) Type() string {
 	return CodeFreeze
 }
 
+// Protocol returns the protocol ID of this message.
+func (m Freeze) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m Freeze) Len() int64 {
 	return int64(len(m.Header)) + 127
@@ -2164,7 +2322,9 @@ func (m *Freeze) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -2173,7 +2333,9 @@ func (m *Freeze) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
 	m.AssetType = make([]byte, 3)
 	if err := m.readLen(buf, m.AssetType); err != nil {
@@ -2189,11 +2351,17 @@ func (m *Freeze) Write(b []byte) (int, error) {
 
 	m.AssetID = bytes.Trim(m.AssetID, "\x00")
 
-	m.read(buf, &m.Timestamp)
+	if err := m.read(buf, &m.Timestamp); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Qty)
+	if err := m.read(buf, &m.Qty); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Expiration)
+	if err := m.read(buf, &m.Expiration); err != nil {
+		return 0, err
+	}
 
 	m.Message = make([]byte, 61)
 	if err := m.readLen(buf, m.Message); err != nil {
@@ -2264,6 +2432,11 @@ func (m Thaw) Type() string {
 	return CodeThaw
 }
 
+// Protocol returns the protocol ID of this message.
+func (m Thaw) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m Thaw) Len() int64 {
 	return int64(len(m.Header)) + 119
@@ -2338,7 +2511,9 @@ func (m *Thaw) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -2347,7 +2522,9 @@ func (m *Thaw) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
 	m.AssetType = make([]byte, 3)
 	if err := m.readLen(buf, m.AssetType); err != nil {
@@ -2363,9 +2540,13 @@ func (m *Thaw) Write(b []byte) (int, error) {
 
 	m.AssetID = bytes.Trim(m.AssetID, "\x00")
 
-	m.read(buf, &m.Timestamp)
+	if err := m.read(buf, &m.Timestamp); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Qty)
+	if err := m.read(buf, &m.Qty); err != nil {
+		return 0, err
+	}
 
 	m.Message = make([]byte, 61)
 	if err := m.readLen(buf, m.Message); err != nil {
@@ -2434,6 +2615,11 @@ func (m Confiscation) Type() string {
 	return CodeConfiscation
 }
 
+// Protocol returns the protocol ID of this message.
+func (m Confiscation) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m Confiscation) Len() int64 {
 	return int64(len(m.Header)) + 127
@@ -2512,7 +2698,9 @@ func (m *Confiscation) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -2521,7 +2709,9 @@ func (m *Confiscation) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
 	m.AssetType = make([]byte, 3)
 	if err := m.readLen(buf, m.AssetType); err != nil {
@@ -2537,11 +2727,17 @@ func (m *Confiscation) Write(b []byte) (int, error) {
 
 	m.AssetID = bytes.Trim(m.AssetID, "\x00")
 
-	m.read(buf, &m.Timestamp)
+	if err := m.read(buf, &m.Timestamp); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.TargetsQty)
+	if err := m.read(buf, &m.TargetsQty); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.DepositsQty)
+	if err := m.read(buf, &m.DepositsQty); err != nil {
+		return 0, err
+	}
 
 	m.Message = make([]byte, 61)
 	if err := m.readLen(buf, m.Message); err != nil {
@@ -2612,6 +2808,11 @@ func (m Reconciliation) Type() string {
 	return CodeReconciliation
 }
 
+// Protocol returns the protocol ID of this message.
+func (m Reconciliation) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m Reconciliation) Len() int64 {
 	return int64(len(m.Header)) + 151
@@ -2690,7 +2891,9 @@ func (m *Reconciliation) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -2699,7 +2902,9 @@ func (m *Reconciliation) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
 	m.AssetType = make([]byte, 3)
 	if err := m.readLen(buf, m.AssetType); err != nil {
@@ -2720,11 +2925,13 @@ func (m *Reconciliation) Write(b []byte) (int, error) {
 		return 0, err
 	}
 
-	m.RefTxnID = bytes.Trim(m.RefTxnID, "\x00")
-
-	m.read(buf, &m.TargetAddressQty)
+	if err := m.read(buf, &m.TargetAddressQty); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Timestamp)
+	if err := m.read(buf, &m.Timestamp); err != nil {
+		return 0, err
+	}
 
 	m.Message = make([]byte, 61)
 	if err := m.readLen(buf, m.Message); err != nil {
@@ -2804,6 +3011,11 @@ func (m Initiative) Type() string {
 	return CodeInitiative
 }
 
+// Protocol returns the protocol ID of this message.
+func (m Initiative) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m Initiative) Len() int64 {
 	return int64(len(m.Header)) + 183
@@ -2894,7 +3106,9 @@ func (m *Initiative) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -2903,7 +3117,9 @@ func (m *Initiative) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
 	m.AssetType = make([]byte, 3)
 	if err := m.readLen(buf, m.AssetType); err != nil {
@@ -2919,7 +3135,9 @@ func (m *Initiative) Write(b []byte) (int, error) {
 
 	m.AssetID = bytes.Trim(m.AssetID, "\x00")
 
-	m.read(buf, &m.VoteType)
+	if err := m.read(buf, &m.VoteType); err != nil {
+		return 0, err
+	}
 
 	m.VoteOptions = make([]byte, 16)
 	if err := m.readLen(buf, m.VoteOptions); err != nil {
@@ -2928,9 +3146,13 @@ func (m *Initiative) Write(b []byte) (int, error) {
 
 	m.VoteOptions = bytes.Trim(m.VoteOptions, "\x00")
 
-	m.read(buf, &m.VoteMax)
+	if err := m.read(buf, &m.VoteMax); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.VoteLogic)
+	if err := m.read(buf, &m.VoteLogic); err != nil {
+		return 0, err
+	}
 
 	m.ProposalDescription = make([]byte, 82)
 	if err := m.readLen(buf, m.ProposalDescription); err != nil {
@@ -2944,9 +3166,9 @@ func (m *Initiative) Write(b []byte) (int, error) {
 		return 0, err
 	}
 
-	m.ProposalDocumentHash = bytes.Trim(m.ProposalDocumentHash, "\x00")
-
-	m.read(buf, &m.VoteCutOffTimestamp)
+	if err := m.read(buf, &m.VoteCutOffTimestamp); err != nil {
+		return 0, err
+	}
 
 	return 186, nil
 }
@@ -3020,6 +3242,11 @@ func (m Referendum) Type() string {
 	return CodeReferendum
 }
 
+// Protocol returns the protocol ID of this message.
+func (m Referendum) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m Referendum) Len() int64 {
 	return int64(len(m.Header)) + 183
@@ -3110,7 +3337,9 @@ func (m *Referendum) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -3119,7 +3348,9 @@ func (m *Referendum) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
 	m.AssetType = make([]byte, 3)
 	if err := m.readLen(buf, m.AssetType); err != nil {
@@ -3135,7 +3366,9 @@ func (m *Referendum) Write(b []byte) (int, error) {
 
 	m.AssetID = bytes.Trim(m.AssetID, "\x00")
 
-	m.read(buf, &m.VoteType)
+	if err := m.read(buf, &m.VoteType); err != nil {
+		return 0, err
+	}
 
 	m.VoteOptions = make([]byte, 16)
 	if err := m.readLen(buf, m.VoteOptions); err != nil {
@@ -3144,9 +3377,13 @@ func (m *Referendum) Write(b []byte) (int, error) {
 
 	m.VoteOptions = bytes.Trim(m.VoteOptions, "\x00")
 
-	m.read(buf, &m.VoteMax)
+	if err := m.read(buf, &m.VoteMax); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.VoteLogic)
+	if err := m.read(buf, &m.VoteLogic); err != nil {
+		return 0, err
+	}
 
 	m.ProposalDescription = make([]byte, 82)
 	if err := m.readLen(buf, m.ProposalDescription); err != nil {
@@ -3160,9 +3397,9 @@ func (m *Referendum) Write(b []byte) (int, error) {
 		return 0, err
 	}
 
-	m.ProposalDocumentHash = bytes.Trim(m.ProposalDocumentHash, "\x00")
-
-	m.read(buf, &m.VoteCutOffTimestamp)
+	if err := m.read(buf, &m.VoteCutOffTimestamp); err != nil {
+		return 0, err
+	}
 
 	return 186, nil
 }
@@ -3237,6 +3474,11 @@ func (m Vote) Type() string {
 	return CodeVote
 }
 
+// Protocol returns the protocol ID of this message.
+func (m Vote) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m Vote) Len() int64 {
 	return int64(len(m.Header)) + 191
@@ -3331,7 +3573,9 @@ func (m *Vote) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -3340,7 +3584,9 @@ func (m *Vote) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
 	m.AssetType = make([]byte, 3)
 	if err := m.readLen(buf, m.AssetType); err != nil {
@@ -3356,7 +3602,9 @@ func (m *Vote) Write(b []byte) (int, error) {
 
 	m.AssetID = bytes.Trim(m.AssetID, "\x00")
 
-	m.read(buf, &m.VoteType)
+	if err := m.read(buf, &m.VoteType); err != nil {
+		return 0, err
+	}
 
 	m.VoteOptions = make([]byte, 16)
 	if err := m.readLen(buf, m.VoteOptions); err != nil {
@@ -3365,9 +3613,13 @@ func (m *Vote) Write(b []byte) (int, error) {
 
 	m.VoteOptions = bytes.Trim(m.VoteOptions, "\x00")
 
-	m.read(buf, &m.VoteMax)
+	if err := m.read(buf, &m.VoteMax); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.VoteLogic)
+	if err := m.read(buf, &m.VoteLogic); err != nil {
+		return 0, err
+	}
 
 	m.ProposalDescription = make([]byte, 82)
 	if err := m.readLen(buf, m.ProposalDescription); err != nil {
@@ -3381,11 +3633,13 @@ func (m *Vote) Write(b []byte) (int, error) {
 		return 0, err
 	}
 
-	m.ProposalDocumentHash = bytes.Trim(m.ProposalDocumentHash, "\x00")
-
-	m.read(buf, &m.VoteCutOffTimestamp)
+	if err := m.read(buf, &m.VoteCutOffTimestamp); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Timestamp)
+	if err := m.read(buf, &m.Timestamp); err != nil {
+		return 0, err
+	}
 
 	return 194, nil
 }
@@ -3457,6 +3711,11 @@ func (m BallotCast) Type() string {
 	return CodeBallotCast
 }
 
+// Protocol returns the protocol ID of this message.
+func (m BallotCast) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m BallotCast) Len() int64 {
 	return int64(len(m.Header)) + 90
@@ -3527,7 +3786,9 @@ func (m *BallotCast) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -3536,7 +3797,9 @@ func (m *BallotCast) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
 	m.AssetType = make([]byte, 3)
 	if err := m.readLen(buf, m.AssetType); err != nil {
@@ -3557,8 +3820,6 @@ func (m *BallotCast) Write(b []byte) (int, error) {
 		return 0, err
 	}
 
-	m.VoteTxnID = bytes.Trim(m.VoteTxnID, "\x00")
-
 	m.Vote = make([]byte, 16)
 	if err := m.readLen(buf, m.Vote); err != nil {
 		return 0, err
@@ -3625,6 +3886,11 @@ func (m BallotCounted) Type() string {
 	return CodeBallotCounted
 }
 
+// Protocol returns the protocol ID of this message.
+func (m BallotCounted) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m BallotCounted) Len() int64 {
 	return int64(len(m.Header)) + 98
@@ -3699,7 +3965,9 @@ func (m *BallotCounted) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -3708,7 +3976,9 @@ func (m *BallotCounted) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
 	m.AssetType = make([]byte, 3)
 	if err := m.readLen(buf, m.AssetType); err != nil {
@@ -3729,8 +3999,6 @@ func (m *BallotCounted) Write(b []byte) (int, error) {
 		return 0, err
 	}
 
-	m.VoteTxnID = bytes.Trim(m.VoteTxnID, "\x00")
-
 	m.Vote = make([]byte, 16)
 	if err := m.readLen(buf, m.Vote); err != nil {
 		return 0, err
@@ -3738,7 +4006,9 @@ func (m *BallotCounted) Write(b []byte) (int, error) {
 
 	m.Vote = bytes.Trim(m.Vote, "\x00")
 
-	m.read(buf, &m.Timestamp)
+	if err := m.read(buf, &m.Timestamp); err != nil {
+		return 0, err
+	}
 
 	return 101, nil
 }
@@ -3814,6 +4084,11 @@ func (m Result) Type() string {
 	return CodeResult
 }
 
+// Protocol returns the protocol ID of this message.
+func (m Result) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m Result) Len() int64 {
 	return int64(len(m.Header)) + 219
@@ -3952,7 +4227,9 @@ func (m *Result) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -3961,7 +4238,9 @@ func (m *Result) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
 	m.AssetType = make([]byte, 3)
 	if err := m.readLen(buf, m.AssetType); err != nil {
@@ -3977,46 +4256,78 @@ func (m *Result) Write(b []byte) (int, error) {
 
 	m.AssetID = bytes.Trim(m.AssetID, "\x00")
 
-	m.read(buf, &m.VoteType)
+	if err := m.read(buf, &m.VoteType); err != nil {
+		return 0, err
+	}
 
 	m.VoteTxnID = make([]byte, 32)
 	if err := m.readLen(buf, m.VoteTxnID); err != nil {
 		return 0, err
 	}
 
-	m.VoteTxnID = bytes.Trim(m.VoteTxnID, "\x00")
-
-	m.read(buf, &m.Timestamp)
+	if err := m.read(buf, &m.Timestamp); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Option1Tally)
+	if err := m.read(buf, &m.Option1Tally); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Option2Tally)
+	if err := m.read(buf, &m.Option2Tally); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Option3Tally)
+	if err := m.read(buf, &m.Option3Tally); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Option4Tally)
+	if err := m.read(buf, &m.Option4Tally); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Option5Tally)
+	if err := m.read(buf, &m.Option5Tally); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Option6Tally)
+	if err := m.read(buf, &m.Option6Tally); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Option7Tally)
+	if err := m.read(buf, &m.Option7Tally); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Option8Tally)
+	if err := m.read(buf, &m.Option8Tally); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Option9Tally)
+	if err := m.read(buf, &m.Option9Tally); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Option10Tally)
+	if err := m.read(buf, &m.Option10Tally); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Option11Tally)
+	if err := m.read(buf, &m.Option11Tally); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Option12Tally)
+	if err := m.read(buf, &m.Option12Tally); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Option13Tally)
+	if err := m.read(buf, &m.Option13Tally); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Option14Tally)
+	if err := m.read(buf, &m.Option14Tally); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Option15Tally)
+	if err := m.read(buf, &m.Option15Tally); err != nil {
+		return 0, err
+	}
 
 	m.Result = make([]byte, 16)
 	if err := m.readLen(buf, m.Result); err != nil {
@@ -4123,6 +4434,11 @@ func (m Message) Type() string {
 	return CodeMessage
 }
 
+// Protocol returns the protocol ID of this message.
+func (m Message) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m Message) Len() int64 {
 	return int64(len(m.Header)) + 220
@@ -4189,7 +4505,9 @@ func (m *Message) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -4198,9 +4516,13 @@ func (m *Message) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Timestamp)
+	if err := m.read(buf, &m.Timestamp); err != nil {
+		return 0, err
+	}
 
 	m.MessageType = make([]byte, 2)
 	if err := m.readLen(buf, m.MessageType); err != nil {
@@ -4278,6 +4600,11 @@ func (m Rejection) Type() string {
 	return CodeRejection
 }
 
+// Protocol returns the protocol ID of this message.
+func (m Rejection) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m Rejection) Len() int64 {
 	return int64(len(m.Header)) + 220
@@ -4352,7 +4679,9 @@ func (m *Rejection) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -4361,9 +4690,13 @@ func (m *Rejection) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Timestamp)
+	if err := m.read(buf, &m.Timestamp); err != nil {
+		return 0, err
+	}
 
 	m.AssetType = make([]byte, 3)
 	if err := m.readLen(buf, m.AssetType); err != nil {
@@ -4379,7 +4712,9 @@ func (m *Rejection) Write(b []byte) (int, error) {
 
 	m.AssetID = bytes.Trim(m.AssetID, "\x00")
 
-	m.read(buf, &m.RejectionType)
+	if err := m.read(buf, &m.RejectionType); err != nil {
+		return 0, err
+	}
 
 	m.Message = make([]byte, 169)
 	if err := m.readLen(buf, m.Message); err != nil {
@@ -4454,6 +4789,11 @@ func (m Establishment) Type() string {
 	return CodeEstablishment
 }
 
+// Protocol returns the protocol ID of this message.
+func (m Establishment) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m Establishment) Len() int64 {
 	return int64(len(m.Header)) + 220
@@ -4536,7 +4876,9 @@ func (m *Establishment) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -4545,7 +4887,9 @@ func (m *Establishment) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
 	m.Registrar = make([]byte, 16)
 	if err := m.readLen(buf, m.Registrar); err != nil {
@@ -4554,7 +4898,9 @@ func (m *Establishment) Write(b []byte) (int, error) {
 
 	m.Registrar = bytes.Trim(m.Registrar, "\x00")
 
-	m.read(buf, &m.RegisterType)
+	if err := m.read(buf, &m.RegisterType); err != nil {
+		return 0, err
+	}
 
 	m.KYCJurisdiction = make([]byte, 5)
 	if err := m.readLen(buf, m.KYCJurisdiction); err != nil {
@@ -4563,7 +4909,9 @@ func (m *Establishment) Write(b []byte) (int, error) {
 
 	m.KYCJurisdiction = bytes.Trim(m.KYCJurisdiction, "\x00")
 
-	m.read(buf, &m.DOB)
+	if err := m.read(buf, &m.DOB); err != nil {
+		return 0, err
+	}
 
 	m.CountryOfResidence = make([]byte, 3)
 	if err := m.readLen(buf, m.CountryOfResidence); err != nil {
@@ -4577,8 +4925,6 @@ func (m *Establishment) Write(b []byte) (int, error) {
 		return 0, err
 	}
 
-	m.SupportingDocumentationHash = bytes.Trim(m.SupportingDocumentationHash, "\x00")
-
 	m.Message = make([]byte, 148)
 	if err := m.readLen(buf, m.Message); err != nil {
 		return 0, err
@@ -4654,6 +5000,11 @@ func (m Addition) Type() string {
 	return CodeAddition
 }
 
+// Protocol returns the protocol ID of this message.
+func (m Addition) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m Addition) Len() int64 {
 	return int64(len(m.Header)) + 208
@@ -4736,7 +5087,9 @@ func (m *Addition) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -4745,7 +5098,9 @@ func (m *Addition) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
 	m.Sublist = make([]byte, 4)
 	if err := m.readLen(buf, m.Sublist); err != nil {
@@ -4754,7 +5109,9 @@ func (m *Addition) Write(b []byte) (int, error) {
 
 	m.Sublist = bytes.Trim(m.Sublist, "\x00")
 
-	m.read(buf, &m.KYC)
+	if err := m.read(buf, &m.KYC); err != nil {
+		return 0, err
+	}
 
 	m.KYCJurisdiction = make([]byte, 5)
 	if err := m.readLen(buf, m.KYCJurisdiction); err != nil {
@@ -4763,7 +5120,9 @@ func (m *Addition) Write(b []byte) (int, error) {
 
 	m.KYCJurisdiction = bytes.Trim(m.KYCJurisdiction, "\x00")
 
-	m.read(buf, &m.DOB)
+	if err := m.read(buf, &m.DOB); err != nil {
+		return 0, err
+	}
 
 	m.CountryOfResidence = make([]byte, 3)
 	if err := m.readLen(buf, m.CountryOfResidence); err != nil {
@@ -4777,8 +5136,6 @@ func (m *Addition) Write(b []byte) (int, error) {
 		return 0, err
 	}
 
-	m.SupportingDocumentationHash = bytes.Trim(m.SupportingDocumentationHash, "\x00")
-
 	m.Message = make([]byte, 148)
 	if err := m.readLen(buf, m.Message); err != nil {
 		return 0, err
@@ -4850,6 +5207,11 @@ func (m Alteration) Type() string {
 	return CodeAlteration
 }
 
+// Protocol returns the protocol ID of this message.
+func (m Alteration) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m Alteration) Len() int64 {
 	return int64(len(m.Header)) + 220
@@ -4932,7 +5294,9 @@ func (m *Alteration) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -4941,7 +5305,9 @@ func (m *Alteration) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
 	m.Sublist = make([]byte, 4)
 	if err := m.readLen(buf, m.Sublist); err != nil {
@@ -4950,7 +5316,9 @@ func (m *Alteration) Write(b []byte) (int, error) {
 
 	m.Sublist = bytes.Trim(m.Sublist, "\x00")
 
-	m.read(buf, &m.KYC)
+	if err := m.read(buf, &m.KYC); err != nil {
+		return 0, err
+	}
 
 	m.KYCJurisdiction = make([]byte, 5)
 	if err := m.readLen(buf, m.KYCJurisdiction); err != nil {
@@ -4959,7 +5327,9 @@ func (m *Alteration) Write(b []byte) (int, error) {
 
 	m.KYCJurisdiction = bytes.Trim(m.KYCJurisdiction, "\x00")
 
-	m.read(buf, &m.DOB)
+	if err := m.read(buf, &m.DOB); err != nil {
+		return 0, err
+	}
 
 	m.CountryOfResidence = make([]byte, 3)
 	if err := m.readLen(buf, m.CountryOfResidence); err != nil {
@@ -4973,8 +5343,6 @@ func (m *Alteration) Write(b []byte) (int, error) {
 		return 0, err
 	}
 
-	m.SupportingDocumentationHash = bytes.Trim(m.SupportingDocumentationHash, "\x00")
-
 	m.Message = make([]byte, 160)
 	if err := m.readLen(buf, m.Message); err != nil {
 		return 0, err
@@ -5042,6 +5410,11 @@ func (m Removal) Type() string {
 	return CodeRemoval
 }
 
+// Protocol returns the protocol ID of this message.
+func (m Removal) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m Removal) Len() int64 {
 	return int64(len(m.Header)) + 220
@@ -5104,7 +5477,9 @@ func (m *Removal) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -5113,15 +5488,15 @@ func (m *Removal) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
 	m.SupportingDocumentationHash = make([]byte, 32)
 	if err := m.readLen(buf, m.SupportingDocumentationHash); err != nil {
 		return 0, err
 	}
 
-	m.SupportingDocumentationHash = bytes.Trim(m.SupportingDocumentationHash, "\x00")
-
 	m.Message = make([]byte, 181)
 	if err := m.readLen(buf, m.Message); err != nil {
 		return 0, err
@@ -5188,6 +5563,11 @@ func (m Send) Type() string {
 	return CodeSend
 }
 
+// Protocol returns the protocol ID of this message.
+func (m Send) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m Send) Len() int64 {
 	return int64(len(m.Header)) + 50
@@ -5254,7 +5634,9 @@ func (m *Send) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -5263,7 +5645,9 @@ func (m *Send) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
 	m.AssetType = make([]byte, 3)
 	if err := m.readLen(buf, m.AssetType); err != nil {
@@ -5279,7 +5663,9 @@ func (m *Send) Write(b []byte) (int, error) {
 
 	m.AssetID = bytes.Trim(m.AssetID, "\x00")
 
-	m.read(buf, &m.TokenQty)
+	if err := m.read(buf, &m.TokenQty); err != nil {
+		return 0, err
+	}
 
 	return 52, nil
 }
@@ -5338,6 +5724,11 @@ func (m Exchange) Type() string {
 	return CodeExchange
 }
 
+// Protocol returns the protocol ID of this message.
+func (m Exchange) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m Exchange) Len() int64 {
 	return int64(len(m.Header)) + 103
@@ -5424,7 +5815,9 @@ func (m *Exchange) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -5433,7 +5826,9 @@ func (m *Exchange) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
 	m.Party1AssetType = make([]byte, 3)
 	if err := m.readLen(buf, m.Party1AssetType); err != nil {
@@ -5449,9 +5844,13 @@ func (m *Exchange) Write(b []byte) (int, error) {
 
 	m.Party1AssetID = bytes.Trim(m.Party1AssetID, "\x00")
 
-	m.read(buf, &m.Party1TokenQty)
+	if err := m.read(buf, &m.Party1TokenQty); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.OfferValidUntil)
+	if err := m.read(buf, &m.OfferValidUntil); err != nil {
+		return 0, err
+	}
 
 	m.ExchangeFeeCurrency = make([]byte, 3)
 	if err := m.readLen(buf, m.ExchangeFeeCurrency); err != nil {
@@ -5460,9 +5859,13 @@ func (m *Exchange) Write(b []byte) (int, error) {
 
 	m.ExchangeFeeCurrency = bytes.Trim(m.ExchangeFeeCurrency, "\x00")
 
-	m.read(buf, &m.ExchangeFeeVar)
+	if err := m.read(buf, &m.ExchangeFeeVar); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.ExchangeFeeFixed)
+	if err := m.read(buf, &m.ExchangeFeeFixed); err != nil {
+		return 0, err
+	}
 
 	m.ExchangeFeeAddress = make([]byte, 34)
 	if err := m.readLen(buf, m.ExchangeFeeAddress); err != nil {
@@ -5543,6 +5946,11 @@ func (m Swap) Type() string {
 	return CodeSwap
 }
 
+// Protocol returns the protocol ID of this message.
+func (m Swap) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m Swap) Len() int64 {
 	return int64(len(m.Header)) + 146
@@ -5641,7 +6049,9 @@ func (m *Swap) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -5650,7 +6060,9 @@ func (m *Swap) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
 	m.Party1AssetType = make([]byte, 3)
 	if err := m.readLen(buf, m.Party1AssetType); err != nil {
@@ -5666,9 +6078,13 @@ func (m *Swap) Write(b []byte) (int, error) {
 
 	m.Party1AssetID = bytes.Trim(m.Party1AssetID, "\x00")
 
-	m.read(buf, &m.Party1TokenQty)
+	if err := m.read(buf, &m.Party1TokenQty); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.OfferValidUntil)
+	if err := m.read(buf, &m.OfferValidUntil); err != nil {
+		return 0, err
+	}
 
 	m.Party2AssetType = make([]byte, 3)
 	if err := m.readLen(buf, m.Party2AssetType); err != nil {
@@ -5684,7 +6100,9 @@ func (m *Swap) Write(b []byte) (int, error) {
 
 	m.Party2AssetID = bytes.Trim(m.Party2AssetID, "\x00")
 
-	m.read(buf, &m.Party2TokenQty)
+	if err := m.read(buf, &m.Party2TokenQty); err != nil {
+		return 0, err
+	}
 
 	m.ExchangeFeeCurrency = make([]byte, 3)
 	if err := m.readLen(buf, m.ExchangeFeeCurrency); err != nil {
@@ -5693,9 +6111,13 @@ func (m *Swap) Write(b []byte) (int, error) {
 
 	m.ExchangeFeeCurrency = bytes.Trim(m.ExchangeFeeCurrency, "\x00")
 
-	m.read(buf, &m.ExchangeFeeVar)
+	if err := m.read(buf, &m.ExchangeFeeVar); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.ExchangeFeeFixed)
+	if err := m.read(buf, &m.ExchangeFeeFixed); err != nil {
+		return 0, err
+	}
 
 	m.ExchangeFeeAddress = make([]byte, 34)
 	if err := m.readLen(buf, m.ExchangeFeeAddress); err != nil {
@@ -5775,6 +6197,11 @@ func (m Settlement) Type() string {
 	return CodeSettlement
 }
 
+// Protocol returns the protocol ID of this message.
+func (m Settlement) Protocol() uint32 {
+	return m.ProtocolID
+}
+
 // Len returns the byte size of this message.
 func (m Settlement) Len() int64 {
 	return int64(len(m.Header)) + 66
@@ -5849,7 +6276,9 @@ func (m *Settlement) Write(b []byte) (int, error) {
 
 	m.Header = bytes.Trim(m.Header, "\x00")
 
-	m.read(buf, &m.ProtocolID)
+	if err := m.read(buf, &m.ProtocolID); err != nil {
+		return 0, err
+	}
 
 	m.ActionPrefix = make([]byte, 2)
 	if err := m.readLen(buf, m.ActionPrefix); err != nil {
@@ -5858,7 +6287,9 @@ func (m *Settlement) Write(b []byte) (int, error) {
 
 	m.ActionPrefix = bytes.Trim(m.ActionPrefix, "\x00")
 
-	m.read(buf, &m.Version)
+	if err := m.read(buf, &m.Version); err != nil {
+		return 0, err
+	}
 
 	m.AssetType = make([]byte, 3)
 	if err := m.readLen(buf, m.AssetType); err != nil {
@@ -5874,11 +6305,17 @@ func (m *Settlement) Write(b []byte) (int, error) {
 
 	m.AssetID = bytes.Trim(m.AssetID, "\x00")
 
-	m.read(buf, &m.Party1TokenQty)
+	if err := m.read(buf, &m.Party1TokenQty); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Party2TokenQty)
+	if err := m.read(buf, &m.Party2TokenQty); err != nil {
+		return 0, err
+	}
 
-	m.read(buf, &m.Timestamp)
+	if err := m.read(buf, &m.Timestamp); err != nil {
+		return 0, err
+	}
 
 	return 68, nil
 }
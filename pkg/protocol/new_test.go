@@ -0,0 +1,109 @@
+package protocol
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+// newTestPayloads returns n OP_RETURN payloads of mixed message types, each
+// with different values, and the message each should decode to.
+func newTestPayloads(t *testing.T, n int) ([][]byte, []OpReturnMessage) {
+	payloads := [][]byte{}
+	want := []OpReturnMessage{}
+
+	for i := 0; i < n; i++ {
+		var m OpReturnMessage
+
+		switch i % 3 {
+		case 0:
+			send := NewSend()
+			send.AssetType = []byte("SHC")
+			send.AssetID = []byte(fmt.Sprintf("send%d", i))
+			send.TokenQty = uint64(i)
+			m = &send
+
+		case 1:
+			freeze := NewFreeze()
+			freeze.AssetType = []byte("COU")
+			freeze.AssetID = []byte(fmt.Sprintf("freeze%d", i))
+			freeze.Qty = uint64(i)
+			freeze.Message = []byte(fmt.Sprintf("frozen %d", i))
+			m = &freeze
+
+		case 2:
+			message := NewMessage()
+			message.Timestamp = uint64(i)
+			message.MessageType = []byte("00")
+			message.Message = []byte(fmt.Sprintf("message %d", i))
+			m = &message
+		}
+
+		b := make([]byte, m.Len())
+		if _, err := m.Read(b); err != nil {
+			t.Fatal(err)
+		}
+
+		payloads = append(payloads, b)
+		want = append(want, m)
+	}
+
+	return payloads, want
+}
+
+func TestNew_fresh(t *testing.T) {
+	payloads, want := newTestPayloads(t, 6)
+
+	first, err := New(payloads[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// decode another message of the same type
+	second, err := New(payloads[3])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if first == second {
+		t.Fatal("decoded the same message twice")
+	}
+
+	if first.String() != want[0].String() {
+		t.Errorf("got\n    %v\nwant\n    %v", first.String(), want[0].String())
+	}
+}
+
+// TestNew_parallel decodes messages from many goroutines at once. Run it
+// with -race.
+func TestNew_parallel(t *testing.T) {
+	payloads, want := newTestPayloads(t, 3000)
+
+	wg := sync.WaitGroup{}
+	errs := make(chan error, len(payloads))
+
+	for i := range payloads {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			m, err := New(payloads[i])
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			if m.Type() != want[i].Type() || m.String() != want[i].String() {
+				errs <- fmt.Errorf("payload %d : got %v, want %v", i, m, want[i])
+			}
+		}(i)
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+}
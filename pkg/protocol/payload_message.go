@@ -5,11 +5,10 @@ import "fmt"
 // NewPayloadMessageFromCode returns the approriate PayloadMessage for the
 // given code.
 func NewPayloadMessageFromCode(code []byte) (PayloadMessage, error) {
-	s := string(code)
-	switch s {
-	case CodeAssetTypeShareCommon:
-		return NewAssetTypeShareCommon(), nil
+	newPayload, ok := AssetTypeMapping[string(code)]
+	if !ok {
+		return nil, fmt.Errorf("No asset type for code %s", code)
 	}
 
-	return nil, fmt.Errorf("No asset type for code %s", code)
+	return newPayload(), nil
 }
@@ -0,0 +1,170 @@
+package protocol
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+// pushData returns the data as a single push with the given opcode, one of
+// OP_PUSHDATA1, OP_PUSHDATA2 or OP_PUSHDATA4.
+func pushData(op byte, data []byte) []byte {
+	b := []byte{op}
+
+	switch op {
+	case 0x4c:
+		b = append(b, byte(len(data)))
+	case 0x4d:
+		l := make([]byte, 2)
+		binary.LittleEndian.PutUint16(l, uint16(len(data)))
+		b = append(b, l...)
+	case 0x4e:
+		l := make([]byte, 4)
+		binary.LittleEndian.PutUint32(l, uint32(len(data)))
+		b = append(b, l...)
+	}
+
+	return append(b, data...)
+}
+
+func TestNew_scripts(t *testing.T) {
+	// a Send has 50 bytes of data, so it is pushed with OP_DATA_50
+	script := decodeFixture(t, versionFixtures[ProtocolID][1].hex)
+	data := script[2:]
+
+	tests := []struct {
+		name   string
+		script []byte
+	}{
+		{
+			name:   "OP_RETURN",
+			script: script,
+		},
+		{
+			name:   "OP_FALSE OP_RETURN",
+			script: append([]byte{0x00}, script...),
+		},
+		{
+			name:   "OP_PUSHDATA1",
+			script: append([]byte{0x6a}, pushData(0x4c, data)...),
+		},
+		{
+			name:   "OP_PUSHDATA2",
+			script: append([]byte{0x6a}, pushData(0x4d, data)...),
+		},
+		{
+			name:   "OP_PUSHDATA4",
+			script: append([]byte{0x00, 0x6a}, pushData(0x4e, data)...),
+		},
+		{
+			name: "split pushes",
+			script: append(append([]byte{0x6a, 0x06}, data[:6]...),
+				pushData(0x4c, data[6:])...),
+		},
+	}
+
+	want, err := New(script)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := New(tt.script)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if m.String() != want.String() {
+				t.Errorf("got\n    %v\nwant\n    %v", m, want)
+			}
+		})
+	}
+}
+
+func TestNew_malformed(t *testing.T) {
+	script := decodeFixture(t, versionFixtures[ProtocolID][1].hex)
+	data := script[2:]
+
+	tests := []struct {
+		name   string
+		script []byte
+	}{
+		{
+			name:   "empty",
+			script: []byte{},
+		},
+		{
+			name:   "OP_RETURN only",
+			script: []byte{0x6a},
+		},
+		{
+			name:   "OP_FALSE only",
+			script: []byte{0x00},
+		},
+		{
+			name:   "not OP_RETURN",
+			script: append([]byte{0x76}, script[1:]...),
+		},
+		{
+			name:   "truncated push",
+			script: script[:len(script)-1],
+		},
+		{
+			name:   "truncated OP_PUSHDATA2 length",
+			script: []byte{0x6a, 0x4d, 0x32},
+		},
+		{
+			name:   "OP_PUSHDATA4 past the end",
+			script: []byte{0x6a, 0x4e, 0xff, 0xff, 0xff, 0xff, 0x00},
+		},
+		{
+			name:   "header only",
+			script: append([]byte{0x6a, 0x06}, data[:6]...),
+		},
+		{
+			name:   "short payload",
+			script: append([]byte{0x6a, 0x03}, data[:3]...),
+		},
+		{
+			name:   "trailing data",
+			script: append(append([]byte{0x6a}, pushData(0x4c, data)...), 0x01, 0x00),
+		},
+		{
+			name:   "non push opcode",
+			script: append(append([]byte{}, script...), 0x76),
+		},
+		{
+			name:   "larger than any message",
+			script: append([]byte{0x6a}, pushData(0x4d, make([]byte, MaxMessageLen+1))...),
+		},
+		{
+			name:   "script too long",
+			script: append([]byte{0x6a}, make([]byte, maxScriptLen)...),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(tt.script); err == nil {
+				t.Fatal("decoded a malformed script")
+			}
+
+			// code and version never index past the end of the script
+			Code(tt.script)
+			Version(tt.script)
+		})
+	}
+}
+
+func TestSend_Write_short(t *testing.T) {
+	script := decodeFixture(t, versionFixtures[ProtocolID][1].hex)
+
+	// every field is read, so a message cut short is an error, even when
+	// only a number is missing.
+	for _, l := range []int{0, 2, 6, len(script) - 1} {
+		m := Send{}
+		if _, err := m.Write(script[:l]); err == nil {
+			t.Errorf("read %d bytes of %d without error", l, len(script))
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package protocol
 
-import "encoding/json"
+import (
+	"bytes"
+	"encoding/json"
+)
 
 // The code in this file is auto-generated. Do not edit it by hand as it will
 // be overwritten when code is regenerated.
@@ -128,7 +131,7 @@ type AssetDefinitionForm struct {
 	Version             uint8           `json:"version,omitempty"`
 	AssetType           string          `json:"asset_type,omitempty"`
 	AssetID             string          `json:"asset_id,omitempty"`
-	AuthorizationFlags  string          `json:"authorization_flags,omitempty"`
+	AuthorizationFlags  []string        `json:"authorization_flags,omitempty"`
 	VotingSystem        string          `json:"voting_system,omitempty"`
 	VoteMultiplier      uint8           `json:"vote_multiplier,omitempty"`
 	Qty                 uint64          `json:"qty,omitempty"`
@@ -148,14 +151,27 @@ func (f *AssetDefinitionForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f AssetDefinitionForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("asset_type", f.AssetType, 3)
+	errs.checkLen("asset_id", f.AssetID, 32)
+	errs.checkFlags("authorization_flags", f.AuthorizationFlags, AssetFlags)
+	errs.checkEnum("voting_system", f.VotingSystem, VotingSystems)
+	errs.checkLen("contract_fee_currency", f.ContractFeeCurrency, 3)
+	errs.checkPayload("payload", f.AssetType, f.Payload)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
 // no matching PayloadForm.
 func (f AssetDefinitionForm) PayloadForm() (PayloadForm, error) {
+	if len(f.Payload) == 0 {
+		return nil, nil
+	}
+
 	pf, err := NewPayloadFormByCode(f.AssetType)
 	if err != nil {
 		return nil, err
@@ -177,6 +193,10 @@ func (f AssetDefinitionForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f AssetDefinitionForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewAssetDefinition()
@@ -193,12 +213,12 @@ func (f AssetDefinitionForm) BuildMessage() (OpReturnMessage, error) {
 		return nil, err
 	}
 
-	m.AuthorizationFlags, err = f.pad(f.AuthorizationFlags, 2)
+	m.AuthorizationFlags, err = f.flagBytes(f.AuthorizationFlags, AssetFlags)
 	if err != nil {
 		return nil, err
 	}
 
-	m.VotingSystem, err = f.ensureByte(f.VotingSystem)
+	m.VotingSystem, err = f.enumByte(f.VotingSystem, VotingSystems)
 	if err != nil {
 		return nil, err
 	}
@@ -237,6 +257,65 @@ func (f AssetDefinitionForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
+// Form returns the form of the message, which is its JSON representation.
+func (m AssetDefinition) Form() (*AssetDefinitionForm, error) {
+	f := AssetDefinitionForm{
+		Version:             m.Version,
+		AssetType:           text(m.AssetType),
+		AssetID:             text(m.AssetID),
+		AuthorizationFlags:  flagNames(m.AuthorizationFlags, AssetFlags),
+		VotingSystem:        enumText(m.VotingSystem, VotingSystems),
+		VoteMultiplier:      m.VoteMultiplier,
+		Qty:                 m.Qty,
+		ContractFeeCurrency: text(m.ContractFeeCurrency),
+		ContractFeeVar:      m.ContractFeeVar,
+		ContractFeeFixed:    m.ContractFeeFixed,
+	}
+
+	if len(bytes.Trim(m.Payload, "\x00")) > 0 {
+		p, err := m.PayloadMessage()
+		if err != nil {
+			return nil, err
+		}
+
+		f.Payload, err = json.Marshal(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m AssetDefinition) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *AssetDefinition) UnmarshalJSON(b []byte) error {
+	f := AssetDefinitionForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*AssetDefinition)
+
+	return nil
+}
+
 // AssetCreationForm is the JSON friendly version of a AssetCreation.
 type AssetCreationForm struct {
 	BaseForm
@@ -245,7 +324,7 @@ type AssetCreationForm struct {
 	AssetType           string          `json:"asset_type,omitempty"`
 	AssetID             string          `json:"asset_id,omitempty"`
 	AssetRevision       uint16          `json:"asset_revision,omitempty"`
-	AuthorizationFlags  string          `json:"authorization_flags,omitempty"`
+	AuthorizationFlags  []string        `json:"authorization_flags,omitempty"`
 	VotingSystem        string          `json:"voting_system,omitempty"`
 	VoteMultiplier      uint8           `json:"vote_multiplier,omitempty"`
 	Qty                 uint64          `json:"qty,omitempty"`
@@ -265,14 +344,27 @@ func (f *AssetCreationForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f AssetCreationForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("asset_type", f.AssetType, 3)
+	errs.checkLen("asset_id", f.AssetID, 32)
+	errs.checkFlags("authorization_flags", f.AuthorizationFlags, AssetFlags)
+	errs.checkEnum("voting_system", f.VotingSystem, VotingSystems)
+	errs.checkLen("contract_fee_currency", f.ContractFeeCurrency, 3)
+	errs.checkPayload("payload", f.AssetType, f.Payload)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
 // no matching PayloadForm.
 func (f AssetCreationForm) PayloadForm() (PayloadForm, error) {
+	if len(f.Payload) == 0 {
+		return nil, nil
+	}
+
 	pf, err := NewPayloadFormByCode(f.AssetType)
 	if err != nil {
 		return nil, err
@@ -294,6 +386,10 @@ func (f AssetCreationForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f AssetCreationForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewAssetCreation()
@@ -312,12 +408,12 @@ func (f AssetCreationForm) BuildMessage() (OpReturnMessage, error) {
 
 	m.AssetRevision = f.AssetRevision
 
-	m.AuthorizationFlags, err = f.pad(f.AuthorizationFlags, 2)
+	m.AuthorizationFlags, err = f.flagBytes(f.AuthorizationFlags, AssetFlags)
 	if err != nil {
 		return nil, err
 	}
 
-	m.VotingSystem, err = f.ensureByte(f.VotingSystem)
+	m.VotingSystem, err = f.enumByte(f.VotingSystem, VotingSystems)
 	if err != nil {
 		return nil, err
 	}
@@ -356,6 +452,66 @@ func (f AssetCreationForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
+// Form returns the form of the message, which is its JSON representation.
+func (m AssetCreation) Form() (*AssetCreationForm, error) {
+	f := AssetCreationForm{
+		Version:             m.Version,
+		AssetType:           text(m.AssetType),
+		AssetID:             text(m.AssetID),
+		AssetRevision:       m.AssetRevision,
+		AuthorizationFlags:  flagNames(m.AuthorizationFlags, AssetFlags),
+		VotingSystem:        enumText(m.VotingSystem, VotingSystems),
+		VoteMultiplier:      m.VoteMultiplier,
+		Qty:                 m.Qty,
+		ContractFeeCurrency: text(m.ContractFeeCurrency),
+		ContractFeeVar:      m.ContractFeeVar,
+		ContractFeeFixed:    m.ContractFeeFixed,
+	}
+
+	if len(bytes.Trim(m.Payload, "\x00")) > 0 {
+		p, err := m.PayloadMessage()
+		if err != nil {
+			return nil, err
+		}
+
+		f.Payload, err = json.Marshal(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m AssetCreation) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *AssetCreation) UnmarshalJSON(b []byte) error {
+	f := AssetCreationForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*AssetCreation)
+
+	return nil
+}
+
 // AssetModificationForm is the JSON friendly version of a AssetModification.
 type AssetModificationForm struct {
 	BaseForm
@@ -364,7 +520,7 @@ type AssetModificationForm struct {
 	AssetType           string          `json:"asset_type,omitempty"`
 	AssetID             string          `json:"asset_id,omitempty"`
 	AssetRevision       uint16          `json:"asset_revision,omitempty"`
-	AuthorizationFlags  string          `json:"authorization_flags,omitempty"`
+	AuthorizationFlags  []string        `json:"authorization_flags,omitempty"`
 	VotingSystem        string          `json:"voting_system,omitempty"`
 	VoteMultiplier      uint8           `json:"vote_multiplier,omitempty"`
 	Qty                 uint64          `json:"qty,omitempty"`
@@ -384,14 +540,27 @@ func (f *AssetModificationForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f AssetModificationForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("asset_type", f.AssetType, 3)
+	errs.checkLen("asset_id", f.AssetID, 32)
+	errs.checkFlags("authorization_flags", f.AuthorizationFlags, AssetFlags)
+	errs.checkEnum("voting_system", f.VotingSystem, VotingSystems)
+	errs.checkLen("contract_fee_currency", f.ContractFeeCurrency, 3)
+	errs.checkPayload("payload", f.AssetType, f.Payload)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
 // no matching PayloadForm.
 func (f AssetModificationForm) PayloadForm() (PayloadForm, error) {
+	if len(f.Payload) == 0 {
+		return nil, nil
+	}
+
 	pf, err := NewPayloadFormByCode(f.AssetType)
 	if err != nil {
 		return nil, err
@@ -413,6 +582,10 @@ func (f AssetModificationForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f AssetModificationForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewAssetModification()
@@ -431,12 +604,12 @@ func (f AssetModificationForm) BuildMessage() (OpReturnMessage, error) {
 
 	m.AssetRevision = f.AssetRevision
 
-	m.AuthorizationFlags, err = f.pad(f.AuthorizationFlags, 2)
+	m.AuthorizationFlags, err = f.flagBytes(f.AuthorizationFlags, AssetFlags)
 	if err != nil {
 		return nil, err
 	}
 
-	m.VotingSystem, err = f.ensureByte(f.VotingSystem)
+	m.VotingSystem, err = f.enumByte(f.VotingSystem, VotingSystems)
 	if err != nil {
 		return nil, err
 	}
@@ -475,25 +648,85 @@ func (f AssetModificationForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
+// Form returns the form of the message, which is its JSON representation.
+func (m AssetModification) Form() (*AssetModificationForm, error) {
+	f := AssetModificationForm{
+		Version:             m.Version,
+		AssetType:           text(m.AssetType),
+		AssetID:             text(m.AssetID),
+		AssetRevision:       m.AssetRevision,
+		AuthorizationFlags:  flagNames(m.AuthorizationFlags, AssetFlags),
+		VotingSystem:        enumText(m.VotingSystem, VotingSystems),
+		VoteMultiplier:      m.VoteMultiplier,
+		Qty:                 m.Qty,
+		ContractFeeCurrency: text(m.ContractFeeCurrency),
+		ContractFeeVar:      m.ContractFeeVar,
+		ContractFeeFixed:    m.ContractFeeFixed,
+	}
+
+	if len(bytes.Trim(m.Payload, "\x00")) > 0 {
+		p, err := m.PayloadMessage()
+		if err != nil {
+			return nil, err
+		}
+
+		f.Payload, err = json.Marshal(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m AssetModification) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *AssetModification) UnmarshalJSON(b []byte) error {
+	f := AssetModificationForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*AssetModification)
+
+	return nil
+}
+
 // ContractOfferForm is the JSON friendly version of a ContractOffer.
 type ContractOfferForm struct {
 	BaseForm
 
-	Version                     uint8   `json:"version,omitempty"`
-	ContractName                string  `json:"contract_name,omitempty"`
-	ContractFileHash            string  `json:"contract_file_hash,omitempty"`
-	GoverningLaw                string  `json:"governing_law,omitempty"`
-	Jurisdiction                string  `json:"jurisdiction,omitempty"`
-	ContractExpiration          uint64  `json:"contract_expiration,omitempty"`
-	URI                         string  `json:"uri,omitempty"`
-	IssuerID                    string  `json:"issuer_id,omitempty"`
-	IssuerType                  string  `json:"issuer_type,omitempty"`
-	ContractOperatorID          string  `json:"contract_operator_id,omitempty"`
-	AuthorizationFlags          string  `json:"authorization_flags,omitempty"`
-	VotingSystem                string  `json:"voting_system,omitempty"`
-	InitiativeThreshold         float32 `json:"initiative_threshold,omitempty"`
-	InitiativeThresholdCurrency string  `json:"initiative_threshold_currency,omitempty"`
-	RestrictedQty               uint64  `json:"restricted_qty,omitempty"`
+	Version                     uint8    `json:"version,omitempty"`
+	ContractName                string   `json:"contract_name,omitempty"`
+	ContractFileHash            string   `json:"contract_file_hash,omitempty"`
+	GoverningLaw                string   `json:"governing_law,omitempty"`
+	Jurisdiction                string   `json:"jurisdiction,omitempty"`
+	ContractExpiration          uint64   `json:"contract_expiration,omitempty"`
+	URI                         string   `json:"uri,omitempty"`
+	IssuerID                    string   `json:"issuer_id,omitempty"`
+	IssuerType                  string   `json:"issuer_type,omitempty"`
+	ContractOperatorID          string   `json:"contract_operator_id,omitempty"`
+	AuthorizationFlags          []string `json:"authorization_flags,omitempty"`
+	VotingSystem                string   `json:"voting_system,omitempty"`
+	InitiativeThreshold         float32  `json:"initiative_threshold,omitempty"`
+	InitiativeThresholdCurrency string   `json:"initiative_threshold_currency,omitempty"`
+	RestrictedQty               uint64   `json:"restricted_qty,omitempty"`
 }
 
 // Write implements the io.Writer interface, writing the data in []byte to
@@ -506,9 +739,23 @@ func (f *ContractOfferForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f ContractOfferForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("contract_name", f.ContractName, 32)
+	errs.checkHex("contract_file_hash", f.ContractFileHash, 32)
+	errs.checkLen("governing_law", f.GoverningLaw, 5)
+	errs.checkLen("jurisdiction", f.Jurisdiction, 5)
+	errs.checkLen("uri", f.URI, 78)
+	errs.checkLen("issuer_id", f.IssuerID, 16)
+	errs.checkEnum("issuer_type", f.IssuerType, IssuerTypes)
+	errs.checkLen("contract_operator_id", f.ContractOperatorID, 16)
+	errs.checkFlags("authorization_flags", f.AuthorizationFlags, ContractFlags)
+	errs.checkEnum("voting_system", f.VotingSystem, VotingSystems)
+	errs.checkLen("initiative_threshold_currency", f.InitiativeThresholdCurrency, 3)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
@@ -519,6 +766,10 @@ func (f ContractOfferForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f ContractOfferForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewContractOffer()
@@ -530,7 +781,7 @@ func (f ContractOfferForm) BuildMessage() (OpReturnMessage, error) {
 		return nil, err
 	}
 
-	m.ContractFileHash, err = f.pad(f.ContractFileHash, 32)
+	m.ContractFileHash, err = f.hexBytes(f.ContractFileHash, 32)
 	if err != nil {
 		return nil, err
 	}
@@ -557,7 +808,7 @@ func (f ContractOfferForm) BuildMessage() (OpReturnMessage, error) {
 		return nil, err
 	}
 
-	m.IssuerType, err = f.ensureByte(f.IssuerType)
+	m.IssuerType, err = f.enumByte(f.IssuerType, IssuerTypes)
 	if err != nil {
 		return nil, err
 	}
@@ -567,12 +818,12 @@ func (f ContractOfferForm) BuildMessage() (OpReturnMessage, error) {
 		return nil, err
 	}
 
-	m.AuthorizationFlags, err = f.pad(f.AuthorizationFlags, 2)
+	m.AuthorizationFlags, err = f.flagBytes(f.AuthorizationFlags, ContractFlags)
 	if err != nil {
 		return nil, err
 	}
 
-	m.VotingSystem, err = f.ensureByte(f.VotingSystem)
+	m.VotingSystem, err = f.enumByte(f.VotingSystem, VotingSystems)
 	if err != nil {
 		return nil, err
 	}
@@ -589,26 +840,78 @@ func (f ContractOfferForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
+// Form returns the form of the message, which is its JSON representation.
+func (m ContractOffer) Form() (*ContractOfferForm, error) {
+	f := ContractOfferForm{
+		Version:                     m.Version,
+		ContractName:                text(m.ContractName),
+		ContractFileHash:            hashText(m.ContractFileHash),
+		GoverningLaw:                text(m.GoverningLaw),
+		Jurisdiction:                text(m.Jurisdiction),
+		ContractExpiration:          m.ContractExpiration,
+		URI:                         text(m.URI),
+		IssuerID:                    text(m.IssuerID),
+		IssuerType:                  enumText(m.IssuerType, IssuerTypes),
+		ContractOperatorID:          text(m.ContractOperatorID),
+		AuthorizationFlags:          flagNames(m.AuthorizationFlags, ContractFlags),
+		VotingSystem:                enumText(m.VotingSystem, VotingSystems),
+		InitiativeThreshold:         m.InitiativeThreshold,
+		InitiativeThresholdCurrency: text(m.InitiativeThresholdCurrency),
+		RestrictedQty:               m.RestrictedQty,
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m ContractOffer) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *ContractOffer) UnmarshalJSON(b []byte) error {
+	f := ContractOfferForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*ContractOffer)
+
+	return nil
+}
+
 // ContractFormationForm is the JSON friendly version of a ContractFormation.
 type ContractFormationForm struct {
 	BaseForm
 
-	Version                     uint8   `json:"version,omitempty"`
-	ContractName                string  `json:"contract_name,omitempty"`
-	ContractFileHash            string  `json:"contract_file_hash,omitempty"`
-	GoverningLaw                string  `json:"governing_law,omitempty"`
-	Jurisdiction                string  `json:"jurisdiction,omitempty"`
-	ContractExpiration          uint64  `json:"contract_expiration,omitempty"`
-	URI                         string  `json:"uri,omitempty"`
-	ContractRevision            uint16  `json:"contract_revision,omitempty"`
-	IssuerID                    string  `json:"issuer_id,omitempty"`
-	IssuerType                  string  `json:"issuer_type,omitempty"`
-	ContractOperatorID          string  `json:"contract_operator_id,omitempty"`
-	AuthorizationFlags          string  `json:"authorization_flags,omitempty"`
-	VotingSystem                string  `json:"voting_system,omitempty"`
-	InitiativeThreshold         float32 `json:"initiative_threshold,omitempty"`
-	InitiativeThresholdCurrency string  `json:"initiative_threshold_currency,omitempty"`
-	RestrictedQty               uint64  `json:"restricted_qty,omitempty"`
+	Version                     uint8    `json:"version,omitempty"`
+	ContractName                string   `json:"contract_name,omitempty"`
+	ContractFileHash            string   `json:"contract_file_hash,omitempty"`
+	GoverningLaw                string   `json:"governing_law,omitempty"`
+	Jurisdiction                string   `json:"jurisdiction,omitempty"`
+	ContractExpiration          uint64   `json:"contract_expiration,omitempty"`
+	URI                         string   `json:"uri,omitempty"`
+	ContractRevision            uint16   `json:"contract_revision,omitempty"`
+	IssuerID                    string   `json:"issuer_id,omitempty"`
+	IssuerType                  string   `json:"issuer_type,omitempty"`
+	ContractOperatorID          string   `json:"contract_operator_id,omitempty"`
+	AuthorizationFlags          []string `json:"authorization_flags,omitempty"`
+	VotingSystem                string   `json:"voting_system,omitempty"`
+	InitiativeThreshold         float32  `json:"initiative_threshold,omitempty"`
+	InitiativeThresholdCurrency string   `json:"initiative_threshold_currency,omitempty"`
+	RestrictedQty               uint64   `json:"restricted_qty,omitempty"`
 }
 
 // Write implements the io.Writer interface, writing the data in []byte to
@@ -621,9 +924,23 @@ func (f *ContractFormationForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f ContractFormationForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("contract_name", f.ContractName, 32)
+	errs.checkHex("contract_file_hash", f.ContractFileHash, 32)
+	errs.checkLen("governing_law", f.GoverningLaw, 5)
+	errs.checkLen("jurisdiction", f.Jurisdiction, 5)
+	errs.checkLen("uri", f.URI, 78)
+	errs.checkLen("issuer_id", f.IssuerID, 16)
+	errs.checkEnum("issuer_type", f.IssuerType, IssuerTypes)
+	errs.checkLen("contract_operator_id", f.ContractOperatorID, 16)
+	errs.checkFlags("authorization_flags", f.AuthorizationFlags, ContractFlags)
+	errs.checkEnum("voting_system", f.VotingSystem, VotingSystems)
+	errs.checkLen("initiative_threshold_currency", f.InitiativeThresholdCurrency, 3)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
@@ -634,6 +951,10 @@ func (f ContractFormationForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f ContractFormationForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewContractFormation()
@@ -645,7 +966,7 @@ func (f ContractFormationForm) BuildMessage() (OpReturnMessage, error) {
 		return nil, err
 	}
 
-	m.ContractFileHash, err = f.pad(f.ContractFileHash, 32)
+	m.ContractFileHash, err = f.hexBytes(f.ContractFileHash, 32)
 	if err != nil {
 		return nil, err
 	}
@@ -674,7 +995,7 @@ func (f ContractFormationForm) BuildMessage() (OpReturnMessage, error) {
 		return nil, err
 	}
 
-	m.IssuerType, err = f.ensureByte(f.IssuerType)
+	m.IssuerType, err = f.enumByte(f.IssuerType, IssuerTypes)
 	if err != nil {
 		return nil, err
 	}
@@ -684,12 +1005,12 @@ func (f ContractFormationForm) BuildMessage() (OpReturnMessage, error) {
 		return nil, err
 	}
 
-	m.AuthorizationFlags, err = f.pad(f.AuthorizationFlags, 2)
+	m.AuthorizationFlags, err = f.flagBytes(f.AuthorizationFlags, ContractFlags)
 	if err != nil {
 		return nil, err
 	}
 
-	m.VotingSystem, err = f.ensureByte(f.VotingSystem)
+	m.VotingSystem, err = f.enumByte(f.VotingSystem, VotingSystems)
 	if err != nil {
 		return nil, err
 	}
@@ -706,26 +1027,79 @@ func (f ContractFormationForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
+// Form returns the form of the message, which is its JSON representation.
+func (m ContractFormation) Form() (*ContractFormationForm, error) {
+	f := ContractFormationForm{
+		Version:                     m.Version,
+		ContractName:                text(m.ContractName),
+		ContractFileHash:            hashText(m.ContractFileHash),
+		GoverningLaw:                text(m.GoverningLaw),
+		Jurisdiction:                text(m.Jurisdiction),
+		ContractExpiration:          m.ContractExpiration,
+		URI:                         text(m.URI),
+		ContractRevision:            m.ContractRevision,
+		IssuerID:                    text(m.IssuerID),
+		IssuerType:                  enumText(m.IssuerType, IssuerTypes),
+		ContractOperatorID:          text(m.ContractOperatorID),
+		AuthorizationFlags:          flagNames(m.AuthorizationFlags, ContractFlags),
+		VotingSystem:                enumText(m.VotingSystem, VotingSystems),
+		InitiativeThreshold:         m.InitiativeThreshold,
+		InitiativeThresholdCurrency: text(m.InitiativeThresholdCurrency),
+		RestrictedQty:               m.RestrictedQty,
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m ContractFormation) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *ContractFormation) UnmarshalJSON(b []byte) error {
+	f := ContractFormationForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*ContractFormation)
+
+	return nil
+}
+
 // ContractAmendmentForm is the JSON friendly version of a ContractAmendment.
 type ContractAmendmentForm struct {
 	BaseForm
 
-	Version                     uint8   `json:"version,omitempty"`
-	ContractName                string  `json:"contract_name,omitempty"`
-	ContractFileHash            string  `json:"contract_file_hash,omitempty"`
-	GoverningLaw                string  `json:"governing_law,omitempty"`
-	Jurisdiction                string  `json:"jurisdiction,omitempty"`
-	ContractExpiration          uint64  `json:"contract_expiration,omitempty"`
-	URI                         string  `json:"uri,omitempty"`
-	ContractRevision            uint16  `json:"contract_revision,omitempty"`
-	IssuerID                    string  `json:"issuer_id,omitempty"`
-	IssuerType                  string  `json:"issuer_type,omitempty"`
-	ContractOperatorID          string  `json:"contract_operator_id,omitempty"`
-	AuthorizationFlags          string  `json:"authorization_flags,omitempty"`
-	VotingSystem                string  `json:"voting_system,omitempty"`
-	InitiativeThreshold         float32 `json:"initiative_threshold,omitempty"`
-	InitiativeThresholdCurrency string  `json:"initiative_threshold_currency,omitempty"`
-	RestrictedQty               uint64  `json:"restricted_qty,omitempty"`
+	Version                     uint8    `json:"version,omitempty"`
+	ContractName                string   `json:"contract_name,omitempty"`
+	ContractFileHash            string   `json:"contract_file_hash,omitempty"`
+	GoverningLaw                string   `json:"governing_law,omitempty"`
+	Jurisdiction                string   `json:"jurisdiction,omitempty"`
+	ContractExpiration          uint64   `json:"contract_expiration,omitempty"`
+	URI                         string   `json:"uri,omitempty"`
+	ContractRevision            uint16   `json:"contract_revision,omitempty"`
+	IssuerID                    string   `json:"issuer_id,omitempty"`
+	IssuerType                  string   `json:"issuer_type,omitempty"`
+	ContractOperatorID          string   `json:"contract_operator_id,omitempty"`
+	AuthorizationFlags          []string `json:"authorization_flags,omitempty"`
+	VotingSystem                string   `json:"voting_system,omitempty"`
+	InitiativeThreshold         float32  `json:"initiative_threshold,omitempty"`
+	InitiativeThresholdCurrency string   `json:"initiative_threshold_currency,omitempty"`
+	RestrictedQty               uint64   `json:"restricted_qty,omitempty"`
 }
 
 // Write implements the io.Writer interface, writing the data in []byte to
@@ -738,9 +1112,23 @@ func (f *ContractAmendmentForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f ContractAmendmentForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("contract_name", f.ContractName, 32)
+	errs.checkHex("contract_file_hash", f.ContractFileHash, 32)
+	errs.checkLen("governing_law", f.GoverningLaw, 5)
+	errs.checkLen("jurisdiction", f.Jurisdiction, 5)
+	errs.checkLen("uri", f.URI, 78)
+	errs.checkLen("issuer_id", f.IssuerID, 16)
+	errs.checkEnum("issuer_type", f.IssuerType, IssuerTypes)
+	errs.checkLen("contract_operator_id", f.ContractOperatorID, 16)
+	errs.checkFlags("authorization_flags", f.AuthorizationFlags, ContractFlags)
+	errs.checkEnum("voting_system", f.VotingSystem, VotingSystems)
+	errs.checkLen("initiative_threshold_currency", f.InitiativeThresholdCurrency, 3)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
@@ -751,6 +1139,10 @@ func (f ContractAmendmentForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f ContractAmendmentForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewContractAmendment()
@@ -762,7 +1154,7 @@ func (f ContractAmendmentForm) BuildMessage() (OpReturnMessage, error) {
 		return nil, err
 	}
 
-	m.ContractFileHash, err = f.pad(f.ContractFileHash, 32)
+	m.ContractFileHash, err = f.hexBytes(f.ContractFileHash, 32)
 	if err != nil {
 		return nil, err
 	}
@@ -791,7 +1183,7 @@ func (f ContractAmendmentForm) BuildMessage() (OpReturnMessage, error) {
 		return nil, err
 	}
 
-	m.IssuerType, err = f.ensureByte(f.IssuerType)
+	m.IssuerType, err = f.enumByte(f.IssuerType, IssuerTypes)
 	if err != nil {
 		return nil, err
 	}
@@ -801,12 +1193,12 @@ func (f ContractAmendmentForm) BuildMessage() (OpReturnMessage, error) {
 		return nil, err
 	}
 
-	m.AuthorizationFlags, err = f.pad(f.AuthorizationFlags, 2)
+	m.AuthorizationFlags, err = f.flagBytes(f.AuthorizationFlags, ContractFlags)
 	if err != nil {
 		return nil, err
 	}
 
-	m.VotingSystem, err = f.ensureByte(f.VotingSystem)
+	m.VotingSystem, err = f.enumByte(f.VotingSystem, VotingSystems)
 	if err != nil {
 		return nil, err
 	}
@@ -823,6 +1215,59 @@ func (f ContractAmendmentForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
+// Form returns the form of the message, which is its JSON representation.
+func (m ContractAmendment) Form() (*ContractAmendmentForm, error) {
+	f := ContractAmendmentForm{
+		Version:                     m.Version,
+		ContractName:                text(m.ContractName),
+		ContractFileHash:            hashText(m.ContractFileHash),
+		GoverningLaw:                text(m.GoverningLaw),
+		Jurisdiction:                text(m.Jurisdiction),
+		ContractExpiration:          m.ContractExpiration,
+		URI:                         text(m.URI),
+		ContractRevision:            m.ContractRevision,
+		IssuerID:                    text(m.IssuerID),
+		IssuerType:                  enumText(m.IssuerType, IssuerTypes),
+		ContractOperatorID:          text(m.ContractOperatorID),
+		AuthorizationFlags:          flagNames(m.AuthorizationFlags, ContractFlags),
+		VotingSystem:                enumText(m.VotingSystem, VotingSystems),
+		InitiativeThreshold:         m.InitiativeThreshold,
+		InitiativeThresholdCurrency: text(m.InitiativeThresholdCurrency),
+		RestrictedQty:               m.RestrictedQty,
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m ContractAmendment) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *ContractAmendment) UnmarshalJSON(b []byte) error {
+	f := ContractAmendmentForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*ContractAmendment)
+
+	return nil
+}
+
 // OrderForm is the JSON friendly version of a Order.
 type OrderForm struct {
 	BaseForm
@@ -849,9 +1294,19 @@ func (f *OrderForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f OrderForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("asset_type", f.AssetType, 3)
+	errs.checkLen("asset_id", f.AssetID, 32)
+	errs.checkEnum("compliance_action", f.ComplianceAction, ComplianceActions)
+	errs.checkLen("target_address", f.TargetAddress, 34)
+	errs.checkLen("deposit_address", f.DepositAddress, 34)
+	errs.checkHex("supporting_evidence_hash", f.SupportingEvidenceHash, 32)
+	errs.checkLen("message", f.Message, 61)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
@@ -862,6 +1317,10 @@ func (f OrderForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f OrderForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewOrder()
@@ -878,7 +1337,7 @@ func (f OrderForm) BuildMessage() (OpReturnMessage, error) {
 		return nil, err
 	}
 
-	m.ComplianceAction, err = f.ensureByte(f.ComplianceAction)
+	m.ComplianceAction, err = f.enumByte(f.ComplianceAction, ComplianceActions)
 	if err != nil {
 		return nil, err
 	}
@@ -893,7 +1352,7 @@ func (f OrderForm) BuildMessage() (OpReturnMessage, error) {
 		return nil, err
 	}
 
-	m.SupportingEvidenceHash, err = f.pad(f.SupportingEvidenceHash, 32)
+	m.SupportingEvidenceHash, err = f.hexBytes(f.SupportingEvidenceHash, 32)
 	if err != nil {
 		return nil, err
 	}
@@ -910,6 +1369,53 @@ func (f OrderForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
+// Form returns the form of the message, which is its JSON representation.
+func (m Order) Form() (*OrderForm, error) {
+	f := OrderForm{
+		Version:                m.Version,
+		AssetType:              text(m.AssetType),
+		AssetID:                text(m.AssetID),
+		ComplianceAction:       enumText(m.ComplianceAction, ComplianceActions),
+		TargetAddress:          text(m.TargetAddress),
+		DepositAddress:         text(m.DepositAddress),
+		SupportingEvidenceHash: hashText(m.SupportingEvidenceHash),
+		Qty:                    m.Qty,
+		Expiration:             m.Expiration,
+		Message:                text(m.Message),
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m Order) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *Order) UnmarshalJSON(b []byte) error {
+	f := OrderForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*Order)
+
+	return nil
+}
+
 // FreezeForm is the JSON friendly version of a Freeze.
 type FreezeForm struct {
 	BaseForm
@@ -933,9 +1439,16 @@ func (f *FreezeForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f FreezeForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("asset_type", f.AssetType, 3)
+	errs.checkLen("asset_id", f.AssetID, 32)
+	errs.checkAfter("expiration", f.Expiration, "timestamp", f.Timestamp)
+	errs.checkLen("message", f.Message, 61)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
@@ -946,6 +1459,10 @@ func (f FreezeForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f FreezeForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewFreeze()
@@ -976,6 +1493,50 @@ func (f FreezeForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
+// Form returns the form of the message, which is its JSON representation.
+func (m Freeze) Form() (*FreezeForm, error) {
+	f := FreezeForm{
+		Version:    m.Version,
+		AssetType:  text(m.AssetType),
+		AssetID:    text(m.AssetID),
+		Timestamp:  m.Timestamp,
+		Qty:        m.Qty,
+		Expiration: m.Expiration,
+		Message:    text(m.Message),
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m Freeze) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *Freeze) UnmarshalJSON(b []byte) error {
+	f := FreezeForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*Freeze)
+
+	return nil
+}
+
 // ThawForm is the JSON friendly version of a Thaw.
 type ThawForm struct {
 	BaseForm
@@ -998,9 +1559,15 @@ func (f *ThawForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f ThawForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("asset_type", f.AssetType, 3)
+	errs.checkLen("asset_id", f.AssetID, 32)
+	errs.checkLen("message", f.Message, 61)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
@@ -1011,6 +1578,10 @@ func (f ThawForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f ThawForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewThaw()
@@ -1039,6 +1610,49 @@ func (f ThawForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
+// Form returns the form of the message, which is its JSON representation.
+func (m Thaw) Form() (*ThawForm, error) {
+	f := ThawForm{
+		Version:   m.Version,
+		AssetType: text(m.AssetType),
+		AssetID:   text(m.AssetID),
+		Timestamp: m.Timestamp,
+		Qty:       m.Qty,
+		Message:   text(m.Message),
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m Thaw) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *Thaw) UnmarshalJSON(b []byte) error {
+	f := ThawForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*Thaw)
+
+	return nil
+}
+
 // ConfiscationForm is the JSON friendly version of a Confiscation.
 type ConfiscationForm struct {
 	BaseForm
@@ -1062,9 +1676,15 @@ func (f *ConfiscationForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f ConfiscationForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("asset_type", f.AssetType, 3)
+	errs.checkLen("asset_id", f.AssetID, 32)
+	errs.checkLen("message", f.Message, 61)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
@@ -1075,6 +1695,10 @@ func (f ConfiscationForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f ConfiscationForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewConfiscation()
@@ -1105,16 +1729,60 @@ func (f ConfiscationForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
-// ReconciliationForm is the JSON friendly version of a Reconciliation.
-type ReconciliationForm struct {
-	BaseForm
+// Form returns the form of the message, which is its JSON representation.
+func (m Confiscation) Form() (*ConfiscationForm, error) {
+	f := ConfiscationForm{
+		Version:     m.Version,
+		AssetType:   text(m.AssetType),
+		AssetID:     text(m.AssetID),
+		Timestamp:   m.Timestamp,
+		TargetsQty:  m.TargetsQty,
+		DepositsQty: m.DepositsQty,
+		Message:     text(m.Message),
+	}
 
-	Version          uint8  `json:"version,omitempty"`
-	AssetType        string `json:"asset_type,omitempty"`
-	AssetID          string `json:"asset_id,omitempty"`
-	RefTxnID         string `json:"ref_txn_id,omitempty"`
-	TargetAddressQty uint64 `json:"target_address_qty,omitempty"`
-	Timestamp        uint64 `json:"timestamp,omitempty"`
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m Confiscation) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *Confiscation) UnmarshalJSON(b []byte) error {
+	f := ConfiscationForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*Confiscation)
+
+	return nil
+}
+
+// ReconciliationForm is the JSON friendly version of a Reconciliation.
+type ReconciliationForm struct {
+	BaseForm
+
+	Version          uint8  `json:"version,omitempty"`
+	AssetType        string `json:"asset_type,omitempty"`
+	AssetID          string `json:"asset_id,omitempty"`
+	RefTxnID         string `json:"ref_txn_id,omitempty"`
+	TargetAddressQty uint64 `json:"target_address_qty,omitempty"`
+	Timestamp        uint64 `json:"timestamp,omitempty"`
 	Message          string `json:"message,omitempty"`
 }
 
@@ -1128,9 +1796,16 @@ func (f *ReconciliationForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f ReconciliationForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("asset_type", f.AssetType, 3)
+	errs.checkLen("asset_id", f.AssetID, 32)
+	errs.checkHex("ref_txn_id", f.RefTxnID, 32)
+	errs.checkLen("message", f.Message, 61)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
@@ -1141,6 +1816,10 @@ func (f ReconciliationForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f ReconciliationForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewReconciliation()
@@ -1157,7 +1836,7 @@ func (f ReconciliationForm) BuildMessage() (OpReturnMessage, error) {
 		return nil, err
 	}
 
-	m.RefTxnID, err = f.pad(f.RefTxnID, 32)
+	m.RefTxnID, err = f.hexBytes(f.RefTxnID, 32)
 	if err != nil {
 		return nil, err
 	}
@@ -1174,6 +1853,50 @@ func (f ReconciliationForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
+// Form returns the form of the message, which is its JSON representation.
+func (m Reconciliation) Form() (*ReconciliationForm, error) {
+	f := ReconciliationForm{
+		Version:          m.Version,
+		AssetType:        text(m.AssetType),
+		AssetID:          text(m.AssetID),
+		RefTxnID:         hashText(m.RefTxnID),
+		TargetAddressQty: m.TargetAddressQty,
+		Timestamp:        m.Timestamp,
+		Message:          text(m.Message),
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m Reconciliation) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *Reconciliation) UnmarshalJSON(b []byte) error {
+	f := ReconciliationForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*Reconciliation)
+
+	return nil
+}
+
 // InitiativeForm is the JSON friendly version of a Initiative.
 type InitiativeForm struct {
 	BaseForm
@@ -1200,9 +1923,19 @@ func (f *InitiativeForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f InitiativeForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("asset_type", f.AssetType, 3)
+	errs.checkLen("asset_id", f.AssetID, 32)
+	errs.checkLen("vote_type", f.VoteType, 1)
+	errs.checkLen("vote_options", f.VoteOptions, 16)
+	errs.checkEnum("vote_logic", f.VoteLogic, VoteLogics)
+	errs.checkLen("proposal_description", f.ProposalDescription, 82)
+	errs.checkHex("proposal_document_hash", f.ProposalDocumentHash, 32)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
@@ -1213,6 +1946,10 @@ func (f InitiativeForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f InitiativeForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewInitiative()
@@ -1241,7 +1978,7 @@ func (f InitiativeForm) BuildMessage() (OpReturnMessage, error) {
 
 	m.VoteMax = f.VoteMax
 
-	m.VoteLogic, err = f.ensureByte(f.VoteLogic)
+	m.VoteLogic, err = f.enumByte(f.VoteLogic, VoteLogics)
 	if err != nil {
 		return nil, err
 	}
@@ -1251,7 +1988,7 @@ func (f InitiativeForm) BuildMessage() (OpReturnMessage, error) {
 		return nil, err
 	}
 
-	m.ProposalDocumentHash, err = f.pad(f.ProposalDocumentHash, 32)
+	m.ProposalDocumentHash, err = f.hexBytes(f.ProposalDocumentHash, 32)
 	if err != nil {
 		return nil, err
 	}
@@ -1261,6 +1998,53 @@ func (f InitiativeForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
+// Form returns the form of the message, which is its JSON representation.
+func (m Initiative) Form() (*InitiativeForm, error) {
+	f := InitiativeForm{
+		Version:              m.Version,
+		AssetType:            text(m.AssetType),
+		AssetID:              text(m.AssetID),
+		VoteType:             charText(m.VoteType),
+		VoteOptions:          text(m.VoteOptions),
+		VoteMax:              m.VoteMax,
+		VoteLogic:            enumText(m.VoteLogic, VoteLogics),
+		ProposalDescription:  text(m.ProposalDescription),
+		ProposalDocumentHash: hashText(m.ProposalDocumentHash),
+		VoteCutOffTimestamp:  m.VoteCutOffTimestamp,
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m Initiative) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *Initiative) UnmarshalJSON(b []byte) error {
+	f := InitiativeForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*Initiative)
+
+	return nil
+}
+
 // ReferendumForm is the JSON friendly version of a Referendum.
 type ReferendumForm struct {
 	BaseForm
@@ -1287,9 +2071,19 @@ func (f *ReferendumForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f ReferendumForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("asset_type", f.AssetType, 3)
+	errs.checkLen("asset_id", f.AssetID, 32)
+	errs.checkLen("vote_type", f.VoteType, 1)
+	errs.checkLen("vote_options", f.VoteOptions, 16)
+	errs.checkEnum("vote_logic", f.VoteLogic, VoteLogics)
+	errs.checkLen("proposal_description", f.ProposalDescription, 82)
+	errs.checkHex("proposal_document_hash", f.ProposalDocumentHash, 32)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
@@ -1300,6 +2094,10 @@ func (f ReferendumForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f ReferendumForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewReferendum()
@@ -1328,7 +2126,7 @@ func (f ReferendumForm) BuildMessage() (OpReturnMessage, error) {
 
 	m.VoteMax = f.VoteMax
 
-	m.VoteLogic, err = f.ensureByte(f.VoteLogic)
+	m.VoteLogic, err = f.enumByte(f.VoteLogic, VoteLogics)
 	if err != nil {
 		return nil, err
 	}
@@ -1338,7 +2136,7 @@ func (f ReferendumForm) BuildMessage() (OpReturnMessage, error) {
 		return nil, err
 	}
 
-	m.ProposalDocumentHash, err = f.pad(f.ProposalDocumentHash, 32)
+	m.ProposalDocumentHash, err = f.hexBytes(f.ProposalDocumentHash, 32)
 	if err != nil {
 		return nil, err
 	}
@@ -1348,6 +2146,53 @@ func (f ReferendumForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
+// Form returns the form of the message, which is its JSON representation.
+func (m Referendum) Form() (*ReferendumForm, error) {
+	f := ReferendumForm{
+		Version:              m.Version,
+		AssetType:            text(m.AssetType),
+		AssetID:              text(m.AssetID),
+		VoteType:             charText(m.VoteType),
+		VoteOptions:          text(m.VoteOptions),
+		VoteMax:              m.VoteMax,
+		VoteLogic:            enumText(m.VoteLogic, VoteLogics),
+		ProposalDescription:  text(m.ProposalDescription),
+		ProposalDocumentHash: hashText(m.ProposalDocumentHash),
+		VoteCutOffTimestamp:  m.VoteCutOffTimestamp,
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m Referendum) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *Referendum) UnmarshalJSON(b []byte) error {
+	f := ReferendumForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*Referendum)
+
+	return nil
+}
+
 // VoteForm is the JSON friendly version of a Vote.
 type VoteForm struct {
 	BaseForm
@@ -1375,9 +2220,19 @@ func (f *VoteForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f VoteForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("asset_type", f.AssetType, 3)
+	errs.checkLen("asset_id", f.AssetID, 32)
+	errs.checkLen("vote_type", f.VoteType, 1)
+	errs.checkLen("vote_options", f.VoteOptions, 16)
+	errs.checkEnum("vote_logic", f.VoteLogic, VoteLogics)
+	errs.checkLen("proposal_description", f.ProposalDescription, 82)
+	errs.checkHex("proposal_document_hash", f.ProposalDocumentHash, 32)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
@@ -1388,6 +2243,10 @@ func (f VoteForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f VoteForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewVote()
@@ -1416,7 +2275,7 @@ func (f VoteForm) BuildMessage() (OpReturnMessage, error) {
 
 	m.VoteMax = f.VoteMax
 
-	m.VoteLogic, err = f.ensureByte(f.VoteLogic)
+	m.VoteLogic, err = f.enumByte(f.VoteLogic, VoteLogics)
 	if err != nil {
 		return nil, err
 	}
@@ -1426,7 +2285,7 @@ func (f VoteForm) BuildMessage() (OpReturnMessage, error) {
 		return nil, err
 	}
 
-	m.ProposalDocumentHash, err = f.pad(f.ProposalDocumentHash, 32)
+	m.ProposalDocumentHash, err = f.hexBytes(f.ProposalDocumentHash, 32)
 	if err != nil {
 		return nil, err
 	}
@@ -1438,6 +2297,54 @@ func (f VoteForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
+// Form returns the form of the message, which is its JSON representation.
+func (m Vote) Form() (*VoteForm, error) {
+	f := VoteForm{
+		Version:              m.Version,
+		AssetType:            text(m.AssetType),
+		AssetID:              text(m.AssetID),
+		VoteType:             charText(m.VoteType),
+		VoteOptions:          text(m.VoteOptions),
+		VoteMax:              m.VoteMax,
+		VoteLogic:            enumText(m.VoteLogic, VoteLogics),
+		ProposalDescription:  text(m.ProposalDescription),
+		ProposalDocumentHash: hashText(m.ProposalDocumentHash),
+		VoteCutOffTimestamp:  m.VoteCutOffTimestamp,
+		Timestamp:            m.Timestamp,
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m Vote) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *Vote) UnmarshalJSON(b []byte) error {
+	f := VoteForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*Vote)
+
+	return nil
+}
+
 // BallotCastForm is the JSON friendly version of a BallotCast.
 type BallotCastForm struct {
 	BaseForm
@@ -1459,9 +2366,16 @@ func (f *BallotCastForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f BallotCastForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("asset_type", f.AssetType, 3)
+	errs.checkLen("asset_id", f.AssetID, 32)
+	errs.checkHex("vote_txn_id", f.VoteTxnID, 32)
+	errs.checkLen("vote", f.Vote, 16)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
@@ -1472,6 +2386,10 @@ func (f BallotCastForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f BallotCastForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewBallotCast()
@@ -1488,7 +2406,7 @@ func (f BallotCastForm) BuildMessage() (OpReturnMessage, error) {
 		return nil, err
 	}
 
-	m.VoteTxnID, err = f.pad(f.VoteTxnID, 32)
+	m.VoteTxnID, err = f.hexBytes(f.VoteTxnID, 32)
 	if err != nil {
 		return nil, err
 	}
@@ -1501,6 +2419,48 @@ func (f BallotCastForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
+// Form returns the form of the message, which is its JSON representation.
+func (m BallotCast) Form() (*BallotCastForm, error) {
+	f := BallotCastForm{
+		Version:   m.Version,
+		AssetType: text(m.AssetType),
+		AssetID:   text(m.AssetID),
+		VoteTxnID: hashText(m.VoteTxnID),
+		Vote:      text(m.Vote),
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m BallotCast) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *BallotCast) UnmarshalJSON(b []byte) error {
+	f := BallotCastForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*BallotCast)
+
+	return nil
+}
+
 // BallotCountedForm is the JSON friendly version of a BallotCounted.
 type BallotCountedForm struct {
 	BaseForm
@@ -1523,9 +2483,16 @@ func (f *BallotCountedForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f BallotCountedForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("asset_type", f.AssetType, 3)
+	errs.checkLen("asset_id", f.AssetID, 32)
+	errs.checkHex("vote_txn_id", f.VoteTxnID, 32)
+	errs.checkLen("vote", f.Vote, 16)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
@@ -1536,6 +2503,10 @@ func (f BallotCountedForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f BallotCountedForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewBallotCounted()
@@ -1552,7 +2523,7 @@ func (f BallotCountedForm) BuildMessage() (OpReturnMessage, error) {
 		return nil, err
 	}
 
-	m.VoteTxnID, err = f.pad(f.VoteTxnID, 32)
+	m.VoteTxnID, err = f.hexBytes(f.VoteTxnID, 32)
 	if err != nil {
 		return nil, err
 	}
@@ -1567,6 +2538,49 @@ func (f BallotCountedForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
+// Form returns the form of the message, which is its JSON representation.
+func (m BallotCounted) Form() (*BallotCountedForm, error) {
+	f := BallotCountedForm{
+		Version:   m.Version,
+		AssetType: text(m.AssetType),
+		AssetID:   text(m.AssetID),
+		VoteTxnID: hashText(m.VoteTxnID),
+		Vote:      text(m.Vote),
+		Timestamp: m.Timestamp,
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m BallotCounted) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *BallotCounted) UnmarshalJSON(b []byte) error {
+	f := BallotCountedForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*BallotCounted)
+
+	return nil
+}
+
 // ResultForm is the JSON friendly version of a Result.
 type ResultForm struct {
 	BaseForm
@@ -1605,9 +2619,17 @@ func (f *ResultForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f ResultForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("asset_type", f.AssetType, 3)
+	errs.checkLen("asset_id", f.AssetID, 32)
+	errs.checkLen("vote_type", f.VoteType, 1)
+	errs.checkHex("vote_txn_id", f.VoteTxnID, 32)
+	errs.checkLen("result", f.Result, 16)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
@@ -1618,6 +2640,10 @@ func (f ResultForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f ResultForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewResult()
@@ -1639,7 +2665,7 @@ func (f ResultForm) BuildMessage() (OpReturnMessage, error) {
 		return nil, err
 	}
 
-	m.VoteTxnID, err = f.pad(f.VoteTxnID, 32)
+	m.VoteTxnID, err = f.hexBytes(f.VoteTxnID, 32)
 	if err != nil {
 		return nil, err
 	}
@@ -1684,6 +2710,65 @@ func (f ResultForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
+// Form returns the form of the message, which is its JSON representation.
+func (m Result) Form() (*ResultForm, error) {
+	f := ResultForm{
+		Version:       m.Version,
+		AssetType:     text(m.AssetType),
+		AssetID:       text(m.AssetID),
+		VoteType:      charText(m.VoteType),
+		VoteTxnID:     hashText(m.VoteTxnID),
+		Timestamp:     m.Timestamp,
+		Option1Tally:  m.Option1Tally,
+		Option2Tally:  m.Option2Tally,
+		Option3Tally:  m.Option3Tally,
+		Option4Tally:  m.Option4Tally,
+		Option5Tally:  m.Option5Tally,
+		Option6Tally:  m.Option6Tally,
+		Option7Tally:  m.Option7Tally,
+		Option8Tally:  m.Option8Tally,
+		Option9Tally:  m.Option9Tally,
+		Option10Tally: m.Option10Tally,
+		Option11Tally: m.Option11Tally,
+		Option12Tally: m.Option12Tally,
+		Option13Tally: m.Option13Tally,
+		Option14Tally: m.Option14Tally,
+		Option15Tally: m.Option15Tally,
+		Result:        text(m.Result),
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m Result) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *Result) UnmarshalJSON(b []byte) error {
+	f := ResultForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*Result)
+
+	return nil
+}
+
 // MessageForm is the JSON friendly version of a Message.
 type MessageForm struct {
 	BaseForm
@@ -1704,9 +2789,14 @@ func (f *MessageForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f MessageForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("message_type", f.MessageType, 2)
+	errs.checkLen("message", f.Message, 203)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
@@ -1717,6 +2807,10 @@ func (f MessageForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f MessageForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewMessage()
@@ -1738,6 +2832,47 @@ func (f MessageForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
+// Form returns the form of the message, which is its JSON representation.
+func (m Message) Form() (*MessageForm, error) {
+	f := MessageForm{
+		Version:     m.Version,
+		Timestamp:   m.Timestamp,
+		MessageType: text(m.MessageType),
+		Message:     text(m.Message),
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m Message) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *Message) UnmarshalJSON(b []byte) error {
+	f := MessageForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*Message)
+
+	return nil
+}
+
 // RejectionForm is the JSON friendly version of a Rejection.
 type RejectionForm struct {
 	BaseForm
@@ -1760,9 +2895,16 @@ func (f *RejectionForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f RejectionForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("asset_type", f.AssetType, 3)
+	errs.checkLen("asset_id", f.AssetID, 32)
+	errs.checkLen("rejection_type", f.RejectionType, 1)
+	errs.checkLen("message", f.Message, 169)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
@@ -1773,6 +2915,10 @@ func (f RejectionForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f RejectionForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewRejection()
@@ -1804,6 +2950,49 @@ func (f RejectionForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
+// Form returns the form of the message, which is its JSON representation.
+func (m Rejection) Form() (*RejectionForm, error) {
+	f := RejectionForm{
+		Version:       m.Version,
+		Timestamp:     m.Timestamp,
+		AssetType:     text(m.AssetType),
+		AssetID:       text(m.AssetID),
+		RejectionType: charText(m.RejectionType),
+		Message:       text(m.Message),
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m Rejection) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *Rejection) UnmarshalJSON(b []byte) error {
+	f := RejectionForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*Rejection)
+
+	return nil
+}
+
 // EstablishmentForm is the JSON friendly version of a Establishment.
 type EstablishmentForm struct {
 	BaseForm
@@ -1828,9 +3017,18 @@ func (f *EstablishmentForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f EstablishmentForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("registrar", f.Registrar, 16)
+	errs.checkLen("register_type", f.RegisterType, 1)
+	errs.checkLen("kyc_jurisdiction", f.KYCJurisdiction, 5)
+	errs.checkLen("country_of_residence", f.CountryOfResidence, 3)
+	errs.checkHex("supporting_documentation_hash", f.SupportingDocumentationHash, 32)
+	errs.checkLen("message", f.Message, 148)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
@@ -1841,6 +3039,10 @@ func (f EstablishmentForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f EstablishmentForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewEstablishment()
@@ -1869,7 +3071,7 @@ func (f EstablishmentForm) BuildMessage() (OpReturnMessage, error) {
 		return nil, err
 	}
 
-	m.SupportingDocumentationHash, err = f.pad(f.SupportingDocumentationHash, 32)
+	m.SupportingDocumentationHash, err = f.hexBytes(f.SupportingDocumentationHash, 32)
 	if err != nil {
 		return nil, err
 	}
@@ -1882,6 +3084,51 @@ func (f EstablishmentForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
+// Form returns the form of the message, which is its JSON representation.
+func (m Establishment) Form() (*EstablishmentForm, error) {
+	f := EstablishmentForm{
+		Version:                     m.Version,
+		Registrar:                   text(m.Registrar),
+		RegisterType:                charText(m.RegisterType),
+		KYCJurisdiction:             text(m.KYCJurisdiction),
+		DOB:                         m.DOB,
+		CountryOfResidence:          text(m.CountryOfResidence),
+		SupportingDocumentationHash: hashText(m.SupportingDocumentationHash),
+		Message:                     text(m.Message),
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m Establishment) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *Establishment) UnmarshalJSON(b []byte) error {
+	f := EstablishmentForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*Establishment)
+
+	return nil
+}
+
 // AdditionForm is the JSON friendly version of a Addition.
 type AdditionForm struct {
 	BaseForm
@@ -1906,9 +3153,18 @@ func (f *AdditionForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f AdditionForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("sublist", f.Sublist, 4)
+	errs.checkLen("kyc", f.KYC, 1)
+	errs.checkLen("kyc_jurisdiction", f.KYCJurisdiction, 5)
+	errs.checkLen("country_of_residence", f.CountryOfResidence, 3)
+	errs.checkHex("supporting_documentation_hash", f.SupportingDocumentationHash, 32)
+	errs.checkLen("message", f.Message, 148)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
@@ -1919,6 +3175,10 @@ func (f AdditionForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f AdditionForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewAddition()
@@ -1947,7 +3207,7 @@ func (f AdditionForm) BuildMessage() (OpReturnMessage, error) {
 		return nil, err
 	}
 
-	m.SupportingDocumentationHash, err = f.pad(f.SupportingDocumentationHash, 32)
+	m.SupportingDocumentationHash, err = f.hexBytes(f.SupportingDocumentationHash, 32)
 	if err != nil {
 		return nil, err
 	}
@@ -1960,6 +3220,51 @@ func (f AdditionForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
+// Form returns the form of the message, which is its JSON representation.
+func (m Addition) Form() (*AdditionForm, error) {
+	f := AdditionForm{
+		Version:                     m.Version,
+		Sublist:                     text(m.Sublist),
+		KYC:                         charText(m.KYC),
+		KYCJurisdiction:             text(m.KYCJurisdiction),
+		DOB:                         m.DOB,
+		CountryOfResidence:          text(m.CountryOfResidence),
+		SupportingDocumentationHash: hashText(m.SupportingDocumentationHash),
+		Message:                     text(m.Message),
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m Addition) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *Addition) UnmarshalJSON(b []byte) error {
+	f := AdditionForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*Addition)
+
+	return nil
+}
+
 // AlterationForm is the JSON friendly version of a Alteration.
 type AlterationForm struct {
 	BaseForm
@@ -1984,9 +3289,18 @@ func (f *AlterationForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f AlterationForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("sublist", f.Sublist, 4)
+	errs.checkLen("kyc", f.KYC, 1)
+	errs.checkLen("kyc_jurisdiction", f.KYCJurisdiction, 5)
+	errs.checkLen("country_of_residence", f.CountryOfResidence, 3)
+	errs.checkHex("supporting_documentation_hash", f.SupportingDocumentationHash, 32)
+	errs.checkLen("message", f.Message, 160)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
@@ -1997,6 +3311,10 @@ func (f AlterationForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f AlterationForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewAlteration()
@@ -2025,7 +3343,7 @@ func (f AlterationForm) BuildMessage() (OpReturnMessage, error) {
 		return nil, err
 	}
 
-	m.SupportingDocumentationHash, err = f.pad(f.SupportingDocumentationHash, 32)
+	m.SupportingDocumentationHash, err = f.hexBytes(f.SupportingDocumentationHash, 32)
 	if err != nil {
 		return nil, err
 	}
@@ -2038,6 +3356,51 @@ func (f AlterationForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
+// Form returns the form of the message, which is its JSON representation.
+func (m Alteration) Form() (*AlterationForm, error) {
+	f := AlterationForm{
+		Version:                     m.Version,
+		Sublist:                     text(m.Sublist),
+		KYC:                         charText(m.KYC),
+		KYCJurisdiction:             text(m.KYCJurisdiction),
+		DOB:                         m.DOB,
+		CountryOfResidence:          text(m.CountryOfResidence),
+		SupportingDocumentationHash: hashText(m.SupportingDocumentationHash),
+		Message:                     text(m.Message),
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m Alteration) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *Alteration) UnmarshalJSON(b []byte) error {
+	f := AlterationForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*Alteration)
+
+	return nil
+}
+
 // RemovalForm is the JSON friendly version of a Removal.
 type RemovalForm struct {
 	BaseForm
@@ -2057,9 +3420,14 @@ func (f *RemovalForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f RemovalForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkHex("supporting_documentation_hash", f.SupportingDocumentationHash, 32)
+	errs.checkLen("message", f.Message, 181)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
@@ -2070,13 +3438,17 @@ func (f RemovalForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f RemovalForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewRemoval()
 
 	m.Version = f.Version
 
-	m.SupportingDocumentationHash, err = f.pad(f.SupportingDocumentationHash, 32)
+	m.SupportingDocumentationHash, err = f.hexBytes(f.SupportingDocumentationHash, 32)
 	if err != nil {
 		return nil, err
 	}
@@ -2089,6 +3461,46 @@ func (f RemovalForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
+// Form returns the form of the message, which is its JSON representation.
+func (m Removal) Form() (*RemovalForm, error) {
+	f := RemovalForm{
+		Version:                     m.Version,
+		SupportingDocumentationHash: hashText(m.SupportingDocumentationHash),
+		Message:                     text(m.Message),
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m Removal) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *Removal) UnmarshalJSON(b []byte) error {
+	f := RemovalForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*Removal)
+
+	return nil
+}
+
 // SendForm is the JSON friendly version of a Send.
 type SendForm struct {
 	BaseForm
@@ -2109,9 +3521,14 @@ func (f *SendForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f SendForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("asset_type", f.AssetType, 3)
+	errs.checkLen("asset_id", f.AssetID, 32)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
@@ -2122,6 +3539,10 @@ func (f SendForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f SendForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewSend()
@@ -2143,6 +3564,47 @@ func (f SendForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
+// Form returns the form of the message, which is its JSON representation.
+func (m Send) Form() (*SendForm, error) {
+	f := SendForm{
+		Version:   m.Version,
+		AssetType: text(m.AssetType),
+		AssetID:   text(m.AssetID),
+		TokenQty:  m.TokenQty,
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m Send) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *Send) UnmarshalJSON(b []byte) error {
+	f := SendForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*Send)
+
+	return nil
+}
+
 // ExchangeForm is the JSON friendly version of a Exchange.
 type ExchangeForm struct {
 	BaseForm
@@ -2168,9 +3630,16 @@ func (f *ExchangeForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f ExchangeForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("party1_asset_type", f.Party1AssetType, 3)
+	errs.checkLen("party1_asset_id", f.Party1AssetID, 32)
+	errs.checkLen("exchange_fee_currency", f.ExchangeFeeCurrency, 3)
+	errs.checkLen("exchange_fee_address", f.ExchangeFeeAddress, 34)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
@@ -2181,6 +3650,10 @@ func (f ExchangeForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f ExchangeForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewExchange()
@@ -2218,6 +3691,52 @@ func (f ExchangeForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
+// Form returns the form of the message, which is its JSON representation.
+func (m Exchange) Form() (*ExchangeForm, error) {
+	f := ExchangeForm{
+		Version:             m.Version,
+		Party1AssetType:     text(m.Party1AssetType),
+		Party1AssetID:       text(m.Party1AssetID),
+		Party1TokenQty:      m.Party1TokenQty,
+		OfferValidUntil:     m.OfferValidUntil,
+		ExchangeFeeCurrency: text(m.ExchangeFeeCurrency),
+		ExchangeFeeVar:      m.ExchangeFeeVar,
+		ExchangeFeeFixed:    m.ExchangeFeeFixed,
+		ExchangeFeeAddress:  text(m.ExchangeFeeAddress),
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m Exchange) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *Exchange) UnmarshalJSON(b []byte) error {
+	f := ExchangeForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*Exchange)
+
+	return nil
+}
+
 // SwapForm is the JSON friendly version of a Swap.
 type SwapForm struct {
 	BaseForm
@@ -2246,9 +3765,18 @@ func (f *SwapForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f SwapForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("party1_asset_type", f.Party1AssetType, 3)
+	errs.checkLen("party1_asset_id", f.Party1AssetID, 32)
+	errs.checkLen("party2_asset_type", f.Party2AssetType, 3)
+	errs.checkLen("party2_asset_id", f.Party2AssetID, 32)
+	errs.checkLen("exchange_fee_currency", f.ExchangeFeeCurrency, 3)
+	errs.checkLen("exchange_fee_address", f.ExchangeFeeAddress, 34)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
@@ -2259,6 +3787,10 @@ func (f SwapForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f SwapForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewSwap()
@@ -2308,6 +3840,55 @@ func (f SwapForm) BuildMessage() (OpReturnMessage, error) {
 	return &m, nil
 }
 
+// Form returns the form of the message, which is its JSON representation.
+func (m Swap) Form() (*SwapForm, error) {
+	f := SwapForm{
+		Version:             m.Version,
+		Party1AssetType:     text(m.Party1AssetType),
+		Party1AssetID:       text(m.Party1AssetID),
+		Party1TokenQty:      m.Party1TokenQty,
+		OfferValidUntil:     m.OfferValidUntil,
+		Party2AssetType:     text(m.Party2AssetType),
+		Party2AssetID:       text(m.Party2AssetID),
+		Party2TokenQty:      m.Party2TokenQty,
+		ExchangeFeeCurrency: text(m.ExchangeFeeCurrency),
+		ExchangeFeeVar:      m.ExchangeFeeVar,
+		ExchangeFeeFixed:    m.ExchangeFeeFixed,
+		ExchangeFeeAddress:  text(m.ExchangeFeeAddress),
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m Swap) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *Swap) UnmarshalJSON(b []byte) error {
+	f := SwapForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*Swap)
+
+	return nil
+}
+
 // SettlementForm is the JSON friendly version of a Settlement.
 type SettlementForm struct {
 	BaseForm
@@ -2330,9 +3911,14 @@ func (f *SettlementForm) Write(b []byte) (n int, err error) {
 	return len(b), nil
 }
 
-// Validate returns an error if validations fails.
+// Validate returns FieldErrors if validation fails, nil otherwise.
 func (f SettlementForm) Validate() error {
-	return nil
+	errs := FieldErrors{}
+
+	errs.checkLen("asset_type", f.AssetType, 3)
+	errs.checkLen("asset_id", f.AssetID, 32)
+
+	return errs.err()
 }
 
 // PayloadForm returns a PayloadForm for this Form, or nil if this Form has
@@ -2343,6 +3929,10 @@ func (f SettlementForm) PayloadForm() (PayloadForm, error) {
 
 // BuildMessage returns an OpReturnMessage from the form.
 func (f SettlementForm) BuildMessage() (OpReturnMessage, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	m := NewSettlement()
@@ -2367,3 +3957,46 @@ func (f SettlementForm) BuildMessage() (OpReturnMessage, error) {
 
 	return &m, nil
 }
+
+// Form returns the form of the message, which is its JSON representation.
+func (m Settlement) Form() (*SettlementForm, error) {
+	f := SettlementForm{
+		Version:        m.Version,
+		AssetType:      text(m.AssetType),
+		AssetID:        text(m.AssetID),
+		Party1TokenQty: m.Party1TokenQty,
+		Party2TokenQty: m.Party2TokenQty,
+		Timestamp:      m.Timestamp,
+	}
+
+	return &f, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, writing the message
+// as its form.
+func (m Settlement) MarshalJSON() ([]byte, error) {
+	f, err := m.Form()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, building the
+// message from its form.
+func (m *Settlement) UnmarshalJSON(b []byte) error {
+	f := SettlementForm{}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+
+	built, err := f.BuildMessage()
+	if err != nil {
+		return err
+	}
+
+	*m = *built.(*Settlement)
+
+	return nil
+}
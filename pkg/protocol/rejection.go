@@ -0,0 +1,143 @@
+package protocol
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// RejectionMessageLen is the size of the Message of a Rejection.
+const RejectionMessageLen = 169
+
+// RejectionReason is the reason a message was rejected by a contract.
+//
+// The reason is written to the Message of a Rejection, starting with the
+// label of the code, so it can be read as it is, or parsed again with
+// NewRejectionReasonFromRejection. For example :
+//
+//	Insufficient Assets (token_qty) : holding 10 is less than 1000
+//
+// A *RejectionReason is an error, so a message that fails while it is being
+// processed can be answered with a Rejection.
+type RejectionReason struct {
+	// Code is the rejection code, described in RejectionCodes.
+	Code uint8
+
+	// Field is the JSON name of the field of the message that was
+	// rejected, if any.
+	Field string
+
+	// Text explains the rejection. It is cut short to fit the Message.
+	Text string
+}
+
+// NewRejectionReason returns a new RejectionReason, with the text formatted
+// from the format and args.
+func NewRejectionReason(code uint8,
+	field string,
+	format string,
+	args ...interface{}) *RejectionReason {
+
+	return &RejectionReason{
+		Code:  code,
+		Field: field,
+		Text:  fmt.Sprintf(format, args...),
+	}
+}
+
+// NewRejectionReasonFromRejection returns the RejectionReason of a
+// Rejection. A Message that does not start with the label of its code is
+// all text.
+func NewRejectionReasonFromRejection(m *Rejection) RejectionReason {
+	r := RejectionReason{
+		Code: m.RejectionType,
+	}
+
+	s := string(m.Message)
+
+	label := rejectionLabel(r.Code)
+	if !strings.HasPrefix(s, label) {
+		r.Text = s
+		return r
+	}
+
+	s = s[len(label):]
+
+	if strings.HasPrefix(s, " (") {
+		if end := strings.Index(s, ")"); end > 0 {
+			r.Field = s[2:end]
+			s = s[end+1:]
+		}
+	}
+
+	r.Text = strings.TrimPrefix(s, " : ")
+
+	return r
+}
+
+func (r *RejectionReason) Error() string {
+	return r.format(r.Text)
+}
+
+// Message returns the Message of a Rejection for the reason. The text is
+// cut short, on a character boundary, so the Message is no longer than
+// RejectionMessageLen.
+func (r RejectionReason) Message() []byte {
+	text := r.Text
+	over := len(r.format(text)) - RejectionMessageLen
+
+	if over > 0 {
+		n := len(text) - over
+		if n < 0 {
+			n = 0
+		}
+
+		for n > 0 && !utf8.RuneStart(text[n]) {
+			n--
+		}
+
+		text = text[:n]
+	}
+
+	b := []byte(r.format(text))
+	if len(b) > RejectionMessageLen {
+		b = b[:RejectionMessageLen]
+	}
+
+	return b
+}
+
+// Rejection returns a new Rejection for the reason.
+func (r RejectionReason) Rejection() Rejection {
+	m := NewRejection()
+	m.RejectionType = r.Code
+	m.Message = r.Message()
+	return m
+}
+
+// format returns the label of the code, followed by the field and the
+// text, if they are set.
+func (r RejectionReason) format(text string) string {
+	s := rejectionLabel(r.Code)
+
+	if len(r.Field) > 0 {
+		s += " (" + r.Field + ")"
+	}
+
+	if len(text) > 0 {
+		s += " : " + text
+	}
+
+	return s
+}
+
+// rejectionLabel returns the label of a rejection code, or the number of
+// the code if it is not in the catalog.
+func rejectionLabel(code uint8) string {
+	info, ok := RejectionCodes[code]
+	if !ok {
+		return fmt.Sprintf("Code %d", code)
+	}
+
+	return info.Label
+}
@@ -1,27 +1,119 @@
 package protocol
 
+// RejectionCodeInfo describes a rejection code, for wallets to display.
+type RejectionCodeInfo struct {
+	// Label is the short name of the code. It starts the Message of a
+	// Rejection.
+	Label string
+
+	// Description explains the code to the sender of the rejected message.
+	Description string
+}
+
 var (
-	RejectionCodes = map[uint8][]byte{
-		1:  []byte("Fee Not Paid"),
-		2:  []byte("Issuer Address"),
-		3:  []byte("Duplicate Asset ID"),
-		4:  []byte("Fixed Quantity"),
-		5:  []byte("Contract Exists"),
-		6:  []byte("Contract Not Dynamic"),
-		7:  []byte("Contract Qty Reduction"),
-		8:  []byte("Contract Auth Flags"),
-		9:  []byte("Contract Expiration"),
-		10: []byte("Contract Update"),
-		11: []byte("Vote Exists"),
-		12: []byte("Vote Not Found"),
-		13: []byte("Vote Closed"),
-		14: []byte("Asset Not Found"),
-		15: []byte("Insufficient Assets"),
-		16: []byte("Transfer Self"),
-		17: []byte("Receiver Unspecified"),
-		18: []byte("Unknown Address"),
-		19: []byte("Frozen"),
-		20: []byte("Contract Revision incorrect"),
-		21: []byte("Asset Revision incorrect"),
+	// RejectionCodes is the catalog of rejection codes, keyed by the
+	// RejectionType of a Rejection. Codes are never reused, so a wallet can
+	// show the Description of a code from any contract.
+	RejectionCodes = map[uint8]RejectionCodeInfo{
+		RejectionCodeInsufficientValue: {
+			Label:       "Fee Not Paid",
+			Description: "The message did not pay enough to fund the response of the contract.",
+		},
+		RejectionCodeIssuerAddress: {
+			Label:       "Issuer Address",
+			Description: "The message was sent from an address that is not the issuer or operator of the contract.",
+		},
+		RejectionCodeDuplicateAssetID: {
+			Label:       "Duplicate Asset ID",
+			Description: "The contract already has an asset with the asset ID.",
+		},
+		RejectionCodeFixedQuantity: {
+			Label:       "Fixed Quantity",
+			Description: "The contract already has as many assets as it permits.",
+		},
+		RejectionCodeContractExists: {
+			Label:       "Contract Exists",
+			Description: "A contract was offered to an address that already has a contract.",
+		},
+		RejectionCodeContractNotDynamic: {
+			Label:       "Contract Not Dynamic",
+			Description: "The contract is not dynamic, so it can not be changed.",
+		},
+		RejectionCodeContractQtyReduction: {
+			Label:       "Contract Qty Reduction",
+			Description: "The number of assets permitted can not be less than the number of assets of the contract.",
+		},
+		RejectionCodeContractAuthFlags: {
+			Label:       "Contract Auth Flags",
+			Description: "The authorization flags of the contract do not permit them to be changed.",
+		},
+		RejectionCodeContractExpiration: {
+			Label:       "Contract Expiration",
+			Description: "The authorization flags of the contract do not permit the expiration to be changed.",
+		},
+		RejectionCodeContractUpdate: {
+			Label:       "Contract Update",
+			Description: "The authorization flags of the contract do not permit it to be changed.",
+		},
+		RejectionCodeVoteExists: {
+			Label:       "Vote Exists",
+			Description: "A vote already exists for the proposal.",
+		},
+		RejectionCodeVoteNotFound: {
+			Label:       "Vote Not Found",
+			Description: "The contract has no vote with the vote ID.",
+		},
+		RejectionCodeVoteClosed: {
+			Label:       "Vote Closed",
+			Description: "The ballot was cast after the vote closed.",
+		},
+		RejectionCodeAssetNotFound: {
+			Label:       "Asset Not Found",
+			Description: "The contract has no asset with the asset ID.",
+		},
+		RejectionCodeInsufficientAssets: {
+			Label:       "Insufficient Assets",
+			Description: "The holding of the sender is smaller than the quantity of the message.",
+		},
+		RejectionCodeTransferSelf: {
+			Label:       "Transfer Self",
+			Description: "Tokens can not be sent to the address that holds them.",
+		},
+		RejectionCodeReceiverUnspecified: {
+			Label:       "Receiver Unspecified",
+			Description: "The transaction does not have an output or input for each party of the message.",
+		},
+		RejectionCodeUnknownAddress: {
+			Label:       "Unknown Address",
+			Description: "The message was sent from an address that holds no assets of the contract.",
+		},
+		RejectionCodeFrozen: {
+			Label:       "Frozen",
+			Description: "The holding is frozen by an order of the contract.",
+		},
+		RejectionCodeContractRevision: {
+			Label:       "Contract Revision incorrect",
+			Description: "The message was not made from the current revision of the contract.",
+		},
+		RejectionCodeAssetRevision: {
+			Label:       "Asset Revision incorrect",
+			Description: "The message was not made from the current revision of the asset.",
+		},
+		RejectionCodeDustOutput: {
+			Label:       "Dust Output",
+			Description: "The response would pay an output too small to be relayed.",
+		},
+		RejectionCodeProtocolVersion: {
+			Label:       "Protocol Version",
+			Description: "The message is of a different version of the protocol than the contract.",
+		},
+		RejectionCodeContractNotFound: {
+			Label:       "Contract Not Found",
+			Description: "There is no contract at the address the message was sent to.",
+		},
+		RejectionCodeMalformed: {
+			Label:       "Malformed",
+			Description: "A field of the message does not hold a valid value.",
+		},
 	}
 )
@@ -0,0 +1,140 @@
+package protocol
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestRejectionCodes(t *testing.T) {
+	// every code, other than OK, is in the catalog
+	for code := RejectionCodeInsufficientValue; code <= RejectionCodeMalformed; code++ {
+		info, ok := RejectionCodes[code]
+		if !ok {
+			t.Errorf("no catalog entry for code %d", code)
+			continue
+		}
+
+		if len(info.Label) == 0 || len(info.Description) == 0 {
+			t.Errorf("code %d : got %+v, want a label and description", code, info)
+		}
+	}
+
+	if _, ok := RejectionCodes[RejectionCodeOK]; ok {
+		t.Error("OK is a rejection code")
+	}
+}
+
+func TestRejectionReason_Message(t *testing.T) {
+	tests := []struct {
+		name   string
+		reason RejectionReason
+		want   string
+	}{
+		{
+			name: "code",
+			reason: RejectionReason{
+				Code: RejectionCodeFrozen,
+			},
+			want: "Frozen",
+		},
+		{
+			name: "text",
+			reason: RejectionReason{
+				Code: RejectionCodeFrozen,
+				Text: "holding of 1Abc is frozen",
+			},
+			want: "Frozen : holding of 1Abc is frozen",
+		},
+		{
+			name: "field and text",
+			reason: RejectionReason{
+				Code:  RejectionCodeInsufficientAssets,
+				Field: "token_qty",
+				Text:  "holding 10 is less than 1000",
+			},
+			want: "Insufficient Assets (token_qty) : holding 10 is less than 1000",
+		},
+		{
+			name: "unknown code",
+			reason: RejectionReason{
+				Code: 0xfe,
+				Text: "from a later version",
+			},
+			want: "Code 254 : from a later version",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := tt.reason.Rejection()
+
+			b, err := m.Bytes()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := Rejection{}
+			if _, err := got.Write(b); err != nil {
+				t.Fatal(err)
+			}
+
+			if string(got.Message) != tt.want {
+				t.Errorf("got %q, want %q", got.Message, tt.want)
+			}
+
+			// the reason can be read back from the rejection
+			if r := NewRejectionReasonFromRejection(&got); r != tt.reason {
+				t.Errorf("got %+v, want %+v", r, tt.reason)
+			}
+		})
+	}
+}
+
+func TestRejectionReason_Message_long(t *testing.T) {
+	r := RejectionReason{
+		Code:  RejectionCodeMalformed,
+		Field: "target_address",
+		Text:  strings.Repeat("é", RejectionMessageLen),
+	}
+
+	m := r.Rejection()
+
+	if len(m.Message) > RejectionMessageLen {
+		t.Fatalf("got message of %d bytes, want at most %d", len(m.Message), RejectionMessageLen)
+	}
+
+	if !utf8.Valid(m.Message) {
+		t.Errorf("got message cut inside a character : %q", m.Message)
+	}
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Errorf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got := NewRejectionReasonFromRejection(&m)
+	if got.Field != r.Field || !strings.HasPrefix(r.Text, got.Text) {
+		t.Errorf("got %+v", got)
+	}
+}
+
+func TestNewRejectionReasonFromRejection_text(t *testing.T) {
+	// a message that does not start with the label is all text
+	m := NewRejection()
+	m.RejectionType = RejectionCodeFrozen
+	m.Message = []byte("Holding frozen by order")
+
+	want := RejectionReason{
+		Code: RejectionCodeFrozen,
+		Text: "Holding frozen by order",
+	}
+
+	if got := NewRejectionReasonFromRejection(&m); got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
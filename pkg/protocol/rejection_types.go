@@ -87,4 +87,20 @@ const (
 	// RejectionCodeAssetRevision is returned when the incorrect asset
 	// revision is sent.
 	RejectionCodeAssetRevision
+
+	// RejectionCodeDustOutput is returned when the response would pay an
+	// output that is too small to be relayed.
+	RejectionCodeDustOutput
+
+	// RejectionCodeProtocolVersion is returned when a message is sent with a
+	// different protocol version than the contract was formed with.
+	RejectionCodeProtocolVersion
+
+	// RejectionCodeContractNotFound is returned when a message is sent to an
+	// address that has no contract.
+	RejectionCodeContractNotFound
+
+	// RejectionCodeMalformed is returned when a field of a message does not
+	// hold a valid value, such as an address that can not be decoded.
+	RejectionCodeMalformed
 )
@@ -0,0 +1,2399 @@
+package protocol
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// The code in this file is auto-generated. Do not edit it by hand as it will
+// be overwritten when code is regenerated.
+
+func TestAssetDefinition_roundTrip(t *testing.T) {
+	m := NewAssetDefinition()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.AuthorizationFlags = []byte{0x00, 0x04}
+	m.VotingSystem = byte('M')
+	m.VoteMultiplier = uint8(6)
+	m.Qty = uint64(7)
+	m.ContractFeeCurrency = []byte("hh")
+	m.ContractFeeVar = float32(8.5)
+	m.ContractFeeFixed = float32(9.5)
+	m.Payload = make([]byte, 152)
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestAssetDefinition_json(t *testing.T) {
+	m := NewAssetDefinition()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.AuthorizationFlags = []byte{0x00, 0x04}
+	m.VotingSystem = byte('M')
+	m.VoteMultiplier = uint8(6)
+	m.Qty = uint64(7)
+	m.ContractFeeCurrency = []byte("hh")
+	m.ContractFeeVar = float32(8.5)
+	m.ContractFeeFixed = float32(9.5)
+	m.Payload = make([]byte, 152)
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := AssetDefinition{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestAssetCreation_roundTrip(t *testing.T) {
+	m := NewAssetCreation()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.AssetRevision = uint16(4)
+	m.AuthorizationFlags = []byte{0x00, 0x05}
+	m.VotingSystem = byte('S')
+	m.VoteMultiplier = uint8(7)
+	m.Qty = uint64(8)
+	m.ContractFeeCurrency = []byte("ii")
+	m.ContractFeeVar = float32(9.5)
+	m.ContractFeeFixed = float32(10.5)
+	m.Payload = make([]byte, 152)
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestAssetCreation_json(t *testing.T) {
+	m := NewAssetCreation()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.AssetRevision = uint16(4)
+	m.AuthorizationFlags = []byte{0x00, 0x05}
+	m.VotingSystem = byte('S')
+	m.VoteMultiplier = uint8(7)
+	m.Qty = uint64(8)
+	m.ContractFeeCurrency = []byte("ii")
+	m.ContractFeeVar = float32(9.5)
+	m.ContractFeeFixed = float32(10.5)
+	m.Payload = make([]byte, 152)
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := AssetCreation{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestAssetModification_roundTrip(t *testing.T) {
+	m := NewAssetModification()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.AssetRevision = uint16(4)
+	m.AuthorizationFlags = []byte{0x00, 0x05}
+	m.VotingSystem = byte('S')
+	m.VoteMultiplier = uint8(7)
+	m.Qty = uint64(8)
+	m.ContractFeeCurrency = []byte("ii")
+	m.ContractFeeVar = float32(9.5)
+	m.ContractFeeFixed = float32(10.5)
+	m.Payload = make([]byte, 152)
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestAssetModification_json(t *testing.T) {
+	m := NewAssetModification()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.AssetRevision = uint16(4)
+	m.AuthorizationFlags = []byte{0x00, 0x05}
+	m.VotingSystem = byte('S')
+	m.VoteMultiplier = uint8(7)
+	m.Qty = uint64(8)
+	m.ContractFeeCurrency = []byte("ii")
+	m.ContractFeeVar = float32(9.5)
+	m.ContractFeeFixed = float32(10.5)
+	m.Payload = make([]byte, 152)
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := AssetModification{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestContractOffer_roundTrip(t *testing.T) {
+	m := NewContractOffer()
+	m.Version = uint8(1)
+	m.ContractName = []byte("bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	m.ContractFileHash = []byte("cccccccccccccccccccccccccccccccc")
+	m.GoverningLaw = []byte("dddd")
+	m.Jurisdiction = []byte("eeee")
+	m.ContractExpiration = uint64(6)
+	m.URI = []byte("ggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggg")
+	m.IssuerID = []byte("hhhhhhhhhhhhhhh")
+	m.IssuerType = byte('N')
+	m.ContractOperatorID = []byte("jjjjjjjjjjjjjjj")
+	m.AuthorizationFlags = []byte{0x00, 0x0b}
+	m.VotingSystem = byte('N')
+	m.InitiativeThreshold = float32(12.5)
+	m.InitiativeThresholdCurrency = []byte("nn")
+	m.RestrictedQty = uint64(15)
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestContractOffer_json(t *testing.T) {
+	m := NewContractOffer()
+	m.Version = uint8(1)
+	m.ContractName = []byte("bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	m.ContractFileHash = []byte("cccccccccccccccccccccccccccccccc")
+	m.GoverningLaw = []byte("dddd")
+	m.Jurisdiction = []byte("eeee")
+	m.ContractExpiration = uint64(6)
+	m.URI = []byte("ggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggg")
+	m.IssuerID = []byte("hhhhhhhhhhhhhhh")
+	m.IssuerType = byte('N')
+	m.ContractOperatorID = []byte("jjjjjjjjjjjjjjj")
+	m.AuthorizationFlags = []byte{0x00, 0x0b}
+	m.VotingSystem = byte('N')
+	m.InitiativeThreshold = float32(12.5)
+	m.InitiativeThresholdCurrency = []byte("nn")
+	m.RestrictedQty = uint64(15)
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := ContractOffer{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestContractFormation_roundTrip(t *testing.T) {
+	m := NewContractFormation()
+	m.Version = uint8(1)
+	m.ContractName = []byte("bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	m.ContractFileHash = []byte("cccccccccccccccccccccccccccccccc")
+	m.GoverningLaw = []byte("dddd")
+	m.Jurisdiction = []byte("eeee")
+	m.ContractExpiration = uint64(6)
+	m.URI = []byte("ggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggg")
+	m.ContractRevision = uint16(8)
+	m.IssuerID = []byte("iiiiiiiiiiiiiii")
+	m.IssuerType = byte('G')
+	m.ContractOperatorID = []byte("kkkkkkkkkkkkkkk")
+	m.AuthorizationFlags = []byte{0x00, 0x0c}
+	m.VotingSystem = byte('M')
+	m.InitiativeThreshold = float32(13.5)
+	m.InitiativeThresholdCurrency = []byte("oo")
+	m.RestrictedQty = uint64(16)
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestContractFormation_json(t *testing.T) {
+	m := NewContractFormation()
+	m.Version = uint8(1)
+	m.ContractName = []byte("bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	m.ContractFileHash = []byte("cccccccccccccccccccccccccccccccc")
+	m.GoverningLaw = []byte("dddd")
+	m.Jurisdiction = []byte("eeee")
+	m.ContractExpiration = uint64(6)
+	m.URI = []byte("ggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggg")
+	m.ContractRevision = uint16(8)
+	m.IssuerID = []byte("iiiiiiiiiiiiiii")
+	m.IssuerType = byte('G')
+	m.ContractOperatorID = []byte("kkkkkkkkkkkkkkk")
+	m.AuthorizationFlags = []byte{0x00, 0x0c}
+	m.VotingSystem = byte('M')
+	m.InitiativeThreshold = float32(13.5)
+	m.InitiativeThresholdCurrency = []byte("oo")
+	m.RestrictedQty = uint64(16)
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := ContractFormation{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestContractAmendment_roundTrip(t *testing.T) {
+	m := NewContractAmendment()
+	m.Version = uint8(1)
+	m.ContractName = []byte("bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	m.ContractFileHash = []byte("cccccccccccccccccccccccccccccccc")
+	m.GoverningLaw = []byte("dddd")
+	m.Jurisdiction = []byte("eeee")
+	m.ContractExpiration = uint64(6)
+	m.URI = []byte("ggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggg")
+	m.ContractRevision = uint16(8)
+	m.IssuerID = []byte("iiiiiiiiiiiiiii")
+	m.IssuerType = byte('G')
+	m.ContractOperatorID = []byte("kkkkkkkkkkkkkkk")
+	m.AuthorizationFlags = []byte{0x00, 0x0c}
+	m.VotingSystem = byte('M')
+	m.InitiativeThreshold = float32(13.5)
+	m.InitiativeThresholdCurrency = []byte("oo")
+	m.RestrictedQty = uint64(16)
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestContractAmendment_json(t *testing.T) {
+	m := NewContractAmendment()
+	m.Version = uint8(1)
+	m.ContractName = []byte("bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	m.ContractFileHash = []byte("cccccccccccccccccccccccccccccccc")
+	m.GoverningLaw = []byte("dddd")
+	m.Jurisdiction = []byte("eeee")
+	m.ContractExpiration = uint64(6)
+	m.URI = []byte("ggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggg")
+	m.ContractRevision = uint16(8)
+	m.IssuerID = []byte("iiiiiiiiiiiiiii")
+	m.IssuerType = byte('G')
+	m.ContractOperatorID = []byte("kkkkkkkkkkkkkkk")
+	m.AuthorizationFlags = []byte{0x00, 0x0c}
+	m.VotingSystem = byte('M')
+	m.InitiativeThreshold = float32(13.5)
+	m.InitiativeThresholdCurrency = []byte("oo")
+	m.RestrictedQty = uint64(16)
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := ContractAmendment{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestOrder_roundTrip(t *testing.T) {
+	m := NewOrder()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.ComplianceAction = byte('R')
+	m.TargetAddress = []byte("eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee")
+	m.DepositAddress = []byte("fffffffffffffffffffffffffffffffff")
+	m.SupportingEvidenceHash = []byte("gggggggggggggggggggggggggggggggg")
+	m.Qty = uint64(8)
+	m.Expiration = uint64(9)
+	m.Message = []byte("jjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjj")
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestOrder_json(t *testing.T) {
+	m := NewOrder()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.ComplianceAction = byte('R')
+	m.TargetAddress = []byte("eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee")
+	m.DepositAddress = []byte("fffffffffffffffffffffffffffffffff")
+	m.SupportingEvidenceHash = []byte("gggggggggggggggggggggggggggggggg")
+	m.Qty = uint64(8)
+	m.Expiration = uint64(9)
+	m.Message = []byte("jjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjj")
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Order{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestFreeze_roundTrip(t *testing.T) {
+	m := NewFreeze()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.Timestamp = uint64(4)
+	m.Qty = uint64(5)
+	m.Expiration = uint64(1005)
+	m.Message = []byte("gggggggggggggggggggggggggggggggggggggggggggggggggggggggggggg")
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestFreeze_json(t *testing.T) {
+	m := NewFreeze()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.Timestamp = uint64(4)
+	m.Qty = uint64(5)
+	m.Expiration = uint64(1005)
+	m.Message = []byte("gggggggggggggggggggggggggggggggggggggggggggggggggggggggggggg")
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Freeze{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestThaw_roundTrip(t *testing.T) {
+	m := NewThaw()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.Timestamp = uint64(4)
+	m.Qty = uint64(5)
+	m.Message = []byte("ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestThaw_json(t *testing.T) {
+	m := NewThaw()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.Timestamp = uint64(4)
+	m.Qty = uint64(5)
+	m.Message = []byte("ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Thaw{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestConfiscation_roundTrip(t *testing.T) {
+	m := NewConfiscation()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.Timestamp = uint64(4)
+	m.TargetsQty = uint64(5)
+	m.DepositsQty = uint64(6)
+	m.Message = []byte("gggggggggggggggggggggggggggggggggggggggggggggggggggggggggggg")
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestConfiscation_json(t *testing.T) {
+	m := NewConfiscation()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.Timestamp = uint64(4)
+	m.TargetsQty = uint64(5)
+	m.DepositsQty = uint64(6)
+	m.Message = []byte("gggggggggggggggggggggggggggggggggggggggggggggggggggggggggggg")
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Confiscation{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestReconciliation_roundTrip(t *testing.T) {
+	m := NewReconciliation()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.RefTxnID = []byte("dddddddddddddddddddddddddddddddd")
+	m.TargetAddressQty = uint64(5)
+	m.Timestamp = uint64(6)
+	m.Message = []byte("gggggggggggggggggggggggggggggggggggggggggggggggggggggggggggg")
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestReconciliation_json(t *testing.T) {
+	m := NewReconciliation()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.RefTxnID = []byte("dddddddddddddddddddddddddddddddd")
+	m.TargetAddressQty = uint64(5)
+	m.Timestamp = uint64(6)
+	m.Message = []byte("gggggggggggggggggggggggggggggggggggggggggggggggggggggggggggg")
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Reconciliation{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestInitiative_roundTrip(t *testing.T) {
+	m := NewInitiative()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.VoteType = byte('D')
+	m.VoteOptions = []byte("eeeeeeeeeeeeeee")
+	m.VoteMax = uint8(6)
+	m.VoteLogic = byte('0')
+	m.ProposalDescription = []byte("hhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhh")
+	m.ProposalDocumentHash = []byte("iiiiiiiiiiiiiiiiiiiiiiiiiiiiiiii")
+	m.VoteCutOffTimestamp = uint64(10)
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestInitiative_json(t *testing.T) {
+	m := NewInitiative()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.VoteType = byte('D')
+	m.VoteOptions = []byte("eeeeeeeeeeeeeee")
+	m.VoteMax = uint8(6)
+	m.VoteLogic = byte('0')
+	m.ProposalDescription = []byte("hhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhh")
+	m.ProposalDocumentHash = []byte("iiiiiiiiiiiiiiiiiiiiiiiiiiiiiiii")
+	m.VoteCutOffTimestamp = uint64(10)
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Initiative{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestReferendum_roundTrip(t *testing.T) {
+	m := NewReferendum()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.VoteType = byte('D')
+	m.VoteOptions = []byte("eeeeeeeeeeeeeee")
+	m.VoteMax = uint8(6)
+	m.VoteLogic = byte('0')
+	m.ProposalDescription = []byte("hhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhh")
+	m.ProposalDocumentHash = []byte("iiiiiiiiiiiiiiiiiiiiiiiiiiiiiiii")
+	m.VoteCutOffTimestamp = uint64(10)
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestReferendum_json(t *testing.T) {
+	m := NewReferendum()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.VoteType = byte('D')
+	m.VoteOptions = []byte("eeeeeeeeeeeeeee")
+	m.VoteMax = uint8(6)
+	m.VoteLogic = byte('0')
+	m.ProposalDescription = []byte("hhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhh")
+	m.ProposalDocumentHash = []byte("iiiiiiiiiiiiiiiiiiiiiiiiiiiiiiii")
+	m.VoteCutOffTimestamp = uint64(10)
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Referendum{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestVote_roundTrip(t *testing.T) {
+	m := NewVote()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.VoteType = byte('D')
+	m.VoteOptions = []byte("eeeeeeeeeeeeeee")
+	m.VoteMax = uint8(6)
+	m.VoteLogic = byte('0')
+	m.ProposalDescription = []byte("hhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhh")
+	m.ProposalDocumentHash = []byte("iiiiiiiiiiiiiiiiiiiiiiiiiiiiiiii")
+	m.VoteCutOffTimestamp = uint64(10)
+	m.Timestamp = uint64(11)
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestVote_json(t *testing.T) {
+	m := NewVote()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.VoteType = byte('D')
+	m.VoteOptions = []byte("eeeeeeeeeeeeeee")
+	m.VoteMax = uint8(6)
+	m.VoteLogic = byte('0')
+	m.ProposalDescription = []byte("hhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhh")
+	m.ProposalDocumentHash = []byte("iiiiiiiiiiiiiiiiiiiiiiiiiiiiiiii")
+	m.VoteCutOffTimestamp = uint64(10)
+	m.Timestamp = uint64(11)
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Vote{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestBallotCast_roundTrip(t *testing.T) {
+	m := NewBallotCast()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.VoteTxnID = []byte("dddddddddddddddddddddddddddddddd")
+	m.Vote = []byte("eeeeeeeeeeeeeee")
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestBallotCast_json(t *testing.T) {
+	m := NewBallotCast()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.VoteTxnID = []byte("dddddddddddddddddddddddddddddddd")
+	m.Vote = []byte("eeeeeeeeeeeeeee")
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := BallotCast{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestBallotCounted_roundTrip(t *testing.T) {
+	m := NewBallotCounted()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.VoteTxnID = []byte("dddddddddddddddddddddddddddddddd")
+	m.Vote = []byte("eeeeeeeeeeeeeee")
+	m.Timestamp = uint64(6)
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestBallotCounted_json(t *testing.T) {
+	m := NewBallotCounted()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.VoteTxnID = []byte("dddddddddddddddddddddddddddddddd")
+	m.Vote = []byte("eeeeeeeeeeeeeee")
+	m.Timestamp = uint64(6)
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := BallotCounted{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestResult_roundTrip(t *testing.T) {
+	m := NewResult()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.VoteType = byte('D')
+	m.VoteTxnID = []byte("eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee")
+	m.Timestamp = uint64(6)
+	m.Option1Tally = uint64(7)
+	m.Option2Tally = uint64(8)
+	m.Option3Tally = uint64(9)
+	m.Option4Tally = uint64(10)
+	m.Option5Tally = uint64(11)
+	m.Option6Tally = uint64(12)
+	m.Option7Tally = uint64(13)
+	m.Option8Tally = uint64(14)
+	m.Option9Tally = uint64(15)
+	m.Option10Tally = uint64(16)
+	m.Option11Tally = uint64(17)
+	m.Option12Tally = uint64(18)
+	m.Option13Tally = uint64(19)
+	m.Option14Tally = uint64(20)
+	m.Option15Tally = uint64(21)
+	m.Result = []byte("vvvvvvvvvvvvvvv")
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestResult_json(t *testing.T) {
+	m := NewResult()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.VoteType = byte('D')
+	m.VoteTxnID = []byte("eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee")
+	m.Timestamp = uint64(6)
+	m.Option1Tally = uint64(7)
+	m.Option2Tally = uint64(8)
+	m.Option3Tally = uint64(9)
+	m.Option4Tally = uint64(10)
+	m.Option5Tally = uint64(11)
+	m.Option6Tally = uint64(12)
+	m.Option7Tally = uint64(13)
+	m.Option8Tally = uint64(14)
+	m.Option9Tally = uint64(15)
+	m.Option10Tally = uint64(16)
+	m.Option11Tally = uint64(17)
+	m.Option12Tally = uint64(18)
+	m.Option13Tally = uint64(19)
+	m.Option14Tally = uint64(20)
+	m.Option15Tally = uint64(21)
+	m.Result = []byte("vvvvvvvvvvvvvvv")
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Result{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestMessage_roundTrip(t *testing.T) {
+	m := NewMessage()
+	m.Version = uint8(1)
+	m.Timestamp = uint64(2)
+	m.MessageType = []byte("c")
+	m.Message = []byte("dddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddd")
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestMessage_json(t *testing.T) {
+	m := NewMessage()
+	m.Version = uint8(1)
+	m.Timestamp = uint64(2)
+	m.MessageType = []byte("c")
+	m.Message = []byte("dddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddd")
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Message{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestRejection_roundTrip(t *testing.T) {
+	m := NewRejection()
+	m.Version = uint8(1)
+	m.Timestamp = uint64(2)
+	m.AssetType = []byte("cc")
+	m.AssetID = []byte("ddddddddddddddddddddddddddddddd")
+	m.RejectionType = byte('E')
+	m.Message = []byte("ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestRejection_json(t *testing.T) {
+	m := NewRejection()
+	m.Version = uint8(1)
+	m.Timestamp = uint64(2)
+	m.AssetType = []byte("cc")
+	m.AssetID = []byte("ddddddddddddddddddddddddddddddd")
+	m.RejectionType = byte('E')
+	m.Message = []byte("ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Rejection{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestEstablishment_roundTrip(t *testing.T) {
+	m := NewEstablishment()
+	m.Version = uint8(1)
+	m.Registrar = []byte("bbbbbbbbbbbbbbb")
+	m.RegisterType = byte('C')
+	m.KYCJurisdiction = []byte("dddd")
+	m.DOB = uint64(5)
+	m.CountryOfResidence = []byte("ff")
+	m.SupportingDocumentationHash = []byte("gggggggggggggggggggggggggggggggg")
+	m.Message = []byte("hhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhh")
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestEstablishment_json(t *testing.T) {
+	m := NewEstablishment()
+	m.Version = uint8(1)
+	m.Registrar = []byte("bbbbbbbbbbbbbbb")
+	m.RegisterType = byte('C')
+	m.KYCJurisdiction = []byte("dddd")
+	m.DOB = uint64(5)
+	m.CountryOfResidence = []byte("ff")
+	m.SupportingDocumentationHash = []byte("gggggggggggggggggggggggggggggggg")
+	m.Message = []byte("hhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhh")
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Establishment{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestAddition_roundTrip(t *testing.T) {
+	m := NewAddition()
+	m.Version = uint8(1)
+	m.Sublist = []byte("bbb")
+	m.KYC = byte('C')
+	m.KYCJurisdiction = []byte("dddd")
+	m.DOB = uint64(5)
+	m.CountryOfResidence = []byte("ff")
+	m.SupportingDocumentationHash = []byte("gggggggggggggggggggggggggggggggg")
+	m.Message = []byte("hhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhh")
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestAddition_json(t *testing.T) {
+	m := NewAddition()
+	m.Version = uint8(1)
+	m.Sublist = []byte("bbb")
+	m.KYC = byte('C')
+	m.KYCJurisdiction = []byte("dddd")
+	m.DOB = uint64(5)
+	m.CountryOfResidence = []byte("ff")
+	m.SupportingDocumentationHash = []byte("gggggggggggggggggggggggggggggggg")
+	m.Message = []byte("hhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhh")
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Addition{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestAlteration_roundTrip(t *testing.T) {
+	m := NewAlteration()
+	m.Version = uint8(1)
+	m.Sublist = []byte("bbb")
+	m.KYC = byte('C')
+	m.KYCJurisdiction = []byte("dddd")
+	m.DOB = uint64(5)
+	m.CountryOfResidence = []byte("ff")
+	m.SupportingDocumentationHash = []byte("gggggggggggggggggggggggggggggggg")
+	m.Message = []byte("hhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhh")
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestAlteration_json(t *testing.T) {
+	m := NewAlteration()
+	m.Version = uint8(1)
+	m.Sublist = []byte("bbb")
+	m.KYC = byte('C')
+	m.KYCJurisdiction = []byte("dddd")
+	m.DOB = uint64(5)
+	m.CountryOfResidence = []byte("ff")
+	m.SupportingDocumentationHash = []byte("gggggggggggggggggggggggggggggggg")
+	m.Message = []byte("hhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhhh")
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Alteration{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestRemoval_roundTrip(t *testing.T) {
+	m := NewRemoval()
+	m.Version = uint8(1)
+	m.SupportingDocumentationHash = []byte("bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	m.Message = []byte("cccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc")
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestRemoval_json(t *testing.T) {
+	m := NewRemoval()
+	m.Version = uint8(1)
+	m.SupportingDocumentationHash = []byte("bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	m.Message = []byte("cccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc")
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Removal{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestSend_roundTrip(t *testing.T) {
+	m := NewSend()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.TokenQty = uint64(4)
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestSend_json(t *testing.T) {
+	m := NewSend()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.TokenQty = uint64(4)
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Send{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestExchange_roundTrip(t *testing.T) {
+	m := NewExchange()
+	m.Version = uint8(1)
+	m.Party1AssetType = []byte("bb")
+	m.Party1AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.Party1TokenQty = uint64(4)
+	m.OfferValidUntil = uint64(5)
+	m.ExchangeFeeCurrency = []byte("ff")
+	m.ExchangeFeeVar = float32(6.5)
+	m.ExchangeFeeFixed = float32(7.5)
+	m.ExchangeFeeAddress = []byte("iiiiiiiiiiiiiiiiiiiiiiiiiiiiiiiii")
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestExchange_json(t *testing.T) {
+	m := NewExchange()
+	m.Version = uint8(1)
+	m.Party1AssetType = []byte("bb")
+	m.Party1AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.Party1TokenQty = uint64(4)
+	m.OfferValidUntil = uint64(5)
+	m.ExchangeFeeCurrency = []byte("ff")
+	m.ExchangeFeeVar = float32(6.5)
+	m.ExchangeFeeFixed = float32(7.5)
+	m.ExchangeFeeAddress = []byte("iiiiiiiiiiiiiiiiiiiiiiiiiiiiiiiii")
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Exchange{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestSwap_roundTrip(t *testing.T) {
+	m := NewSwap()
+	m.Version = uint8(1)
+	m.Party1AssetType = []byte("bb")
+	m.Party1AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.Party1TokenQty = uint64(4)
+	m.OfferValidUntil = uint64(5)
+	m.Party2AssetType = []byte("ff")
+	m.Party2AssetID = []byte("ggggggggggggggggggggggggggggggg")
+	m.Party2TokenQty = uint64(8)
+	m.ExchangeFeeCurrency = []byte("ii")
+	m.ExchangeFeeVar = float32(9.5)
+	m.ExchangeFeeFixed = float32(10.5)
+	m.ExchangeFeeAddress = []byte("lllllllllllllllllllllllllllllllll")
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestSwap_json(t *testing.T) {
+	m := NewSwap()
+	m.Version = uint8(1)
+	m.Party1AssetType = []byte("bb")
+	m.Party1AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.Party1TokenQty = uint64(4)
+	m.OfferValidUntil = uint64(5)
+	m.Party2AssetType = []byte("ff")
+	m.Party2AssetID = []byte("ggggggggggggggggggggggggggggggg")
+	m.Party2TokenQty = uint64(8)
+	m.ExchangeFeeCurrency = []byte("ii")
+	m.ExchangeFeeVar = float32(9.5)
+	m.ExchangeFeeFixed = float32(10.5)
+	m.ExchangeFeeAddress = []byte("lllllllllllllllllllllllllllllllll")
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Swap{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestSettlement_roundTrip(t *testing.T) {
+	m := NewSettlement()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.Party1TokenQty = uint64(4)
+	m.Party2TokenQty = uint64(5)
+	m.Timestamp = uint64(6)
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := New(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, &m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, &m)
+	}
+}
+
+func TestSettlement_json(t *testing.T) {
+	m := NewSettlement()
+	m.Version = uint8(1)
+	m.AssetType = []byte("bb")
+	m.AssetID = []byte("ccccccccccccccccccccccccccccccc")
+	m.Party1TokenQty = uint64(4)
+	m.Party2TokenQty = uint64(5)
+	m.Timestamp = uint64(6)
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Settlement{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	wantBytes, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotBytes, err := got.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Errorf("got\n    %x\nwant\n    %x", gotBytes, wantBytes)
+	}
+}
+
+func TestAssetTypeBond_roundTrip(t *testing.T) {
+	m := NewAssetTypeBond()
+	m.Version = uint8(1)
+	m.TradingRestriction = []byte("bb")
+	m.Currency = []byte("cc")
+	m.FaceValue = float32(3.5)
+	m.CouponRate = float32(4.5)
+	m.InterestPaymentInterval = byte('F')
+	m.IssueDate = uint64(7)
+	m.MaturityDate = uint64(1007)
+	m.ISIN = []byte("iiiiiiiiiii")
+	m.Description = []byte("jjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjj")
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := NewPayloadMessageFromCode([]byte(CodeAssetTypeBond))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := got.Write(b); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, m)
+	}
+}
+
+func TestAssetTypeBond_json(t *testing.T) {
+	m := NewAssetTypeBond()
+	m.Version = uint8(1)
+	m.TradingRestriction = []byte("bb")
+	m.Currency = []byte("cc")
+	m.FaceValue = float32(3.5)
+	m.CouponRate = float32(4.5)
+	m.InterestPaymentInterval = byte('F')
+	m.IssueDate = uint64(7)
+	m.MaturityDate = uint64(1007)
+	m.ISIN = []byte("iiiiiiiiiii")
+	m.Description = []byte("jjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjj")
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := NewAssetTypeBond()
+	if err := json.Unmarshal(b, got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, m)
+	}
+}
+
+func TestAssetTypeCoupon_roundTrip(t *testing.T) {
+	m := NewAssetTypeCoupon()
+	m.Version = uint8(1)
+	m.TradingRestriction = []byte("bb")
+	m.RedeemingEntity = []byte("ccccccccccccccccccccccccccccccc")
+	m.ExpiryDate = uint64(1003)
+	m.IssueDate = uint64(5)
+	m.Description = []byte("fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := NewPayloadMessageFromCode([]byte(CodeAssetTypeCoupon))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := got.Write(b); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, m)
+	}
+}
+
+func TestAssetTypeCoupon_json(t *testing.T) {
+	m := NewAssetTypeCoupon()
+	m.Version = uint8(1)
+	m.TradingRestriction = []byte("bb")
+	m.RedeemingEntity = []byte("ccccccccccccccccccccccccccccccc")
+	m.ExpiryDate = uint64(1003)
+	m.IssueDate = uint64(5)
+	m.Description = []byte("fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := NewAssetTypeCoupon()
+	if err := json.Unmarshal(b, got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, m)
+	}
+}
+
+func TestAssetTypeCurrency_roundTrip(t *testing.T) {
+	m := NewAssetTypeCurrency()
+	m.Version = uint8(1)
+	m.TradingRestriction = []byte("bb")
+	m.ISOCode = []byte("cc")
+	m.MonetaryAuthority = []byte("ddddddddddddddddddddddddddddddd")
+	m.Precision = uint8(5)
+	m.Description = []byte("fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := NewPayloadMessageFromCode([]byte(CodeAssetTypeCurrency))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := got.Write(b); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, m)
+	}
+}
+
+func TestAssetTypeCurrency_json(t *testing.T) {
+	m := NewAssetTypeCurrency()
+	m.Version = uint8(1)
+	m.TradingRestriction = []byte("bb")
+	m.ISOCode = []byte("cc")
+	m.MonetaryAuthority = []byte("ddddddddddddddddddddddddddddddd")
+	m.Precision = uint8(5)
+	m.Description = []byte("fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := NewAssetTypeCurrency()
+	if err := json.Unmarshal(b, got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, m)
+	}
+}
+
+func TestAssetTypeLoyaltyPoints_roundTrip(t *testing.T) {
+	m := NewAssetTypeLoyaltyPoints()
+	m.Version = uint8(1)
+	m.TradingRestriction = []byte("bb")
+	m.AgeRestriction = []byte("cccc")
+	m.OfferName = []byte("ddddddddddddddddddddddddddddddd")
+	m.ValidFrom = uint64(5)
+	m.ExpirationTimestamp = uint64(1005)
+	m.Description = []byte("gggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggg")
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := NewPayloadMessageFromCode([]byte(CodeAssetTypeLoyaltyPoints))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := got.Write(b); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, m)
+	}
+}
+
+func TestAssetTypeLoyaltyPoints_json(t *testing.T) {
+	m := NewAssetTypeLoyaltyPoints()
+	m.Version = uint8(1)
+	m.TradingRestriction = []byte("bb")
+	m.AgeRestriction = []byte("cccc")
+	m.OfferName = []byte("ddddddddddddddddddddddddddddddd")
+	m.ValidFrom = uint64(5)
+	m.ExpirationTimestamp = uint64(1005)
+	m.Description = []byte("gggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggg")
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := NewAssetTypeLoyaltyPoints()
+	if err := json.Unmarshal(b, got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, m)
+	}
+}
+
+func TestAssetTypeMembership_roundTrip(t *testing.T) {
+	m := NewAssetTypeMembership()
+	m.Version = uint8(1)
+	m.TradingRestriction = []byte("bb")
+	m.AgeRestriction = []byte("cccc")
+	m.ValidFrom = uint64(4)
+	m.ExpirationTimestamp = uint64(1004)
+	m.MembershipType = []byte("fffffffffffffff")
+	m.Description = []byte("gggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggg")
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := NewPayloadMessageFromCode([]byte(CodeAssetTypeMembership))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := got.Write(b); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, m)
+	}
+}
+
+func TestAssetTypeMembership_json(t *testing.T) {
+	m := NewAssetTypeMembership()
+	m.Version = uint8(1)
+	m.TradingRestriction = []byte("bb")
+	m.AgeRestriction = []byte("cccc")
+	m.ValidFrom = uint64(4)
+	m.ExpirationTimestamp = uint64(1004)
+	m.MembershipType = []byte("fffffffffffffff")
+	m.Description = []byte("gggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggg")
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := NewAssetTypeMembership()
+	if err := json.Unmarshal(b, got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, m)
+	}
+}
+
+func TestAssetTypeMovieTicket_roundTrip(t *testing.T) {
+	m := NewAssetTypeMovieTicket()
+	m.Version = uint8(1)
+	m.TradingRestriction = []byte("bb")
+	m.AgeRestriction = []byte("cccc")
+	m.Venue = []byte("ddddddddddddddddddddddddddddddd")
+	m.ValidFrom = uint64(5)
+	m.ExpirationTimestamp = uint64(1005)
+	m.Description = []byte("gggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggg")
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := NewPayloadMessageFromCode([]byte(CodeAssetTypeMovieTicket))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := got.Write(b); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, m)
+	}
+}
+
+func TestAssetTypeMovieTicket_json(t *testing.T) {
+	m := NewAssetTypeMovieTicket()
+	m.Version = uint8(1)
+	m.TradingRestriction = []byte("bb")
+	m.AgeRestriction = []byte("cccc")
+	m.Venue = []byte("ddddddddddddddddddddddddddddddd")
+	m.ValidFrom = uint64(5)
+	m.ExpirationTimestamp = uint64(1005)
+	m.Description = []byte("gggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggggg")
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := NewAssetTypeMovieTicket()
+	if err := json.Unmarshal(b, got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, m)
+	}
+}
+
+func TestAssetTypeShareCommon_roundTrip(t *testing.T) {
+	m := NewAssetTypeShareCommon()
+	m.Version = uint8(1)
+	m.TradingRestriction = []byte("bb")
+	m.DividendType = byte('C')
+	m.DividendVar = float32(3.5)
+	m.DividendFixed = float32(4.5)
+	m.DistributionInterval = byte('F')
+	m.Guaranteed = byte('G')
+	m.Ticker = []byte("hhhh")
+	m.ISIN = []byte("iiiiiiiiiii")
+	m.Description = []byte("jjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjj")
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := NewPayloadMessageFromCode([]byte(CodeAssetTypeShareCommon))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := got.Write(b); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, m)
+	}
+}
+
+func TestAssetTypeShareCommon_json(t *testing.T) {
+	m := NewAssetTypeShareCommon()
+	m.Version = uint8(1)
+	m.TradingRestriction = []byte("bb")
+	m.DividendType = byte('C')
+	m.DividendVar = float32(3.5)
+	m.DividendFixed = float32(4.5)
+	m.DistributionInterval = byte('F')
+	m.Guaranteed = byte('G')
+	m.Ticker = []byte("hhhh")
+	m.ISIN = []byte("iiiiiiiiiii")
+	m.Description = []byte("jjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjjj")
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := NewAssetTypeShareCommon()
+	if err := json.Unmarshal(b, got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, m)
+	}
+}
+
+func TestAssetTypeSharePreferred_roundTrip(t *testing.T) {
+	m := NewAssetTypeSharePreferred()
+	m.Version = uint8(1)
+	m.TradingRestriction = []byte("bb")
+	m.DividendType = byte('C')
+	m.DividendVar = float32(3.5)
+	m.DividendFixed = float32(4.5)
+	m.DistributionInterval = byte('F')
+	m.Cumulative = byte('G')
+	m.Convertible = byte('H')
+	m.Ticker = []byte("iiii")
+	m.ISIN = []byte("jjjjjjjjjjj")
+	m.Description = []byte("kkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkk")
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := NewPayloadMessageFromCode([]byte(CodeAssetTypeSharePreferred))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := got.Write(b); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, m)
+	}
+}
+
+func TestAssetTypeSharePreferred_json(t *testing.T) {
+	m := NewAssetTypeSharePreferred()
+	m.Version = uint8(1)
+	m.TradingRestriction = []byte("bb")
+	m.DividendType = byte('C')
+	m.DividendVar = float32(3.5)
+	m.DividendFixed = float32(4.5)
+	m.DistributionInterval = byte('F')
+	m.Cumulative = byte('G')
+	m.Convertible = byte('H')
+	m.Ticker = []byte("iiii")
+	m.ISIN = []byte("jjjjjjjjjjj")
+	m.Description = []byte("kkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkk")
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := NewAssetTypeSharePreferred()
+	if err := json.Unmarshal(b, got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, m)
+	}
+}
+
+func TestAssetTypeTicketAdmission_roundTrip(t *testing.T) {
+	m := NewAssetTypeTicketAdmission()
+	m.Version = uint8(1)
+	m.TradingRestriction = []byte("bb")
+	m.AgeRestriction = []byte("cccc")
+	m.ValidFrom = uint64(4)
+	m.ExpirationTimestamp = uint64(1004)
+	m.Description = []byte("ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+
+	b, err := m.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if int64(len(b)) != m.Len() {
+		t.Fatalf("got %d bytes, want %d", len(b), m.Len())
+	}
+
+	got, err := NewPayloadMessageFromCode([]byte(CodeAssetTypeTicketAdmission))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := got.Write(b); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, m)
+	}
+}
+
+func TestAssetTypeTicketAdmission_json(t *testing.T) {
+	m := NewAssetTypeTicketAdmission()
+	m.Version = uint8(1)
+	m.TradingRestriction = []byte("bb")
+	m.AgeRestriction = []byte("cccc")
+	m.ValidFrom = uint64(4)
+	m.ExpirationTimestamp = uint64(1004)
+	m.Description = []byte("ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := NewAssetTypeTicketAdmission()
+	if err := json.Unmarshal(b, got); err != nil {
+		t.Fatalf("%v : %s", err, b)
+	}
+
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("got\n    %+v\nwant\n    %+v", got, m)
+	}
+}
@@ -0,0 +1,100 @@
+package protocol
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/tokenized/smart-contract/pkg/txscript"
+)
+
+// Codec decodes the messages of one version of the protocol.
+type Codec struct {
+	// ProtocolID identifies the version in the payload of a message.
+	ProtocolID uint32
+
+	// TypeMapping holds a mapping of message codes to constructors of the
+	// message types of the version.
+	TypeMapping map[string]func() OpReturnMessage
+}
+
+// Codecs holds the codec of each supported version of the protocol, keyed
+// by protocol ID.
+//
+// New messages are always built with the current ProtocolID. A revision of
+// the protocol adds a codec here, and keeps the codecs of earlier versions
+// so that messages for contracts formed under them can still be decoded.
+var Codecs = map[uint32]Codec{
+	ProtocolID: {
+		ProtocolID:  ProtocolID,
+		TypeMapping: TypeMapping,
+	},
+}
+
+// IsSupported returns true if messages with the protocol ID can be decoded.
+func IsSupported(id uint32) bool {
+	_, ok := Codecs[id]
+	return ok
+}
+
+// decode returns a new message from the OP_RETURN payload, decoded by the
+// codec of its protocol version.
+func decode(codecs map[uint32]Codec, b []byte) (OpReturnMessage, error) {
+	id, err := Version(b)
+	if err != nil {
+		return nil, err
+	}
+
+	codec, ok := codecs[id]
+	if !ok {
+		return nil, fmt.Errorf("Unsupported protocol version : %#08x", id)
+	}
+
+	return codec.Decode(b)
+}
+
+// Decode returns a new message, as an OpReturnMessage, from the OP_RETURN
+// payload.
+//
+// The data pushed by the script is checked against the size of the message
+// before it is read, so a malformed payload is an error.
+func (c Codec) Decode(b []byte) (OpReturnMessage, error) {
+	data, err := Payload(b)
+	if err != nil {
+		return nil, err
+	}
+
+	id := binary.BigEndian.Uint32(data[0:4])
+	if id != c.ProtocolID {
+		return nil, fmt.Errorf("Protocol version mismatch : got %#08x, want %#08x", id, c.ProtocolID)
+	}
+
+	code := string(data[4:headerLen])
+
+	newMessage, ok := c.TypeMapping[code]
+	if !ok {
+		return nil, fmt.Errorf("Unknown code :  %v", code)
+	}
+
+	t := newMessage()
+
+	// a new message has no header, so its size is the size of the data
+	if int64(len(data)) != t.Len() {
+		return nil, fmt.Errorf("Payload size %d for code %s, want %d", len(data), code, t.Len())
+	}
+
+	// messages are read from a single push of the data, however it was
+	// pushed in the script.
+	script, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_RETURN).
+		AddData(data).
+		Script()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := t.Write(script); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
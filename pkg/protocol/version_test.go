@@ -0,0 +1,149 @@
+package protocol
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// versionFixtures holds OP_RETURN payloads built by each version of the
+// protocol. Every codec in Codecs must have fixtures here.
+var versionFixtures = map[uint32][]struct {
+	code string
+	hex  string
+}{
+	0x00000020: {
+		{
+			code: CodeContractOffer,
+			hex:  "6a4cda000000204331005465736c61202d205368617265686f6c6465722041677265656d656e74000000000000000000000000000000000000000000000000000000000000000000000041555300004155530000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004d000000000000000000000000000001",
+		},
+		{
+			code: CodeSend,
+			hex:  "6a320000002054310053484361706d3271737a6e686b7332337a38643833753431733830313968797269336900000000000003e8",
+		},
+		{
+			code: CodeMessage,
+			hex:  "6a4cdc000000204d3100000000005c2aad80303048656c6c6f20576f726c64000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+		},
+	},
+}
+
+func decodeFixture(t testing.TB, s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return b
+}
+
+func TestNew_versions(t *testing.T) {
+	for id := range Codecs {
+		fixtures, ok := versionFixtures[id]
+		if !ok || len(fixtures) == 0 {
+			t.Errorf("no fixtures for protocol version %#08x", id)
+			continue
+		}
+
+		for _, f := range fixtures {
+			m, err := New(decodeFixture(t, f.hex))
+			if err != nil {
+				t.Errorf("version %#08x, code %s : %v", id, f.code, err)
+				continue
+			}
+
+			if m.Type() != f.code {
+				t.Errorf("version %#08x : got code %s, want %s", id, m.Type(), f.code)
+			}
+
+			if m.Protocol() != id {
+				t.Errorf("got version %#08x, want %#08x", m.Protocol(), id)
+			}
+		}
+	}
+}
+
+func TestNew_unsupportedVersion(t *testing.T) {
+	b := decodeFixture(t, versionFixtures[ProtocolID][1].hex)
+
+	// protocol ID of a short header starts at byte 2
+	b[5] = 0x7f
+
+	if IsSupported(0x0000007f) {
+		t.Fatal("version 0x7f is supported")
+	}
+
+	if _, err := New(b); err == nil {
+		t.Fatal("decoded message of an unsupported version")
+	}
+}
+
+func TestNew_dispatch(t *testing.T) {
+	const next uint32 = 0x00000021
+
+	codecs := map[uint32]Codec{
+		ProtocolID: Codecs[ProtocolID],
+		next: {
+			ProtocolID: next,
+			TypeMapping: map[string]func() OpReturnMessage{
+				CodeSend: func() OpReturnMessage { return &Send{} },
+			},
+		},
+	}
+
+	send := decodeFixture(t, versionFixtures[ProtocolID][1].hex)
+	send[5] = byte(next)
+
+	m, err := decode(codecs, send)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Protocol() != next {
+		t.Errorf("got version %#08x, want %#08x", m.Protocol(), next)
+	}
+
+	// the next version has no Message, so it must not fall back to the
+	// codec of the current version.
+	message := decodeFixture(t, versionFixtures[ProtocolID][2].hex)
+	message[6] = byte(next)
+
+	if _, err := decode(codecs, message); err == nil {
+		t.Fatal("decoded a message unknown to its version")
+	}
+}
+
+func TestVersion(t *testing.T) {
+	tests := []struct {
+		name string
+		hex  string
+		want uint32
+	}{
+		{
+			name: "short header",
+			hex:  versionFixtures[ProtocolID][1].hex,
+			want: ProtocolID,
+		},
+		{
+			name: "OP_PUSHDATA1 header",
+			hex:  versionFixtures[ProtocolID][2].hex,
+			want: ProtocolID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Version(decodeFixture(t, tt.hex))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != tt.want {
+				t.Errorf("got %#08x, want %#08x", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := Version([]byte{0x6a, 0x02}); err == nil {
+		t.Error("got version from a short payload")
+	}
+}
@@ -1,9 +0,0 @@
-package protocol
-
-const (
-	// VoteLogicStandard identifies a vote as having a standard count.
-	VoteLogicStandard = '0'
-
-	// VoteLogicWeighted identifies a vote as using a weighted count.
-	VoteLogicWeighted = '1'
-)
@@ -9,18 +9,29 @@ import (
 
 // BlockHandler exists to handle the Ping command.
 type BlockHandler struct {
-	Config       Config
-	BlockService *BlockService
-	Listener     Listener
+	Config              Config
+	BlockService        *BlockService
+	Mempool             *Mempool
+	Outbound            *Outbound
+	Listener            Listener
+	DoubleSpendListener Listener
 }
 
 // NewBlockHandler returns a new BlockHandler with the given Config.
-func NewBlockHandler(config Config, blockService *BlockService, listener Listener) BlockHandler {
+func NewBlockHandler(config Config,
+	blockService *BlockService,
+	mempool *Mempool,
+	outbound *Outbound,
+	listener Listener,
+	doubleSpendListener Listener) BlockHandler {
 
 	return BlockHandler{
-		Config:       config,
-		BlockService: blockService,
-		Listener:     listener,
+		Config:              config,
+		BlockService:        blockService,
+		Mempool:             mempool,
+		Outbound:            outbound,
+		Listener:            listener,
+		DoubleSpendListener: doubleSpendListener,
 	}
 }
 
@@ -50,10 +61,29 @@ func (h BlockHandler) handle(ctx context.Context,
 		return nil, nil
 	}
 
+	// confirmed txs leave the mempool, and any unconfirmed txs that
+	// conflict with them are now double spent.
+	conflicts := h.Mempool.Confirm(b.Transactions)
+	h.Mempool.Prune()
+
+	// txs we broadcast no longer need to be announced
+	h.Outbound.Mined(b.Transactions)
+
+	if h.DoubleSpendListener != nil {
+		for _, tx := range conflicts {
+			h.DoubleSpendListener.Handle(ctx, tx)
+		}
+	}
+
 	prevBlock, err := h.BlockService.Read(ctx, b.Header.PrevBlock)
+	if err == ErrBlockNotFound {
+		// we have missed blocks, so sync the headers from the last seen
+		// block again.
+		out := newGetHeaders(h.BlockService, h.Config.Net)
+		return []wire.Message{out}, nil
+	}
+
 	if err != nil {
-		// TODO we don't have the block, so we should fetch it if this was
-		// a "not found" error. Probably worth a full sync again.
 		return nil, err
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/tokenized/smart-contract/pkg/netparams"
 	"github.com/tokenized/smart-contract/pkg/spvnode/logger"
 )
 
@@ -21,6 +22,10 @@ type BlockService struct {
 	Blocks          map[chainhash.Hash]Block
 	State           *State
 	synced          bool
+
+	// peerHeight is the height of the chain reported by the peer when
+	// connecting.
+	peerHeight int32
 }
 
 func NewBlockService(br BlockRepository, sr StateRepository) BlockService {
@@ -84,7 +89,7 @@ func (b *BlockService) Write(ctx context.Context, block Block) error {
 	return nil
 }
 
-func (b BlockService) LastSeen(ctx context.Context,
+func (b *BlockService) LastSeen(ctx context.Context,
 	block Block) (*Block, error) {
 
 	if b.State != nil && block.Height <= b.State.LastSeen.Height {
@@ -146,6 +151,50 @@ func (b BlockService) prune(ctx context.Context, max int32) error {
 	return nil
 }
 
+// Bootstrap sets the last seen block to a checkpoint. Headers are synced
+// from the checkpoint, rather than from the genesis block.
+//
+// This is only used on first run, when there is no state.
+func (b *BlockService) Bootstrap(ctx context.Context,
+	c netparams.Checkpoint) error {
+
+	block := Block{
+		Hash:   c.Hash.String(),
+		Height: c.Height,
+	}
+
+	if err := b.Write(ctx, block); err != nil {
+		return err
+	}
+
+	state := State{
+		LastSeen: block,
+	}
+
+	if err := b.StateRepository.Write(ctx, state); err != nil {
+		return err
+	}
+
+	b.State = &state
+
+	log := logger.NewLoggerFromContext(ctx).Sugar()
+	log.Infof("Starting from checkpoint hash=%v height=%v",
+		block.Hash,
+		block.Height)
+
+	return nil
+}
+
+// progress returns the percentage of the chain of the peer that has been
+// synced, up to the given height.
+func (b BlockService) progress(height int32) float64 {
+	if b.peerHeight <= 0 || height >= b.peerHeight {
+		return 100
+	}
+
+	return float64(height) * 100 / float64(b.peerHeight)
+}
+
 func (b *BlockService) LoadState(ctx context.Context) (*State, error) {
 	state, err := b.StateRepository.Read(ctx, "")
 
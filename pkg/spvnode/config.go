@@ -2,20 +2,31 @@ package spvnode
 
 import (
 	"fmt"
+	"net"
 	"strings"
+
+	"github.com/tokenized/smart-contract/pkg/netparams"
 )
 
 // Config holds all configuration for the running service.
 type Config struct {
 	NodeAddress string
 	UserAgent   string
+	Net         *netparams.Params
 }
 
 // NewConfig returns a new Config populated from environment variables.
-func NewConfig(host, useragent string) Config {
+//
+// If the host has no port, the default port of the network is used.
+func NewConfig(host, useragent string, params *netparams.Params) Config {
+	if _, _, err := net.SplitHostPort(host); err != nil {
+		host = net.JoinHostPort(host, params.DefaultPort)
+	}
+
 	c := Config{
 		NodeAddress: host,
 		UserAgent:   useragent,
+		Net:         params,
 	}
 
 	return c
@@ -28,6 +39,7 @@ func (c Config) String() string {
 	pairs := map[string]string{
 		"NodeAddress": c.NodeAddress,
 		"UserAgent":   c.UserAgent,
+		"Net":         c.Net.String(),
 	}
 
 	parts := []string{}
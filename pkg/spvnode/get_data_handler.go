@@ -0,0 +1,69 @@
+package spvnode
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tokenized/smart-contract/pkg/wire"
+)
+
+// GetDataHandler exists to handle the GetData command.
+type GetDataHandler struct {
+	Config   Config
+	Outbound *Outbound
+}
+
+// NewGetDataHandler returns a new GetDataHandler with the given Config.
+func NewGetDataHandler(config Config, outbound *Outbound) GetDataHandler {
+	return GetDataHandler{
+		Config:   config,
+		Outbound: outbound,
+	}
+}
+
+// Handle implments the Handler interface.
+//
+// This function handles type conversion and delegates the the contrete
+// handler.
+func (h GetDataHandler) Handle(ctx context.Context,
+	m wire.Message) ([]wire.Message, error) {
+
+	in, ok := m.(*wire.MsgGetData)
+	if !ok {
+		return nil, errors.New("Could not assert as *wire.MsgGetData")
+	}
+
+	return h.handle(ctx, in)
+}
+
+// handle processes the MsgGetData.
+//
+// The peer is requesting txs we announced. Each tx we are broadcasting is
+// sent, and anything else is reported as not found.
+func (h GetDataHandler) handle(ctx context.Context,
+	m *wire.MsgGetData) ([]wire.Message, error) {
+
+	messages := []wire.Message{}
+	notFound := wire.NewMsgNotFound()
+
+	for _, v := range m.InvList {
+		if v.Type != wire.InvTypeTx {
+			notFound.AddInvVect(v)
+			continue
+		}
+
+		tx, ok := h.Outbound.Request(v.Hash)
+		if !ok {
+			notFound.AddInvVect(v)
+			continue
+		}
+
+		messages = append(messages, tx)
+	}
+
+	if len(notFound.InvList) > 0 {
+		messages = append(messages, notFound)
+	}
+
+	return messages, nil
+}
@@ -19,14 +19,18 @@ type Listener interface {
 // newCommandHandlers returns a mapping of commands and Handler's.
 func newCommandHandlers(config Config,
 	blockService *BlockService,
+	mempool *Mempool,
+	outbound *Outbound,
 	listeners map[string]Listener) map[string]CommandHandler {
 
 	return map[string]CommandHandler{
 		wire.CmdPing:       NewPingHandler(config),
-		wire.CmdVersion:    NewVersionHandler(config),
-		wire.CmdInv:        NewInvHandler(config),
-		wire.CmdTx:         NewTXHandler(config, blockService, listeners[ListenerTX]),
-		wire.CmdBlock:      NewBlockHandler(config, blockService, listeners[ListenerBlock]),
+		wire.CmdVersion:    NewVersionHandler(config, blockService),
+		wire.CmdVerAck:     NewVerAckHandler(config, blockService),
+		wire.CmdInv:        NewInvHandler(config, outbound),
+		wire.CmdGetData:    NewGetDataHandler(config, outbound),
+		wire.CmdTx:         NewTXHandler(config, blockService, mempool, listeners[ListenerTX], listeners[ListenerDoubleSpend]),
+		wire.CmdBlock:      NewBlockHandler(config, blockService, mempool, outbound, listeners[ListenerBlock], listeners[ListenerDoubleSpend]),
 		wire.CmdGetHeaders: NewGetHeadersHandler(config, blockService),
 		wire.CmdHeaders:    NewHeadersHandler(config, blockService),
 	}
@@ -3,6 +3,7 @@ package spvnode
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/tokenized/smart-contract/pkg/spvnode/logger"
 	"github.com/tokenized/smart-contract/pkg/wire"
@@ -10,7 +11,11 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 )
 
-// HeadersHandler exists to handle the Ping command.
+// ErrCheckpointMismatch is returned when a header does not match the
+// checkpoint at its height.
+var ErrCheckpointMismatch = errors.New("Header does not match checkpoint")
+
+// HeadersHandler exists to handle the Headers command.
 type HeadersHandler struct {
 	Config       Config
 	BlockService *BlockService
@@ -48,6 +53,8 @@ func (h HeadersHandler) handle(ctx context.Context,
 	m *wire.MsgHeaders) ([]wire.Message, error) {
 
 	if len(m.Headers) == 0 {
+		// the peer has no headers after ours, so we are at the tip
+		h.BlockService.synced = true
 		return nil, nil
 	}
 
@@ -75,10 +82,18 @@ func (h HeadersHandler) handle(ctx context.Context,
 			continue
 		}
 
+		height := previous.Height + 1
+
+		// a header that does not match a checkpoint is on another chain
+		if !h.Config.Net.VerifyCheckpoint(height, hash) {
+			return nil, fmt.Errorf("%v : height=%v hash=%v",
+				ErrCheckpointMismatch, height, hash)
+		}
+
 		b := Block{
 			Hash:      hash.String(),
 			PrevBlock: header.PrevBlock.String(),
-			Height:    previous.Height + 1,
+			Height:    height,
 		}
 
 		if getdata := h.buildGetDataForBlock(ctx, hash); getdata != nil {
@@ -101,7 +116,10 @@ func (h HeadersHandler) handle(ctx context.Context,
 	}
 
 	log := logger.NewLoggerFromContext(ctx).Sugar()
-	log.Infof("Latest block hash=%v height=%v", max.Hash, max.Height)
+	log.Infof("Latest block hash=%v height=%v progress=%.2f%%",
+		max.Hash,
+		max.Height,
+		h.BlockService.progress(max.Height))
 
 	// prune the blocks map, we only need a few recent one
 	if err := h.BlockService.prune(ctx, max.Height); err != nil {
@@ -109,19 +127,15 @@ func (h HeadersHandler) handle(ctx context.Context,
 		log.Errorf("Failed to prune : %v", err)
 	}
 
-	if len(m.Headers) > 1 {
-		// get more headers if we need them
-		last := m.Headers[len(m.Headers)-1]
-		lastHash := last.BlockHash()
-
-		out := wire.NewMsgGetHeaders()
-		out.BlockLocatorHashes = []*chainhash.Hash{
-			&lastHash,
-		}
-
-		outs = append(outs, out)
+	if len(m.Headers) < wire.MaxBlockHeadersPerMsg {
+		// a partial batch means the peer has no more headers to send
+		h.BlockService.synced = true
+		return outs, nil
 	}
 
+	// get more headers, following on from the last seen block
+	outs = append(outs, newGetHeaders(h.BlockService, h.Config.Net))
+
 	return outs, nil
 }
 
@@ -10,13 +10,15 @@ import (
 
 // InvHandler exists to handle the Ping command.
 type InvHandler struct {
-	Config Config
+	Config   Config
+	Outbound *Outbound
 }
 
 // NewInvHandler returns a new InvHandler with the given Config.
-func NewInvHandler(config Config) InvHandler {
+func NewInvHandler(config Config, outbound *Outbound) InvHandler {
 	return InvHandler{
-		Config: config,
+		Config:   config,
+		Outbound: outbound,
 	}
 }
 
@@ -46,6 +48,11 @@ func (h InvHandler) handle(ctx context.Context,
 	for _, v := range m.InvList {
 		switch v.Type {
 		case wire.InvTypeTx:
+			if h.Outbound.Has(v.Hash) {
+				// we sent this tx, we don't need it back
+				continue
+			}
+
 			out := wire.NewMsgGetData()
 			out.AddInvVect(v)
 			messages = append(messages, out)
@@ -0,0 +1,108 @@
+package spvnode
+
+import (
+	"github.com/tokenized/smart-contract/pkg/netparams"
+	"github.com/tokenized/smart-contract/pkg/wire"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+const (
+	// locatorDenseBlocks is the number of most recent blocks added to a
+	// block locator one by one, before stepping back exponentially.
+	locatorDenseBlocks = 10
+)
+
+// blockLocator returns a block locator for the chain ending at the last
+// seen block.
+//
+// The locator holds the most recent blocks, then blocks at exponentially
+// increasing distances back down the chain, then the checkpoints below
+// those, and finally the genesis block. A peer uses the first hash it
+// knows to find where our chain meets its own, even after a reorg.
+func (b BlockService) blockLocator(params *netparams.Params) []*chainhash.Hash {
+	locator := []*chainhash.Hash{}
+
+	// the lowest height in the locator so far
+	lowest := int32(-1)
+
+	if b.State != nil && len(b.State.LastSeen.Hash) > 0 {
+		block := b.State.LastSeen
+		step := 1
+
+		for len(locator) < wire.MaxBlockLocatorsPerMsg-1 {
+			hash, err := chainhash.NewHashFromStr(block.Hash)
+			if err != nil {
+				break
+			}
+
+			locator = append(locator, hash)
+			lowest = block.Height
+
+			if len(locator) >= locatorDenseBlocks {
+				step *= 2
+			}
+
+			prev, ok := b.ancestor(block, step)
+			if !ok {
+				break
+			}
+
+			block = *prev
+		}
+	}
+
+	// checkpoints are in order of height, and the locator must go from
+	// highest to lowest.
+	for i := len(params.Checkpoints) - 1; i >= 0; i-- {
+		if len(locator) >= wire.MaxBlockLocatorsPerMsg-1 {
+			break
+		}
+
+		c := params.Checkpoints[i]
+
+		if lowest >= 0 && c.Height >= lowest {
+			continue
+		}
+
+		hash := c.Hash
+		locator = append(locator, &hash)
+	}
+
+	if lowest != 0 {
+		genesis := params.GenesisHash
+		locator = append(locator, &genesis)
+	}
+
+	return locator
+}
+
+// ancestor returns the block the given number of blocks before a block,
+// following the chain of blocks held in memory. false is returned if the
+// chain is not held back that far.
+func (b BlockService) ancestor(block Block, n int) (*Block, bool) {
+	for i := 0; i < n; i++ {
+		hash, err := chainhash.NewHashFromStr(block.PrevBlock)
+		if err != nil {
+			return nil, false
+		}
+
+		prev, ok := b.Blocks[*hash]
+		if !ok {
+			return nil, false
+		}
+
+		block = prev
+	}
+
+	return &block, true
+}
+
+// newGetHeaders returns a MsgGetHeaders asking for the headers that follow
+// the last seen block.
+func newGetHeaders(b *BlockService, params *netparams.Params) *wire.MsgGetHeaders {
+	out := wire.NewMsgGetHeaders()
+	out.BlockLocatorHashes = b.blockLocator(params)
+
+	return out
+}
@@ -0,0 +1,100 @@
+package spvnode
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tokenized/smart-contract/pkg/netparams"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// newTestChain returns a BlockService holding a chain of blocks from the
+// given height to the tip, with the tip as the last seen block.
+func newTestChain(from, tip int32) (*BlockService, map[int32]chainhash.Hash) {
+	b := &BlockService{
+		Blocks: map[chainhash.Hash]Block{},
+	}
+
+	hashes := map[int32]chainhash.Hash{}
+
+	var prev Block
+
+	for height := from; height <= tip; height++ {
+		hash := chainhash.Hash{byte(height), byte(height >> 8), 0xff}
+		hashes[height] = hash
+
+		block := Block{
+			Hash:      hash.String(),
+			PrevBlock: prev.Hash,
+			Height:    height,
+		}
+
+		b.Blocks[hash] = block
+		prev = block
+	}
+
+	b.State = &State{
+		LastSeen: prev,
+	}
+
+	return b, hashes
+}
+
+func TestBlockService_blockLocator(t *testing.T) {
+	b, hashes := newTestChain(100, 150)
+
+	params := netparams.RegTest
+	params.Checkpoints = []netparams.Checkpoint{
+		{Height: 50, Hash: chainhash.Hash{50}},
+		{Height: 100, Hash: hashes[100]},
+		{Height: 120, Hash: hashes[120]},
+	}
+
+	got := b.blockLocator(&params)
+
+	// 10 blocks back from the tip one at a time, then doubling steps
+	heights := []int32{150, 149, 148, 147, 146, 145, 144, 143, 142, 141,
+		139, 135, 127, 111}
+
+	want := []chainhash.Hash{}
+	for _, height := range heights {
+		want = append(want, hashes[height])
+	}
+
+	// checkpoints below the lowest block, then genesis
+	want = append(want, hashes[100], chainhash.Hash{50}, params.GenesisHash)
+
+	gotHashes := []chainhash.Hash{}
+	for _, h := range got {
+		gotHashes = append(gotHashes, *h)
+	}
+
+	if !reflect.DeepEqual(gotHashes, want) {
+		t.Errorf("got\n%v\nwant\n%v", gotHashes, want)
+	}
+}
+
+func TestBlockService_blockLocatorEmpty(t *testing.T) {
+	b := &BlockService{
+		Blocks: map[chainhash.Hash]Block{},
+	}
+
+	params := netparams.MainNet
+
+	got := b.blockLocator(&params)
+
+	// every checkpoint, newest first, then genesis
+	if len(got) != len(params.Checkpoints)+1 {
+		t.Fatalf("got %v hashes, want %v", len(got), len(params.Checkpoints)+1)
+	}
+
+	latest := params.LatestCheckpoint()
+	if *got[0] != latest.Hash {
+		t.Errorf("got %v, want %v", got[0], latest.Hash)
+	}
+
+	if *got[len(got)-1] != params.GenesisHash {
+		t.Errorf("got %v, want %v", got[len(got)-1], params.GenesisHash)
+	}
+}
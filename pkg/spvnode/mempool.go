@@ -0,0 +1,192 @@
+package spvnode
+
+import (
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/tokenized/smart-contract/pkg/wire"
+)
+
+const (
+	// maxMempoolAge is how long an unconfirmed transaction is kept in the
+	// mempool before it is forgotten.
+	maxMempoolAge = time.Hour * 72
+)
+
+// mempoolEntry is an unconfirmed transaction held in the Mempool.
+type mempoolEntry struct {
+	tx    *wire.MsgTx
+	added time.Time
+}
+
+// Mempool is a view of the unconfirmed transactions seen by the node, and
+// the outpoints they spend.
+//
+// A transaction that spends an outpoint already spent by another
+// transaction, in the mempool or in a block, is a double spend. Both sides
+// of the conflict are recorded as double spent.
+type Mempool struct {
+	mu           sync.Mutex
+	txs          map[chainhash.Hash]mempoolEntry
+	spends       map[wire.OutPoint]chainhash.Hash
+	doubleSpends map[chainhash.Hash]time.Time
+}
+
+// NewMempool returns a new, empty Mempool.
+func NewMempool() *Mempool {
+	return &Mempool{
+		txs:          map[chainhash.Hash]mempoolEntry{},
+		spends:       map[wire.OutPoint]chainhash.Hash{},
+		doubleSpends: map[chainhash.Hash]time.Time{},
+	}
+}
+
+// Add adds an unconfirmed transaction to the mempool.
+//
+// The transactions already in the mempool that spend any of the same
+// outpoints are returned. If any are returned, they and the new
+// transaction are all marked as double spent.
+func (m *Mempool) Add(tx *wire.MsgTx) []*wire.MsgTx {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	hash := tx.TxHash()
+
+	if _, ok := m.txs[hash]; ok {
+		return nil
+	}
+
+	now := time.Now()
+
+	m.txs[hash] = mempoolEntry{
+		tx:    tx,
+		added: now,
+	}
+
+	conflicts := []*wire.MsgTx{}
+
+	for _, in := range tx.TxIn {
+		spender, ok := m.spends[in.PreviousOutPoint]
+		if !ok {
+			m.spends[in.PreviousOutPoint] = hash
+			continue
+		}
+
+		if spender == hash {
+			continue
+		}
+
+		m.doubleSpends[spender] = now
+
+		if e, ok := m.txs[spender]; ok {
+			conflicts = append(conflicts, e.tx)
+		}
+	}
+
+	if len(conflicts) > 0 {
+		m.doubleSpends[hash] = now
+	}
+
+	return conflicts
+}
+
+// Has returns true if the transaction is in the mempool.
+func (m *Mempool) Has(hash chainhash.Hash) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	_, ok := m.txs[hash]
+
+	return ok
+}
+
+// IsDoubleSpent returns true if the transaction conflicts with another
+// transaction that has been seen, confirmed or not.
+func (m *Mempool) IsDoubleSpent(hash chainhash.Hash) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	_, ok := m.doubleSpends[hash]
+
+	return ok
+}
+
+// Confirm removes the transactions in a block from the mempool.
+//
+// Any unconfirmed transactions spending the same outpoints as a confirmed
+// transaction can never confirm. They are removed, marked as double spent
+// and returned.
+func (m *Mempool) Confirm(txs []*wire.MsgTx) []*wire.MsgTx {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	conflicts := []*wire.MsgTx{}
+
+	for _, tx := range txs {
+		hash := tx.TxHash()
+
+		for _, in := range tx.TxIn {
+			spender, ok := m.spends[in.PreviousOutPoint]
+			if !ok {
+				continue
+			}
+
+			delete(m.spends, in.PreviousOutPoint)
+
+			if spender == hash {
+				continue
+			}
+
+			m.doubleSpends[spender] = now
+
+			if e, ok := m.txs[spender]; ok {
+				conflicts = append(conflicts, e.tx)
+				m.remove(spender)
+			}
+		}
+
+		delete(m.txs, hash)
+	}
+
+	return conflicts
+}
+
+// Prune removes transactions, and double spend records, that are older
+// than maxMempoolAge.
+func (m *Mempool) Prune() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	cutoff := time.Now().Add(-maxMempoolAge)
+
+	for hash, e := range m.txs {
+		if e.added.Before(cutoff) {
+			m.remove(hash)
+		}
+	}
+
+	for hash, t := range m.doubleSpends {
+		if t.Before(cutoff) {
+			delete(m.doubleSpends, hash)
+		}
+	}
+}
+
+// remove deletes a transaction, and the outpoints it holds, from the
+// mempool. The caller must hold the lock.
+func (m *Mempool) remove(hash chainhash.Hash) {
+	e, ok := m.txs[hash]
+	if !ok {
+		return
+	}
+
+	for _, in := range e.tx.TxIn {
+		if m.spends[in.PreviousOutPoint] == hash {
+			delete(m.spends, in.PreviousOutPoint)
+		}
+	}
+
+	delete(m.txs, hash)
+}
@@ -0,0 +1,99 @@
+package spvnode
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/tokenized/smart-contract/pkg/wire"
+)
+
+func newSpendingTx(op wire.OutPoint, value int64) *wire.MsgTx {
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(&op, nil))
+	tx.AddTxOut(wire.NewTxOut(value, nil))
+
+	return tx
+}
+
+func TestMempool_Add(t *testing.T) {
+	op := wire.OutPoint{
+		Hash:  chainhash.Hash{1},
+		Index: 0,
+	}
+
+	m := NewMempool()
+
+	first := newSpendingTx(op, 1000)
+	if conflicts := m.Add(first); len(conflicts) != 0 {
+		t.Fatalf("got %v conflicts, want 0", len(conflicts))
+	}
+
+	if m.IsDoubleSpent(first.TxHash()) {
+		t.Fatalf("got double spent, want not double spent")
+	}
+
+	// adding the same tx again is not a conflict
+	if conflicts := m.Add(first); len(conflicts) != 0 {
+		t.Fatalf("got %v conflicts, want 0", len(conflicts))
+	}
+
+	second := newSpendingTx(op, 900)
+	conflicts := m.Add(second)
+	if len(conflicts) != 1 {
+		t.Fatalf("got %v conflicts, want 1", len(conflicts))
+	}
+
+	if conflicts[0].TxHash() != first.TxHash() {
+		t.Errorf("got conflict %s, want %s", conflicts[0].TxHash(), first.TxHash())
+	}
+
+	for _, tx := range []*wire.MsgTx{first, second} {
+		if !m.IsDoubleSpent(tx.TxHash()) {
+			t.Errorf("got not double spent, want double spent : %s", tx.TxHash())
+		}
+	}
+}
+
+func TestMempool_Confirm(t *testing.T) {
+	op := wire.OutPoint{
+		Hash:  chainhash.Hash{2},
+		Index: 1,
+	}
+
+	m := NewMempool()
+
+	unconfirmed := newSpendingTx(op, 1000)
+	m.Add(unconfirmed)
+
+	// a tx we never saw is confirmed, spending the same outpoint
+	confirmed := newSpendingTx(op, 800)
+
+	conflicts := m.Confirm([]*wire.MsgTx{confirmed})
+	if len(conflicts) != 1 {
+		t.Fatalf("got %v conflicts, want 1", len(conflicts))
+	}
+
+	if !m.IsDoubleSpent(unconfirmed.TxHash()) {
+		t.Errorf("got not double spent, want double spent")
+	}
+
+	if m.Has(unconfirmed.TxHash()) {
+		t.Errorf("got tx in mempool, want removed")
+	}
+
+	// confirming a tx that was in the mempool is not a conflict
+	other := newSpendingTx(wire.OutPoint{Hash: chainhash.Hash{3}}, 500)
+	m.Add(other)
+
+	if conflicts := m.Confirm([]*wire.MsgTx{other}); len(conflicts) != 0 {
+		t.Fatalf("got %v conflicts, want 0", len(conflicts))
+	}
+
+	if m.Has(other.TxHash()) {
+		t.Errorf("got tx in mempool, want removed")
+	}
+
+	if m.IsDoubleSpent(other.TxHash()) {
+		t.Errorf("got double spent, want not double spent")
+	}
+}
@@ -5,8 +5,10 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"net"
+	"strconv"
 	"sync"
 	"time"
 
@@ -14,18 +16,19 @@ import (
 	"github.com/tokenized/smart-contract/pkg/storage"
 	"github.com/tokenized/smart-contract/pkg/wire"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"go.uber.org/multierr"
 )
 
 const (
-	MainNetBch wire.BitcoinNet = 0xe8f3e1e3
-	TestNetBch wire.BitcoinNet = 0xf4f3e5f4
-	RegTestBch wire.BitcoinNet = 0xfabfb5da
-
-	ListenerTX    = "TX"
-	ListenerBlock = "block"
-
-	firstBCHBlock = 478559
+	ListenerTX          = "TX"
+	ListenerBlock       = "block"
+	ListenerDoubleSpend = "doubleSpend"
+
+	// ListenerBroadcastFallback is given txs that have been announced
+	// several times without the peer requesting them, so they can be sent
+	// another way.
+	ListenerBroadcastFallback = "broadcastFallback"
 )
 
 type Node struct {
@@ -34,9 +37,15 @@ type Node struct {
 	conn         net.Conn
 	messages     chan wire.Message
 	BlockService *BlockService
+	Mempool      *Mempool
+	Outbound     *Outbound
 	Listeners    map[string]Listener
 }
 
+// ErrNotConnected is returned when a message can't be sent because there
+// is no connection to the peer.
+var ErrNotConnected = errors.New("Not connected to peer")
+
 func NewNode(config Config, store storage.Storage) Node {
 	stateRepo := NewStateRepository(store)
 	blockRepo := NewBlockRepository(store)
@@ -46,6 +55,8 @@ func NewNode(config Config, store storage.Storage) Node {
 		Config:       config,
 		messages:     make(chan wire.Message),
 		BlockService: &blockService,
+		Mempool:      NewMempool(),
+		Outbound:     NewOutbound(),
 		Listeners:    map[string]Listener{},
 	}
 
@@ -56,21 +67,17 @@ func (n *Node) Start() error {
 	ctx := logger.NewContext()
 	log := logger.NewLoggerFromContext(ctx).Sugar()
 
-	n.Handlers = newCommandHandlers(n.Config, n.BlockService, n.Listeners)
+	n.Handlers = newCommandHandlers(n.Config,
+		n.BlockService,
+		n.Mempool,
+		n.Outbound,
+		n.Listeners)
 
 	state, err := n.BlockService.LoadState(ctx)
 	if err != nil {
 		return err
 	}
 
-	if state.LastSeen.Height > 0 {
-		// This is not the first run.
-		//
-		// On first run we don't broadcast to listeners to avoid publishing
-		// the entire blockchain.
-		n.BlockService.synced = true
-	}
-
 	log.Infof("Loaded initial state : %+v", *state)
 
 	// load any blocks we have into the cache.
@@ -80,6 +87,21 @@ func (n *Node) Start() error {
 
 	log.Infof("Loaded %v blocks", len(n.BlockService.Blocks))
 
+	if len(state.LastSeen.Hash) > 0 {
+		// This is not the first run.
+		//
+		// On first run we don't broadcast to listeners to avoid publishing
+		// the entire blockchain.
+		n.BlockService.synced = true
+	} else {
+		// On first run, headers are synced from the latest checkpoint.
+		checkpoint := n.Config.Net.LatestCheckpoint()
+
+		if err := n.BlockService.Bootstrap(ctx, checkpoint); err != nil {
+			return err
+		}
+	}
+
 	if err := n.connect(); err != nil {
 		return err
 	}
@@ -88,7 +110,7 @@ func (n *Node) Start() error {
 	defer n.close()
 
 	wg := sync.WaitGroup{}
-	wg.Add(2)
+	wg.Add(3)
 
 	go func() {
 		defer wg.Done()
@@ -104,6 +126,13 @@ func (n *Node) Start() error {
 		n.readChannel()
 	}()
 
+	go func() {
+		defer wg.Done()
+
+		// announce txs that have not been mined yet
+		n.rebroadcast()
+	}()
+
 	// kick off the connection handshaking process by sending a version
 	// message.
 	if err := n.handshake(); err != nil {
@@ -149,7 +178,7 @@ func (n Node) readPeer() {
 		ctx := logger.NewContext()
 
 		// read new messages, blocking
-		m, _, err := wire.ReadMessage(n.conn, wire.ProtocolVersion, MainNetBch)
+		m, _, err := wire.ReadMessage(n.conn, wire.ProtocolVersion, n.Config.Net.Net)
 		if err != nil {
 			log := logger.NewLoggerFromContext(ctx)
 			log.Error(err.Error())
@@ -182,10 +211,6 @@ func (n Node) handle(ctx context.Context,
 	}
 
 	if out == nil {
-		if _, ok := m.(*wire.MsgHeaders); ok {
-			n.BlockService.synced = true
-		}
-
 		return nil
 	}
 
@@ -206,6 +231,89 @@ func (n *Node) RegisterListener(name string, listener Listener) {
 	n.Listeners[name] = listener
 }
 
+// BroadcastTX announces a tx to the peer. The tx is sent when the peer
+// requests it, and announced again until it is seen in a block.
+func (n Node) BroadcastTX(ctx context.Context, tx *wire.MsgTx) error {
+	if n.conn == nil {
+		return ErrNotConnected
+	}
+
+	n.Outbound.Add(tx)
+
+	return n.Queue(ctx, newTXInv(tx))
+}
+
+// IsAnnounced returns true if the tx has been announced to the peer, and
+// has not been mined yet.
+func (n Node) IsAnnounced(hash chainhash.Hash) bool {
+	return n.Outbound.Has(hash)
+}
+
+// IsRequested returns true if the peer has requested a tx announced to it.
+func (n Node) IsRequested(hash chainhash.Hash) bool {
+	return n.Outbound.IsRequested(hash)
+}
+
+// CancelTX stops announcing a tx, and refuses it to the peer, as it must
+// not be sent.
+func (n Node) CancelTX(hash chainhash.Hash) {
+	n.Outbound.Remove(hash)
+}
+
+// rebroadcast announces txs that have not been mined, and hands txs the
+// peer is not requesting to the fallback listener.
+//
+// This is a blocking function that will run forever, so it should be run
+// in a goroutine.
+func (n Node) rebroadcast() {
+	ticker := time.NewTicker(rebroadcastInterval / 4)
+	defer ticker.Stop()
+
+	for now := range ticker.C {
+		ctx := logger.NewContext()
+		log := logger.NewLoggerFromContext(ctx).Sugar()
+
+		announce, fallback := n.Outbound.Due(now)
+
+		for _, tx := range announce {
+			log.Infof("Rebroadcasting tx %s", tx.TxHash())
+
+			if err := n.Queue(ctx, newTXInv(tx)); err != nil {
+				log.Errorf("Failed to rebroadcast tx %s : %v", tx.TxHash(), err)
+			}
+		}
+
+		listener, ok := n.Listeners[ListenerBroadcastFallback]
+		if !ok {
+			continue
+		}
+
+		for _, tx := range fallback {
+			log.Warnf("Peer has not requested tx %s", tx.TxHash())
+
+			if err := listener.Handle(ctx, tx); err != nil {
+				log.Errorf("Fallback failed for tx %s : %v", tx.TxHash(), err)
+			}
+		}
+	}
+}
+
+// newTXInv returns an inv message announcing a tx.
+func newTXInv(tx *wire.MsgTx) *wire.MsgInv {
+	hash := tx.TxHash()
+
+	inv := wire.NewMsgInv()
+	inv.AddInvVect(wire.NewInvVect(wire.InvTypeTx, &hash))
+
+	return inv
+}
+
+// IsDoubleSpent returns true if the tx has been seen to conflict with
+// another tx.
+func (n Node) IsDoubleSpent(hash chainhash.Hash) bool {
+	return n.Mempool.IsDoubleSpent(hash)
+}
+
 // handshake starts the handshake process.
 //
 // Sending a version message to the peer will fire off is enough as the
@@ -223,11 +331,13 @@ func (n *Node) RegisterListener(name string, listener Listener) {
 func (n Node) handshake() error {
 	ctx := logger.NewContext()
 
+	port := n.port()
+
 	// my local. This doesn't matter, we don't accept inboound connections.
-	local := wire.NewNetAddressIPPort(net.IPv4(127, 0, 0, 1), 9333, 0)
+	local := wire.NewNetAddressIPPort(net.IPv4(127, 0, 0, 1), port, 0)
 
 	// build the address of the remote
-	remote := wire.NewNetAddressIPPort(net.IPv4(127, 0, 0, 1), 8333, 0)
+	remote := wire.NewNetAddressIPPort(net.IPv4(127, 0, 0, 1), port, 0)
 
 	lastSeen := n.BlockService.State.LastSeen
 	msg := wire.NewMsgVersion(remote, local, n.nonce(), lastSeen.Height)
@@ -274,7 +384,7 @@ func (n Node) sendAsync(ctx context.Context, m wire.Message) error {
 	var buf bytes.Buffer
 
 	// build the message to send
-	_, err := wire.WriteMessageN(&buf, m, wire.ProtocolVersion, MainNetBch)
+	_, err := wire.WriteMessageN(&buf, m, wire.ProtocolVersion, n.Config.Net.Net)
 	if err != nil {
 		return err
 	}
@@ -290,6 +400,22 @@ func (n Node) sendAsync(ctx context.Context, m wire.Message) error {
 	return nil
 }
 
+// port returns the port of the remote node, falling back to the default
+// port of the network.
+func (n Node) port() uint16 {
+	_, p, err := net.SplitHostPort(n.Config.NodeAddress)
+	if err != nil {
+		p = n.Config.Net.DefaultPort
+	}
+
+	port, err := strconv.ParseUint(p, 10, 16)
+	if err != nil {
+		return 0
+	}
+
+	return uint16(port)
+}
+
 func (n Node) buildUserAgent() string {
 	return fmt.Sprintf("%v", n.Config.UserAgent)
 }
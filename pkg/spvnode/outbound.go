@@ -0,0 +1,164 @@
+package spvnode
+
+import (
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/tokenized/smart-contract/pkg/wire"
+)
+
+const (
+	// rebroadcastInterval is how often txs that have not been mined are
+	// announced to the peer again.
+	rebroadcastInterval = time.Minute * 2
+
+	// maxUnrequestedAnnouncements is the number of times a tx is announced
+	// without the peer requesting it, before it is handed to the fallback
+	// listener.
+	maxUnrequestedAnnouncements = 3
+
+	// maxAnnouncements is the number of times a tx is announced before it
+	// is no longer tracked. The sender of the tx must broadcast it again
+	// if it is still wanted.
+	maxAnnouncements = 30
+)
+
+// outboundTX is a tx broadcast by this node.
+type outboundTX struct {
+	tx            *wire.MsgTx
+	announcements int
+	requested     bool
+	fallback      bool
+	lastAnnounced time.Time
+}
+
+// Outbound tracks the txs broadcast by this node until they are mined,
+// removed, or announced maxAnnouncements times.
+//
+// A tx is announced to the peer with an inv message, and sent when the
+// peer requests it with a getdata message. Until the tx is seen in a block
+// it is announced again every rebroadcastInterval.
+type Outbound struct {
+	mu  sync.Mutex
+	txs map[chainhash.Hash]*outboundTX
+}
+
+// NewOutbound returns a new, empty Outbound.
+func NewOutbound() *Outbound {
+	return &Outbound{
+		txs: map[chainhash.Hash]*outboundTX{},
+	}
+}
+
+// Add starts tracking a tx that is about to be announced.
+func (o *Outbound) Add(tx *wire.MsgTx) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	hash := tx.TxHash()
+
+	if _, ok := o.txs[hash]; ok {
+		return
+	}
+
+	o.txs[hash] = &outboundTX{
+		tx:            tx,
+		announcements: 1,
+		lastAnnounced: time.Now(),
+	}
+}
+
+// Request returns the tx for a getdata request from the peer, and records
+// that the peer requested it.
+func (o *Outbound) Request(hash chainhash.Hash) (*wire.MsgTx, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	t, ok := o.txs[hash]
+	if !ok {
+		return nil, false
+	}
+
+	t.requested = true
+
+	return t.tx, true
+}
+
+// Has returns true if the tx is being tracked.
+func (o *Outbound) Has(hash chainhash.Hash) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	_, ok := o.txs[hash]
+
+	return ok
+}
+
+// IsRequested returns true if the peer has requested the tx.
+func (o *Outbound) IsRequested(hash chainhash.Hash) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	t, ok := o.txs[hash]
+
+	return ok && t.requested
+}
+
+// Mined stops tracking any of the txs, as they have been seen in a block.
+func (o *Outbound) Mined(txs []*wire.MsgTx) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for _, tx := range txs {
+		delete(o.txs, tx.TxHash())
+	}
+}
+
+// Remove stops tracking a tx that must not be sent, so it is no longer
+// announced or given to the peer.
+func (o *Outbound) Remove(hash chainhash.Hash) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	delete(o.txs, hash)
+}
+
+// Due returns the txs that should be announced again, and the txs that
+// should be handed to the fallback listener because the peer has not
+// requested them.
+//
+// A tx is only returned for fallback once. A tx that has been announced
+// maxAnnouncements times is no longer tracked.
+func (o *Outbound) Due(now time.Time) ([]*wire.MsgTx, []*wire.MsgTx) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	announce := []*wire.MsgTx{}
+	fallback := []*wire.MsgTx{}
+
+	for hash, t := range o.txs {
+		if now.Sub(t.lastAnnounced) < rebroadcastInterval {
+			continue
+		}
+
+		if t.announcements >= maxAnnouncements {
+			delete(o.txs, hash)
+			continue
+		}
+
+		t.announcements++
+		t.lastAnnounced = now
+
+		announce = append(announce, t.tx)
+
+		if !t.requested && !t.fallback &&
+			t.announcements > maxUnrequestedAnnouncements {
+
+			t.fallback = true
+			fallback = append(fallback, t.tx)
+		}
+	}
+
+	return announce, fallback
+}
@@ -0,0 +1,141 @@
+package spvnode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/tokenized/smart-contract/pkg/wire"
+)
+
+func TestOutbound_Due(t *testing.T) {
+	o := NewOutbound()
+
+	tx := newSpendingTx(wire.OutPoint{Hash: chainhash.Hash{4}}, 1000)
+	o.Add(tx)
+
+	now := time.Now()
+
+	// nothing is due until the interval has passed
+	announce, fallback := o.Due(now)
+	if len(announce) != 0 || len(fallback) != 0 {
+		t.Fatalf("got %v announce, %v fallback, want none", len(announce), len(fallback))
+	}
+
+	// announced, but not requested, so it falls back once
+	for i := 1; i <= maxUnrequestedAnnouncements; i++ {
+		now = now.Add(rebroadcastInterval)
+
+		announce, fallback = o.Due(now)
+		if len(announce) != 1 {
+			t.Fatalf("got %v announce, want 1", len(announce))
+		}
+
+		wantFallback := 0
+		if i == maxUnrequestedAnnouncements {
+			wantFallback = 1
+		}
+
+		if len(fallback) != wantFallback {
+			t.Fatalf("announcement %v : got %v fallback, want %v", i, len(fallback), wantFallback)
+		}
+	}
+
+	now = now.Add(rebroadcastInterval)
+	if _, fallback := o.Due(now); len(fallback) != 0 {
+		t.Fatalf("got %v fallback, want 0", len(fallback))
+	}
+
+	// once mined, it is no longer announced
+	o.Mined([]*wire.MsgTx{tx})
+
+	now = now.Add(rebroadcastInterval)
+	if announce, _ := o.Due(now); len(announce) != 0 {
+		t.Fatalf("got %v announce, want 0", len(announce))
+	}
+}
+
+func TestOutbound_Remove(t *testing.T) {
+	o := NewOutbound()
+
+	tx := newSpendingTx(wire.OutPoint{Hash: chainhash.Hash{7}}, 1000)
+	o.Add(tx)
+
+	// a removed tx is not announced, or given to the peer
+	o.Remove(tx.TxHash())
+
+	if announce, _ := o.Due(time.Now().Add(rebroadcastInterval)); len(announce) != 0 {
+		t.Fatalf("got %v announce, want 0", len(announce))
+	}
+
+	if _, ok := o.Request(tx.TxHash()); ok {
+		t.Fatal("requested a removed tx")
+	}
+}
+
+func TestOutbound_Due_expire(t *testing.T) {
+	o := NewOutbound()
+
+	tx := newSpendingTx(wire.OutPoint{Hash: chainhash.Hash{8}}, 1000)
+	o.Add(tx)
+
+	now := time.Now()
+
+	for i := 1; i < maxAnnouncements; i++ {
+		now = now.Add(rebroadcastInterval)
+
+		if announce, _ := o.Due(now); len(announce) != 1 {
+			t.Fatalf("announcement %v : got %v announce, want 1", i+1, len(announce))
+		}
+	}
+
+	// announced maxAnnouncements times, so it is no longer tracked
+	now = now.Add(rebroadcastInterval)
+
+	if announce, _ := o.Due(now); len(announce) != 0 {
+		t.Fatalf("got %v announce, want 0", len(announce))
+	}
+
+	if o.Has(tx.TxHash()) {
+		t.Fatal("tx is still tracked")
+	}
+}
+
+func TestGetDataHandler_handle(t *testing.T) {
+	o := NewOutbound()
+
+	tx := newSpendingTx(wire.OutPoint{Hash: chainhash.Hash{5}}, 1000)
+	o.Add(tx)
+
+	hash := tx.TxHash()
+	unknown := chainhash.Hash{6}
+
+	m := wire.NewMsgGetData()
+	m.AddInvVect(wire.NewInvVect(wire.InvTypeTx, &hash))
+	m.AddInvVect(wire.NewInvVect(wire.InvTypeTx, &unknown))
+
+	h := NewGetDataHandler(Config{}, o)
+
+	out, err := h.Handle(context.Background(), m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(out) != 2 {
+		t.Fatalf("got %v messages, want 2", len(out))
+	}
+
+	if got, ok := out[0].(*wire.MsgTx); !ok || got.TxHash() != hash {
+		t.Errorf("got %#v, want tx %s", out[0], hash)
+	}
+
+	notFound, ok := out[1].(*wire.MsgNotFound)
+	if !ok || len(notFound.InvList) != 1 || notFound.InvList[0].Hash != unknown {
+		t.Errorf("got %#v, want notfound %s", out[1], unknown)
+	}
+
+	if !o.IsRequested(hash) {
+		t.Errorf("got not requested, want requested")
+	}
+}
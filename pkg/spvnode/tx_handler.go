@@ -9,20 +9,26 @@ import (
 
 // TXHandler exists to handle the Ping command.
 type TXHandler struct {
-	Config       Config
-	BlockService *BlockService
-	Listener     Listener
+	Config              Config
+	BlockService        *BlockService
+	Mempool             *Mempool
+	Listener            Listener
+	DoubleSpendListener Listener
 }
 
 // NewTXHandler returns a new TXHandler with the given Config.
 func NewTXHandler(config Config,
 	blockService *BlockService,
-	listener Listener) TXHandler {
+	mempool *Mempool,
+	listener Listener,
+	doubleSpendListener Listener) TXHandler {
 
 	return TXHandler{
-		Config:       config,
-		BlockService: blockService,
-		Listener:     listener,
+		Config:              config,
+		BlockService:        blockService,
+		Mempool:             mempool,
+		Listener:            listener,
+		DoubleSpendListener: doubleSpendListener,
 	}
 }
 
@@ -47,10 +53,26 @@ func (h TXHandler) Handle(ctx context.Context,
 func (h TXHandler) handle(ctx context.Context,
 	tx *wire.MsgTx) ([]wire.Message, error) {
 
+	if h.Mempool.Has(tx.TxHash()) {
+		// we have already seen this tx
+		return nil, nil
+	}
+
+	conflicts := h.Mempool.Add(tx)
+
 	if h.Listener != nil {
 		// notify the listener
 		h.Listener.Handle(ctx, tx)
 	}
 
+	if len(conflicts) > 0 && h.DoubleSpendListener != nil {
+		// notify the listener of both sides of the double spend
+		for _, c := range conflicts {
+			h.DoubleSpendListener.Handle(ctx, c)
+		}
+
+		h.DoubleSpendListener.Handle(ctx, tx)
+	}
+
 	return nil, nil
 }
@@ -0,0 +1,49 @@
+package spvnode
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tokenized/smart-contract/pkg/wire"
+)
+
+// VerAckHandler exists to handle the VerAck command.
+type VerAckHandler struct {
+	Config       Config
+	BlockService *BlockService
+}
+
+// NewVerAckHandler returns a new VerAckHandler with the given Config.
+func NewVerAckHandler(config Config, blockService *BlockService) VerAckHandler {
+	return VerAckHandler{
+		Config:       config,
+		BlockService: blockService,
+	}
+}
+
+// Handle implments the Handler interface
+//
+// This function handles type conversion and delegates the the contrete
+// handler.
+func (h VerAckHandler) Handle(ctx context.Context,
+	m wire.Message) ([]wire.Message, error) {
+
+	msg, ok := m.(*wire.MsgVerAck)
+	if !ok {
+		return nil, errors.New("Could not assert as *wire.MsgVerAck")
+	}
+
+	return h.handle(ctx, msg)
+}
+
+// handle processes the MsgVerAck.
+//
+// The handshake is complete, so start syncing headers from the last seen
+// block.
+func (h VerAckHandler) handle(ctx context.Context,
+	m *wire.MsgVerAck) ([]wire.Message, error) {
+
+	out := newGetHeaders(h.BlockService, h.Config.Net)
+
+	return []wire.Message{out}, nil
+}
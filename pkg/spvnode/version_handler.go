@@ -9,13 +9,15 @@ import (
 
 // VersionHandler exists to handle the Version command.
 type VersionHandler struct {
-	Config Config
+	Config       Config
+	BlockService *BlockService
 }
 
 // NewVersionHandler returns a new VersionHandler with the given Config.
-func NewVersionHandler(config Config) VersionHandler {
+func NewVersionHandler(config Config, blockService *BlockService) VersionHandler {
 	return VersionHandler{
-		Config: config,
+		Config:       config,
+		BlockService: blockService,
 	}
 }
 
@@ -34,12 +36,14 @@ func (h VersionHandler) Handle(ctx context.Context,
 	return h.handle(ctx, msg)
 }
 
-// handle processes the MsgVersion, and responds with a MsgVersion.
+// handle processes the MsgVersion, and responds with a MsgVerAck.
 //
-// For now this just echos the version back in the response.
+// The height of the chain of the peer is kept to report sync progress.
 func (h VersionHandler) handle(ctx context.Context,
 	m *wire.MsgVersion) ([]wire.Message, error) {
 
+	h.BlockService.peerHeight = m.LastBlock
+
 	out := wire.NewMsgVerAck()
 
 	return []wire.Message{out}, nil
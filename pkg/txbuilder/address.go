@@ -7,17 +7,17 @@ import (
 	"github.com/btcsuite/btcutil"
 )
 
-func GetAddress(pubKey []byte) (btcutil.Address, error) {
+func GetAddress(pubKey []byte, params *chaincfg.Params) (btcutil.Address, error) {
 	if len(pubKey) == 0 {
 		return nil, errors.New("Empty pubkey")
 	}
 
-	addr, err := btcutil.NewAddressPubKey(pubKey, &chaincfg.MainNetParams)
+	addr, err := btcutil.NewAddressPubKey(pubKey, params)
 	if err != nil {
 		return nil, err
 	}
 
-	address, err := btcutil.DecodeAddress(addr.EncodeAddress(), &chaincfg.MainNetParams)
+	address, err := btcutil.DecodeAddress(addr.EncodeAddress(), params)
 	if err != nil {
 		return nil, err
 	}
@@ -25,6 +25,8 @@ func GetAddress(pubKey []byte) (btcutil.Address, error) {
 	return address, nil
 }
 
-func GetAddressFromString(addressString string) (btcutil.Address, error) {
-	return btcutil.DecodeAddress(addressString, &chaincfg.MainNetParams)
+func GetAddressFromString(addressString string,
+	params *chaincfg.Params) (btcutil.Address, error) {
+
+	return btcutil.DecodeAddress(addressString, params)
 }
@@ -1,40 +1,20 @@
 package txbuilder
 
 import (
-	"errors"
-	"fmt"
-	"sort"
-
-	"github.com/tokenized/smart-contract/pkg/wire"
-
-	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcutil"
 )
 
-const (
-	MaxTxFee          = 425
-	OutputFeeP2PKH    = 34
-	OutputFeeOpReturn = 20
-	OutputOpDataFee   = 3
-	InputFeeP2PKH     = 148
-	BaseTxFee         = 10
-)
-
 const DustMinimumOutput uint64 = 546
 
-const notEnoughValueErrorText = "unable to find enough value to spend"
-
-var notEnoughValueError = errors.New(notEnoughValueErrorText)
-
 func build(spendableTxOuts []*TxOutput,
 	outputs []TxOutput,
-	privateKey *btcec.PrivateKey,
+	signer Signer,
 	changeAddress btcutil.Address,
-	opReturn TxOutput) (*Tx, error) {
-
-	sort.Sort(TxOutSortByValue(spendableTxOuts))
+	opReturn TxOutput,
+	policy FeePolicy,
+	selector CoinSelector) (*Tx, error) {
 
-	rTx, _, err := buildWithTxOuts(outputs, spendableTxOuts, privateKey, changeAddress, opReturn)
+	rTx, _, err := buildWithTxOuts(outputs, spendableTxOuts, signer, changeAddress, opReturn, policy, selector)
 	if err != nil {
 		return nil, err
 	}
@@ -43,102 +23,65 @@ func build(spendableTxOuts []*TxOutput,
 
 func buildWithTxOuts(outputs []TxOutput,
 	spendableTxOuts []*TxOutput,
-	privateKey *btcec.PrivateKey,
+	signer Signer,
 	changeAddress btcutil.Address,
-	opReturn TxOutput) (*Tx, []*TxOutput, error) {
+	opReturn TxOutput,
+	policy FeePolicy,
+	selector CoinSelector) (*Tx, []*TxOutput, error) {
 
-	var minInput = uint64(BaseTxFee + InputFeeP2PKH + OutputFeeP2PKH + DustMinimumOutput)
-
-	var spendOutputType TxOutputType
-	var txOutsToUse []*TxOutput
-	var totalInputValue uint64
-
-	for {
-		if len(spendableTxOuts) == 0 {
-			return nil, nil, notEnoughValueError
-		}
-		spendableTxOut := spendableTxOuts[0]
-		spendableTxOuts = spendableTxOuts[1:]
-		txOutsToUse = append(txOutsToUse, spendableTxOut)
-
-		totalInputValue += spendableTxOut.Value
-		if totalInputValue > minInput {
-			break
-		}
-		minInput += InputFeeP2PKH
+	if selector == nil {
+		selector = DefaultCoinSelector()
 	}
 
-	var fee = uint64(BaseTxFee+len(txOutsToUse)*InputFeeP2PKH) + OutputFeeP2PKH
-
-	var totalOutputValue uint64
-	allOutputs := append(outputs, opReturn)
-	for _, spendOutput := range allOutputs {
-		totalOutputValue += spendOutput.Value
+	var spendOutputType TxOutputType
 
-		outputFee, err := getAppOutputFee(spendOutput)
-		if err != nil {
-			return nil, nil, err
-		}
-		fee += uint64(outputFee)
+	// change is always paid in an output of its own, even if another output
+	// pays the change address, so the other outputs keep their values and
+	// their order.
+	changeOutput := &TxOutput{
+		Type:    AddressOutputType(changeAddress),
+		Address: changeAddress,
 	}
 
-	// minimum fee is dust
-	if fee < DustMinimumOutput {
-		fee = DustMinimumOutput
+	if err := checkDust(outputs); err != nil {
+		return nil, nil, err
 	}
 
-	var change = totalInputValue - fee - totalOutputValue
+	// the OP_RETURN is paid for, but added after the change
+	allOutputs := append(append([]TxOutput{}, outputs...), opReturn)
 
-	pk := PrivateKey{
-		Secret: privateKey.Serialize(),
+	target := SpendTarget{
+		Outputs:   allOutputs,
+		Change:    changeOutput,
+		FeePolicy: policy,
 	}
 
-	address, err := pk.GetPublicKey().GetAddress()
+	selection, err := selector.Select(spendableTxOuts, target)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	changeAddr, err := GetAddressFromString(changeAddress.EncodeAddress())
+	report, err := newBuildReport(selection, target)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	if change > 0 {
-		// do we have an output for this address already?
-		output := TxOutput{}
-
-		s := changeAddress.EncodeAddress()
-
-		fmt.Printf("change address = %v\n", s)
+	txOutsToUse := selection.Inputs
+	spendableTxOuts = selection.Remaining(spendableTxOuts)
+	change := selection.Change
 
-		for i, o := range outputs {
-			if o.Address.EncodeAddress() == s {
-				o.Value += change
-
-				output = o
-				outputs[i] = o
-
-				break
-			}
-		}
-
-		fmt.Printf("output = %+v\n", output)
-		if output.Value == 0 && change >= DustMinimumOutput {
-			output = TxOutput{
-				Type:    OutputTypeP2PK,
-				Address: changeAddr,
-				Value:   change,
-			}
+	// the hash is the same on every network
+	selfPkHash := btcutil.Hash160(signer.PublicKey().SerializeCompressed())
 
-			outputs = append(outputs, output)
-		}
+	if change > 0 {
+		changeOutput.Value = change
+		outputs = append(outputs, *changeOutput)
 	}
 
 	// add the OP_RETURN payload last
 	outputs = append(outputs, opReturn)
 
-	var tx *wire.MsgTx
-	tx, err = Create(txOutsToUse, &pk, outputs)
+	tx, err := CreateWithSigner(txOutsToUse, signer, outputs)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -162,76 +105,74 @@ func buildWithTxOuts(outputs []TxOutput,
 	}}, spendableTxOuts...)
 
 	return &Tx{
-		SelfPkHash: address.ScriptAddress(),
+		SelfPkHash: selfPkHash,
 		Type:       spendOutputType,
 		MsgTx:      tx,
 		Inputs:     inputs,
+		Selection:  selection,
+		Report:     report,
 	}, spendableTxOuts, nil
 }
 
 func BuildUnsignedWithTxOuts(outputs []TxOutput,
 	spendableTxOuts []*TxOutput,
-	address btcutil.Address) (*Tx, []*TxOutput, error) {
+	address btcutil.Address,
+	policy FeePolicy,
+	selector CoinSelector) (*Tx, []*TxOutput, error) {
 
-	var minInput = uint64(BaseTxFee + InputFeeP2PKH + OutputFeeP2PKH + DustMinimumOutput)
+	if selector == nil {
+		selector = DefaultCoinSelector()
+	}
 
 	var spendOutputType TxOutputType
-	var txOutsToUse []*TxOutput
-	var totalInputValue uint64
 
-	for {
-		if len(spendableTxOuts) == 0 {
-			return nil, nil, notEnoughValueError
-		}
-		spendableTxOut := spendableTxOuts[0]
-		spendableTxOuts = spendableTxOuts[1:]
-		txOutsToUse = append(txOutsToUse, spendableTxOut)
-
-		totalInputValue += spendableTxOut.Value
-		if totalInputValue > minInput {
-			break
-		}
-		minInput += InputFeeP2PKH
+	changeOutput := TxOutput{
+		Type:    AddressOutputType(address),
+		Address: address,
 	}
 
-	var fee = uint64(BaseTxFee+len(txOutsToUse)*InputFeeP2PKH) + OutputFeeP2PKH
-
-	var totalOutputValue uint64
-
-	allOutputs := outputs
-
-	for _, spendOutput := range allOutputs {
-		totalOutputValue += spendOutput.Value
+	if err := checkDust(outputs); err != nil {
+		return nil, nil, err
+	}
 
-		outputFee, err := getAppOutputFee(spendOutput)
-		if err != nil {
-			return nil, nil, err
-		}
-		fee += uint64(outputFee)
+	target := SpendTarget{
+		Outputs:   outputs,
+		Change:    &changeOutput,
+		FeePolicy: policy,
 	}
 
-	var change = totalInputValue - fee - totalOutputValue
+	selection, err := selector.Select(spendableTxOuts, target)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	if change < DustMinimumOutput {
-		// not enough change to pay, leave it for the miner.
-		change = 0
+	report, err := newBuildReport(selection, target)
+	if err != nil {
+		return nil, nil, err
 	}
 
+	txOutsToUse := selection.Inputs
+	spendableTxOuts = selection.Remaining(spendableTxOuts)
+	change := selection.Change
+
 	if change > 0 {
-		output := TxOutput{
-			Type:    OutputTypeP2PK,
-			Address: address,
-			Value:   change,
-		}
+		changeOutput.Value = change
 
 		// change is added after the other P2PK outputs
-		outputs = append(outputs, output)
+		outputs = append(outputs, changeOutput)
 	}
 
 	// add the OP_RETURN payload last
 	// outputs = append(outputs, opReturn)
 
-	var tx *wire.MsgTx
+	// everything went to the miner, as there was nothing to pay and the
+	// change was dust
+	if len(outputs) == 0 {
+		return nil, nil, &InsufficientValueError{
+			Available: selection.Value(),
+			Required:  selection.Fee + DustMinimumOutput,
+		}
+	}
 
 	tx, err := CreateUnsigned(txOutsToUse, outputs)
 
@@ -265,17 +206,22 @@ func BuildUnsignedWithTxOuts(outputs []TxOutput,
 		Type:       spendOutputType,
 		MsgTx:      tx,
 		Inputs:     inputs,
+		Selection:  selection,
+		Report:     report,
 	}, spendableTxOuts, nil
 }
 
-func getAppOutputFee(output TxOutput) (uint64, error) {
-	switch output.Type {
-	case OutputTypeP2PK:
-		return OutputFeeP2PKH, nil
-
-	case OutputTypeReturn:
-		return uint64(len(output.Data)) + 15, nil
+// checkDust returns a DustOutputError for the first output, other than an
+// OP_RETURN, that is worth less than dust.
+func checkDust(outputs []TxOutput) error {
+	for i, o := range outputs {
+		if o.Type != OutputTypeReturn && o.Value < DustMinimumOutput {
+			return &DustOutputError{
+				Index: i,
+				Value: o.Value,
+			}
+		}
 	}
 
-	return 0, errors.New("unable to get fee for output type")
+	return nil
 }
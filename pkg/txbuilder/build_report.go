@@ -0,0 +1,62 @@
+package txbuilder
+
+import (
+	"fmt"
+)
+
+// BuildReport describes how a tx was paid for.
+type BuildReport struct {
+	Inputs      int
+	InputValue  uint64
+	OutputValue uint64
+	Fee         uint64
+	Change      uint64
+
+	// DustDiscarded is change that was too small to keep, so it was paid
+	// to the miner as part of the Fee.
+	DustDiscarded uint64
+
+	Strategy string
+	Reason   string
+}
+
+// newBuildReport returns the BuildReport for the inputs chosen to pay for
+// the target.
+func newBuildReport(s *Selection, target SpendTarget) (*BuildReport, error) {
+	r := BuildReport{
+		Inputs:      len(s.Inputs),
+		InputValue:  s.Value(),
+		OutputValue: target.Value(),
+		Fee:         s.Fee,
+		Change:      s.Change,
+		Strategy:    s.Strategy,
+		Reason:      s.Reason,
+	}
+
+	// change added to an existing output is never discarded
+	if s.Change > 0 || target.Change == nil {
+		return &r, nil
+	}
+
+	fee, err := target.FeePolicy.EstimateFee(len(s.Inputs), target.Outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Fee > fee {
+		r.DustDiscarded = s.Fee - fee
+	}
+
+	return &r, nil
+}
+
+func (r BuildReport) String() string {
+	return fmt.Sprintf("inputs=%d input_value=%d output_value=%d fee=%d change=%d dust_discarded=%d strategy=%s",
+		r.Inputs,
+		r.InputValue,
+		r.OutputValue,
+		r.Fee,
+		r.Change,
+		r.DustDiscarded,
+		r.Strategy)
+}
@@ -0,0 +1,81 @@
+package txbuilder
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestBuildUnsignedWithTxOuts_report(t *testing.T) {
+	target := newTestSpendTarget(t, 4000)
+	address := target.Change.Address
+	policy := target.FeePolicy
+
+	// the change is less than dust, so it goes to the miner
+	spendable := newTestSpendable(4500)
+
+	tx, _, err := BuildUnsignedWithTxOuts(target.Outputs, spendable, address, policy, LargestFirst{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fee, err := policy.EstimateFee(1, target.Outputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := tx.Report
+	if r.Inputs != 1 || r.InputValue != 4500 || r.OutputValue != 4000 || r.Change != 0 {
+		t.Fatalf("got report %v", r)
+	}
+
+	if r.Fee != 500 || r.DustDiscarded != 500-fee {
+		t.Errorf("got fee %v dust %v, want 500 and %v", r.Fee, r.DustDiscarded, 500-fee)
+	}
+}
+
+func TestBuildUnsignedWithTxOuts_errors(t *testing.T) {
+	target := newTestSpendTarget(t, 4000)
+	address := target.Change.Address
+	policy := target.FeePolicy
+
+	_, _, err := BuildUnsignedWithTxOuts(target.Outputs, newTestSpendable(1000, 2000),
+		address, policy, nil)
+
+	short, ok := err.(*InsufficientValueError)
+	if !ok {
+		t.Fatalf("got err %v, want InsufficientValueError", err)
+	}
+
+	fee, err := policy.EstimateFee(2, target.Outputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if short.Available != 3000 || short.Shortfall() != 1000+fee {
+		t.Errorf("got available %v shortfall %v, want 3000 and %v",
+			short.Available, short.Shortfall(), 1000+fee)
+	}
+
+	target.Outputs = append(target.Outputs, TxOutput{
+		Type:    OutputTypeP2PK,
+		Address: address,
+		Value:   100,
+	})
+
+	_, _, err = BuildUnsignedWithTxOuts(target.Outputs, newTestSpendable(10000),
+		address, policy, nil)
+
+	dust, ok := err.(*DustOutputError)
+	if !ok {
+		t.Fatalf("got err %v, want DustOutputError", err)
+	}
+
+	if dust.Index != 1 || dust.Value != 100 {
+		t.Errorf("got dust output %v value %v, want 1 value 100", dust.Index, dust.Value)
+	}
+
+	want := fmt.Sprintf("output 1 value 100 is less than dust %d", DustMinimumOutput)
+	if dust.Error() != want {
+		t.Errorf("got error %q, want %q", dust.Error(), want)
+	}
+}
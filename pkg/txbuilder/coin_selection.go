@@ -0,0 +1,404 @@
+package txbuilder
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+const (
+	// StrategyBranchAndBound selects inputs that pay for a tx without
+	// change.
+	StrategyBranchAndBound = "branch-and-bound"
+
+	// StrategyLargestFirst selects the largest inputs first.
+	StrategyLargestFirst = "largest-first"
+
+	// StrategyOldestFirst selects the oldest inputs first, and spends
+	// extra small inputs to consolidate them.
+	StrategyOldestFirst = "oldest-first"
+
+	// defaultMaxTries is the number of branches a BranchAndBound searches
+	// before giving up.
+	defaultMaxTries = 100000
+)
+
+// ErrNoExactMatch is returned when no set of inputs pays for a tx without
+// change.
+var ErrNoExactMatch = errors.New("no exact match of inputs")
+
+// CoinSelector chooses the inputs that pay for a tx.
+type CoinSelector interface {
+	Select(spendable []*TxOutput, target SpendTarget) (*Selection, error)
+}
+
+// DefaultCoinSelector returns the CoinSelector used when none is given. It
+// looks for inputs that avoid change, and falls back to the largest inputs.
+func DefaultCoinSelector() CoinSelector {
+	return BranchAndBound{
+		Fallback: LargestFirst{},
+	}
+}
+
+// SpendTarget is what the selected inputs must pay for.
+type SpendTarget struct {
+	// Outputs are all the outputs of the tx, other than change.
+	Outputs []TxOutput
+
+	// Change is the output that will hold the change. It is nil if the
+	// change is added to one of the Outputs, so it costs nothing.
+	Change *TxOutput
+
+	FeePolicy FeePolicy
+}
+
+// Value returns the total value of the Outputs.
+func (t SpendTarget) Value() uint64 {
+	v := uint64(0)
+
+	for _, o := range t.Outputs {
+		v += o.Value
+	}
+
+	return v
+}
+
+// settle returns the Selection for the inputs, or nil if they do not pay
+// for the outputs and the fee.
+//
+// If the change would need a new output and would be less than dust after
+// paying for that output, the change is left to the miner.
+func (t SpendTarget) settle(inputs []*TxOutput) (*Selection, error) {
+	total := uint64(0)
+	for _, in := range inputs {
+		total += in.Value
+	}
+
+	value := t.Value()
+
+	fee, err := t.FeePolicy.EstimateFee(len(inputs), t.Outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	if total < value+fee {
+		return nil, nil
+	}
+
+	s := Selection{
+		Inputs: inputs,
+		Fee:    total - value,
+	}
+
+	if t.Change == nil {
+		s.Fee = fee
+		s.Change = total - value - fee
+		return &s, nil
+	}
+
+	withChange := append(append([]TxOutput{}, t.Outputs...), *t.Change)
+
+	fee, err = t.FeePolicy.EstimateFee(len(inputs), withChange)
+	if err != nil {
+		return nil, err
+	}
+
+	if total >= value+fee+DustMinimumOutput {
+		s.Fee = fee
+		s.Change = total - value - fee
+	}
+
+	return &s, nil
+}
+
+// insufficient returns the InsufficientValueError for spendable outputs
+// that do not pay for the target, even when all of them are spent.
+func (t SpendTarget) insufficient(spendable []*TxOutput) error {
+	total := uint64(0)
+	for _, in := range spendable {
+		total += in.Value
+	}
+
+	fee, err := t.FeePolicy.EstimateFee(len(spendable), t.Outputs)
+	if err != nil {
+		return err
+	}
+
+	return &InsufficientValueError{
+		Available: total,
+		Required:  t.Value() + fee,
+	}
+}
+
+// Selection is the inputs chosen to pay for a tx, and why.
+type Selection struct {
+	Inputs []*TxOutput
+
+	// Fee is what is paid to the miner, including any change that was too
+	// small to keep.
+	Fee uint64
+
+	// Change is the value of the change, or 0 if there is no change.
+	Change uint64
+
+	// Strategy is the strategy that chose the Inputs.
+	Strategy string
+
+	// Reason describes why the Inputs were chosen.
+	Reason string
+}
+
+// Value returns the total value of the Inputs.
+func (s Selection) Value() uint64 {
+	v := uint64(0)
+
+	for _, in := range s.Inputs {
+		v += in.Value
+	}
+
+	return v
+}
+
+// Remaining returns the spendable outputs that were not selected, in
+// order.
+func (s Selection) Remaining(spendable []*TxOutput) []*TxOutput {
+	used := map[*TxOutput]bool{}
+	for _, in := range s.Inputs {
+		used[in] = true
+	}
+
+	remaining := []*TxOutput{}
+
+	for _, o := range spendable {
+		if !used[o] {
+			remaining = append(remaining, o)
+		}
+	}
+
+	return remaining
+}
+
+// LargestFirst selects the largest inputs until the tx is paid for. This
+// uses the fewest inputs, so the tx is small.
+type LargestFirst struct{}
+
+// Select implements the CoinSelector interface.
+func (c LargestFirst) Select(spendable []*TxOutput,
+	target SpendTarget) (*Selection, error) {
+
+	sorted := append([]*TxOutput{}, spendable...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Value > sorted[j].Value
+	})
+
+	for i := range sorted {
+		s, err := target.settle(sorted[:i+1])
+		if err != nil {
+			return nil, err
+		}
+
+		if s == nil {
+			continue
+		}
+
+		s.Strategy = StrategyLargestFirst
+		s.Reason = fmt.Sprintf("the %d largest of %d outputs pay %d with %d fee",
+			len(s.Inputs), len(spendable), target.Value(), s.Fee)
+
+		return s, nil
+	}
+
+	return nil, target.insufficient(spendable)
+}
+
+// OldestFirst selects inputs in the order they are given, which is
+// expected to be oldest first, until the tx is paid for.
+//
+// Up to MaxInputs, further inputs are spent while they are worth more than
+// the fee to spend them, consolidating small outputs into the change.
+type OldestFirst struct {
+	MaxInputs int
+}
+
+// Select implements the CoinSelector interface.
+func (c OldestFirst) Select(spendable []*TxOutput,
+	target SpendTarget) (*Selection, error) {
+
+	var s *Selection
+
+	for i := range spendable {
+		var err error
+		s, err = target.settle(spendable[:i+1])
+		if err != nil {
+			return nil, err
+		}
+
+		if s != nil {
+			break
+		}
+	}
+
+	if s == nil {
+		return nil, target.insufficient(spendable)
+	}
+
+	needed := len(s.Inputs)
+	inputFee := target.FeePolicy.InputFee()
+
+	for i := needed; i < len(spendable) && i < c.MaxInputs; i++ {
+		if spendable[i].Value <= inputFee {
+			continue
+		}
+
+		inputs := append(append([]*TxOutput{}, s.Inputs...), spendable[i])
+
+		next, err := target.settle(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+		s = next
+	}
+
+	s.Strategy = StrategyOldestFirst
+	s.Reason = fmt.Sprintf("the %d oldest outputs pay %d with %d fee, %d more consolidated",
+		needed, target.Value(), s.Fee, len(s.Inputs)-needed)
+
+	return s, nil
+}
+
+// BranchAndBound searches for a set of inputs that pays for the tx without
+// change, so no new output is created and the inputs are not fragmented.
+//
+// If no such set is found within MaxTries branches, the Fallback is used.
+// If there is no Fallback, ErrNoExactMatch is returned.
+type BranchAndBound struct {
+	MaxTries int
+	Fallback CoinSelector
+}
+
+// Select implements the CoinSelector interface.
+func (c BranchAndBound) Select(spendable []*TxOutput,
+	target SpendTarget) (*Selection, error) {
+
+	s, err := c.search(spendable, target)
+	if err != nil {
+		return nil, err
+	}
+
+	if s != nil {
+		return s, nil
+	}
+
+	if c.Fallback == nil {
+		return nil, ErrNoExactMatch
+	}
+
+	return c.Fallback.Select(spendable, target)
+}
+
+// search returns the Selection without change that leaves the least to the
+// miner, or nil if none is found.
+func (c BranchAndBound) search(spendable []*TxOutput,
+	target SpendTarget) (*Selection, error) {
+
+	// change added to an existing output costs nothing, so there is
+	// nothing to avoid.
+	if target.Change == nil {
+		return nil, nil
+	}
+
+	maxTries := c.MaxTries
+	if maxTries == 0 {
+		maxTries = defaultMaxTries
+	}
+
+	policy := target.FeePolicy
+	inputFee := policy.InputFee()
+
+	// only inputs worth more than the fee to spend them help
+	candidates := []*TxOutput{}
+	for _, o := range spendable {
+		if o.Value > inputFee {
+			candidates = append(candidates, o)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Value > candidates[j].Value
+	})
+
+	base, err := policy.EstimateFee(0, target.Outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	withChange, err := policy.EstimateFee(0,
+		append(append([]TxOutput{}, target.Outputs...), *target.Change))
+	if err != nil {
+		return nil, err
+	}
+
+	// the window the effective value of the inputs must fall in. Any more
+	// and the change would be worth keeping.
+	low := target.Value() + base
+	high := low + (withChange - base) + DustMinimumOutput
+
+	// remaining[i] is the effective value of candidates[i:]
+	remaining := make([]uint64, len(candidates)+1)
+	for i := len(candidates) - 1; i >= 0; i-- {
+		remaining[i] = remaining[i+1] + candidates[i].Value - inputFee
+	}
+
+	var best *Selection
+	tries := 0
+	selected := []*TxOutput{}
+
+	var walk func(i int, value uint64) error
+	walk = func(i int, value uint64) error {
+		tries++
+		if tries > maxTries || value > high || value+remaining[i] < low {
+			return nil
+		}
+
+		if value >= low {
+			s, err := target.settle(append([]*TxOutput{}, selected...))
+			if err != nil {
+				return err
+			}
+
+			if s != nil && s.Change == 0 && (best == nil || s.Fee < best.Fee) {
+				best = s
+			}
+
+			return nil
+		}
+
+		if i == len(candidates) {
+			return nil
+		}
+
+		// include the candidate, then try without it
+		selected = append(selected, candidates[i])
+		if err := walk(i+1, value+candidates[i].Value-inputFee); err != nil {
+			return err
+		}
+		selected = selected[:len(selected)-1]
+
+		return walk(i+1, value)
+	}
+
+	if err := walk(0, 0); err != nil {
+		return nil, err
+	}
+
+	if best == nil {
+		return nil, nil
+	}
+
+	best.Strategy = StrategyBranchAndBound
+	best.Reason = fmt.Sprintf("%d of %d outputs pay %d without change, %d to the miner",
+		len(best.Inputs), len(spendable), target.Value(), best.Fee)
+
+	return best, nil
+}
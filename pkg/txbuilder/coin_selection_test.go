@@ -0,0 +1,165 @@
+package txbuilder
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+func newTestSpendTarget(t *testing.T, value uint64) SpendTarget {
+	address, err := GetAddressFromString("18chgevayKE8fQDDVsopokEnVSugjFRJGL",
+		&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return SpendTarget{
+		Outputs: []TxOutput{
+			TxOutput{
+				Type:    OutputTypeP2PK,
+				Address: address,
+				Value:   value,
+			},
+		},
+		Change: &TxOutput{
+			Type:    OutputTypeP2PK,
+			Address: address,
+		},
+		FeePolicy: NewFeePolicy(1000),
+	}
+}
+
+func newTestSpendable(values ...uint64) []*TxOutput {
+	outs := []*TxOutput{}
+
+	for i, v := range values {
+		hash := chainhash.Hash{byte(i + 1)}
+
+		outs = append(outs, &TxOutput{
+			TransactionHash: hash.CloneBytes(),
+			Value:           v,
+		})
+	}
+
+	return outs
+}
+
+func selectedValues(s *Selection) []uint64 {
+	values := []uint64{}
+
+	for _, in := range s.Inputs {
+		values = append(values, in.Value)
+	}
+
+	return values
+}
+
+func equalValues(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestLargestFirst_Select(t *testing.T) {
+	spendable := newTestSpendable(1000, 50000, 3000, 20000)
+
+	// 1 input, 2 outputs is 227 bytes
+	s, err := LargestFirst{}.Select(spendable, newTestSpendTarget(t, 60000))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 2 inputs, 2 outputs is 376 bytes
+	if want := []uint64{50000, 20000}; !equalValues(selectedValues(s), want) {
+		t.Fatalf("got %v, want %v", selectedValues(s), want)
+	}
+
+	if s.Fee != 376 || s.Change != 70000-60000-376 {
+		t.Errorf("got fee %v change %v, want fee 376 change %v", s.Fee, s.Change, 70000-60000-376)
+	}
+
+	if s.Strategy != StrategyLargestFirst || len(s.Reason) == 0 {
+		t.Errorf("got strategy %v reason %q", s.Strategy, s.Reason)
+	}
+
+	remaining := s.Remaining(spendable)
+	if len(remaining) != 2 || remaining[0] != spendable[0] || remaining[1] != spendable[2] {
+		t.Errorf("got remaining %v, want the unselected outputs in order", remaining)
+	}
+
+	if _, err := (LargestFirst{}).Select(spendable, newTestSpendTarget(t, 100000)); !IsInsufficientValue(err) {
+		t.Errorf("got err %v, want InsufficientValueError", err)
+	}
+}
+
+func TestOldestFirst_Select(t *testing.T) {
+	spendable := newTestSpendable(5000, 100, 700, 800, 900)
+
+	s, err := OldestFirst{MaxInputs: 4}.Select(spendable, newTestSpendTarget(t, 1000))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the first pays, 100 is not worth spending, 700 and 800 are
+	// consolidated, and 900 is beyond MaxInputs.
+	if want := []uint64{5000, 700, 800}; !equalValues(selectedValues(s), want) {
+		t.Fatalf("got %v, want %v", selectedValues(s), want)
+	}
+
+	// 3 inputs, 2 outputs is 525 bytes
+	if s.Fee != 525 || s.Change != 6500-1000-525 {
+		t.Errorf("got fee %v change %v, want fee 525 change %v", s.Fee, s.Change, 6500-1000-525)
+	}
+
+	if s.Strategy != StrategyOldestFirst {
+		t.Errorf("got strategy %v, want %v", s.Strategy, StrategyOldestFirst)
+	}
+}
+
+func TestBranchAndBound_Select(t *testing.T) {
+	// 2 inputs, 1 output is 342 bytes. 10000 + 342 is paid by 7000 and
+	// 3342, leaving nothing for change.
+	spendable := newTestSpendable(9000, 7000, 1500, 3342, 12000)
+
+	s, err := BranchAndBound{}.Select(spendable, newTestSpendTarget(t, 10000))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []uint64{7000, 3342}; !equalValues(selectedValues(s), want) {
+		t.Fatalf("got %v, want %v", selectedValues(s), want)
+	}
+
+	if s.Fee != 342 || s.Change != 0 {
+		t.Errorf("got fee %v change %v, want fee 342 change 0", s.Fee, s.Change)
+	}
+
+	if s.Strategy != StrategyBranchAndBound {
+		t.Errorf("got strategy %v, want %v", s.Strategy, StrategyBranchAndBound)
+	}
+
+	// no exact match
+	spendable = newTestSpendable(50000)
+
+	if _, err := (BranchAndBound{}).Select(spendable, newTestSpendTarget(t, 10000)); err != ErrNoExactMatch {
+		t.Fatalf("got err %v, want %v", err, ErrNoExactMatch)
+	}
+
+	s, err = DefaultCoinSelector().Select(spendable, newTestSpendTarget(t, 10000))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s.Strategy != StrategyLargestFirst || s.Change == 0 {
+		t.Errorf("got strategy %v change %v, want fallback with change", s.Strategy, s.Change)
+	}
+}
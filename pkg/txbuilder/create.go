@@ -1,6 +1,8 @@
 package txbuilder
 
 import (
+	"errors"
+
 	"github.com/tokenized/smart-contract/pkg/txscript"
 	"github.com/tokenized/smart-contract/pkg/wire"
 
@@ -11,31 +13,18 @@ func Create(spendOuts []*TxOutput,
 	privateKey *PrivateKey,
 	spendOutputs []TxOutput) (*wire.MsgTx, error) {
 
-	var txOuts []*wire.TxOut
-	for _, spendOutput := range spendOutputs {
-		switch spendOutput.Type {
-		case OutputTypeP2PK:
-			pkScript, err := txscript.NewScriptBuilder().
-				AddOp(txscript.OP_DUP).
-				AddOp(txscript.OP_HASH160).
-				AddData(spendOutput.Address.ScriptAddress()).
-				AddOp(txscript.OP_EQUALVERIFY).
-				AddOp(txscript.OP_CHECKSIG).
-				Script()
-			if err != nil {
-				return nil, err
-			}
-			txOuts = append(txOuts, wire.NewTxOut(int64(spendOutput.Value), pkScript))
-		case OutputTypeReturn:
-			pkScript, err := txscript.NewScriptBuilder().
-				AddOp(txscript.OP_RETURN).
-				AddData(spendOutput.Data).
-				Script()
-			if err != nil {
-				return nil, err
-			}
-			txOuts = append(txOuts, wire.NewTxOut(0, pkScript))
-		}
+	return CreateWithSigner(spendOuts, NewKeySigner(privateKey.GetBtcEcPrivateKey()), spendOutputs)
+}
+
+// CreateWithSigner returns a tx spending the P2PKH outputs of the signer
+// to the outputs, with each input signed by the signer.
+func CreateWithSigner(spendOuts []*TxOutput,
+	signer Signer,
+	spendOutputs []TxOutput) (*wire.MsgTx, error) {
+
+	txOuts, err := buildTxOuts(spendOutputs)
+	if err != nil {
+		return nil, err
 	}
 
 	var txIns []*wire.TxIn
@@ -61,15 +50,11 @@ func Create(spendOuts []*TxOutput,
 	}
 
 	for i := 0; i < len(spendOuts); i++ {
-		signature, err := txscript.SignatureScript(
+		signature, err := signatureScript(signer,
 			tx,
 			i,
 			spendOuts[i].PkScript,
-			txscript.SigHashAll+SigHashForkID,
-			privateKey.GetBtcEcPrivateKey(),
-			true,
-			int64(spendOuts[i].Value),
-		)
+			spendOuts[i].Value)
 		if err != nil {
 			return nil, err
 		}
@@ -81,32 +66,9 @@ func Create(spendOuts []*TxOutput,
 func CreateUnsigned(spendOuts []*TxOutput,
 	spendOutputs []TxOutput) (*wire.MsgTx, error) {
 
-	var txOuts []*wire.TxOut
-	for _, spendOutput := range spendOutputs {
-		switch spendOutput.Type {
-		case OutputTypeP2PK:
-			pkScript, err := txscript.NewScriptBuilder().
-				AddOp(txscript.OP_DUP).
-				AddOp(txscript.OP_HASH160).
-				AddData(spendOutput.Address.ScriptAddress()).
-				AddOp(txscript.OP_EQUALVERIFY).
-				AddOp(txscript.OP_CHECKSIG).
-				Script()
-			if err != nil {
-				return nil, err
-			}
-			txOuts = append(txOuts, wire.NewTxOut(int64(spendOutput.Value), pkScript))
-		case OutputTypeReturn:
-			pkScript, err := txscript.NewScriptBuilder().
-				AddOp(txscript.OP_RETURN).
-				AddData(spendOutput.Data).
-				Script()
-			if err != nil {
-				return nil, err
-			}
-			txOuts = append(txOuts, wire.NewTxOut(0, pkScript))
-
-		}
+	txOuts, err := buildTxOuts(spendOutputs)
+	if err != nil {
+		return nil, err
 	}
 
 	var txIns []*wire.TxIn
@@ -133,3 +95,66 @@ func CreateUnsigned(spendOuts []*TxOutput,
 
 	return tx, nil
 }
+
+// buildTxOuts returns the outputs for a tx.
+func buildTxOuts(outputs []TxOutput) ([]*wire.TxOut, error) {
+	var txOuts []*wire.TxOut
+
+	for _, o := range outputs {
+		txOut, err := buildTxOut(o)
+		if err != nil {
+			return nil, err
+		}
+
+		txOuts = append(txOuts, txOut)
+	}
+
+	return txOuts, nil
+}
+
+// buildTxOut returns the output for a TxOutput.
+func buildTxOut(output TxOutput) (*wire.TxOut, error) {
+	switch output.Type {
+	case OutputTypeP2PK:
+		pkScript, err := txscript.NewScriptBuilder().
+			AddOp(txscript.OP_DUP).
+			AddOp(txscript.OP_HASH160).
+			AddData(output.Address.ScriptAddress()).
+			AddOp(txscript.OP_EQUALVERIFY).
+			AddOp(txscript.OP_CHECKSIG).
+			Script()
+		if err != nil {
+			return nil, err
+		}
+
+		return wire.NewTxOut(int64(output.Value), pkScript), nil
+
+	case OutputTypeReturn:
+		pkScript, err := txscript.NewScriptBuilder().
+			AddOp(txscript.OP_RETURN).
+			AddData(output.Data).
+			Script()
+		if err != nil {
+			return nil, err
+		}
+
+		return wire.NewTxOut(0, pkScript), nil
+
+	case OutputTypeP2SH:
+		pkScript, err := txscript.PayToAddrScript(output.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		return wire.NewTxOut(int64(output.Value), pkScript), nil
+
+	case OutputTypeMultiSig:
+		if txscript.GetScriptClass(output.PkScript) != txscript.MultiSigTy {
+			return nil, errors.New("output is not a multisig script")
+		}
+
+		return wire.NewTxOut(int64(output.Value), output.PkScript), nil
+	}
+
+	return nil, &UnknownOutputTypeError{Type: output.Type}
+}
@@ -0,0 +1,69 @@
+package txbuilder
+
+import (
+	"fmt"
+)
+
+// InsufficientValueError is returned when the spendable outputs do not pay
+// for a tx.
+type InsufficientValueError struct {
+	// Available is the value of the spendable outputs.
+	Available uint64
+
+	// Required is the value of the outputs of the tx, and the fee to spend
+	// all of the spendable outputs.
+	Required uint64
+}
+
+// Shortfall returns the value missing to pay for the tx.
+func (e *InsufficientValueError) Shortfall() uint64 {
+	if e.Available >= e.Required {
+		return 0
+	}
+
+	return e.Required - e.Available
+}
+
+func (e *InsufficientValueError) Error() string {
+	return fmt.Sprintf("unable to find enough value to spend : available %d, required %d, short %d",
+		e.Available, e.Required, e.Shortfall())
+}
+
+// IsInsufficientValue returns true if the error is an
+// InsufficientValueError.
+func IsInsufficientValue(err error) bool {
+	_, ok := err.(*InsufficientValueError)
+	return ok
+}
+
+// DustOutputError is returned when an output of a tx is worth less than
+// dust, so the tx would not be relayed.
+type DustOutputError struct {
+	Index int
+	Value uint64
+}
+
+func (e *DustOutputError) Error() string {
+	return fmt.Sprintf("output %d value %d is less than dust %d",
+		e.Index, e.Value, DustMinimumOutput)
+}
+
+// UnknownOutputTypeError is returned for an output of a type that can not
+// be built.
+type UnknownOutputTypeError struct {
+	Type TxOutputType
+}
+
+func (e *UnknownOutputTypeError) Error() string {
+	return fmt.Sprintf("unable to build output for output type %v", e.Type)
+}
+
+// VerifyError is returned when the script of an input of a tx fails.
+type VerifyError struct {
+	Index int
+	Err   error
+}
+
+func (e *VerifyError) Error() string {
+	return fmt.Sprintf("input %d failed verification : %v", e.Index, e.Err)
+}
@@ -0,0 +1,81 @@
+package txbuilder
+
+import (
+	"github.com/tokenized/smart-contract/pkg/wire"
+)
+
+const (
+	// DefaultSatsPerKB is the fee rate used when none is configured.
+	DefaultSatsPerKB = 500
+
+	// p2pkhSigScriptSize is the largest signature script that spends a
+	// P2PKH output. A push of a DER signature with the sighash type, and a
+	// push of a compressed public key.
+	p2pkhSigScriptSize = 1 + 73 + 1 + 33
+
+	// p2pkhInputSize is the largest serialized input that spends a P2PKH
+	// output. The outpoint, the script length, the script and the sequence.
+	p2pkhInputSize = 36 + 1 + p2pkhSigScriptSize + 4
+)
+
+// FeePolicy decides the fee paid by a tx, from its serialized size.
+type FeePolicy struct {
+	// SatsPerKB is the fee rate in satoshis per 1000 bytes.
+	SatsPerKB uint64
+}
+
+// NewFeePolicy returns a FeePolicy with the given rate in satoshis per 1000
+// bytes.
+func NewFeePolicy(satsPerKB uint64) FeePolicy {
+	return FeePolicy{
+		SatsPerKB: satsPerKB,
+	}
+}
+
+// DefaultFeePolicy returns a FeePolicy with the DefaultSatsPerKB rate.
+func DefaultFeePolicy() FeePolicy {
+	return NewFeePolicy(DefaultSatsPerKB)
+}
+
+// Fee returns the fee for a tx of the given size in bytes, rounded up to
+// the next satoshi.
+func (p FeePolicy) Fee(size int) uint64 {
+	return (uint64(size)*p.SatsPerKB + 999) / 1000
+}
+
+// InputFee returns the fee for adding one P2PKH input to a tx.
+func (p FeePolicy) InputFee() uint64 {
+	return p.Fee(p2pkhInputSize)
+}
+
+// EstimateFee returns the fee for a tx spending the given number of P2PKH
+// inputs to the given outputs, once it has been signed.
+func (p FeePolicy) EstimateFee(inputs int, outputs []TxOutput) (uint64, error) {
+	size, err := EstimateSize(inputs, outputs)
+	if err != nil {
+		return 0, err
+	}
+
+	return p.Fee(size), nil
+}
+
+// EstimateSize returns the serialized size of a tx spending the given
+// number of P2PKH inputs to the given outputs, once it has been signed.
+func EstimateSize(inputs int, outputs []TxOutput) (int, error) {
+	tx := wire.NewMsgTx(wire.TxVersion)
+
+	for i := 0; i < inputs; i++ {
+		tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, make([]byte, p2pkhSigScriptSize)))
+	}
+
+	for _, o := range outputs {
+		txOut, err := buildTxOut(o)
+		if err != nil {
+			return 0, err
+		}
+
+		tx.AddTxOut(txOut)
+	}
+
+	return tx.SerializeSize(), nil
+}
@@ -0,0 +1,266 @@
+package txbuilder
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+)
+
+func TestFeePolicy_Fee(t *testing.T) {
+	tests := []struct {
+		satsPerKB uint64
+		size      int
+		want      uint64
+	}{
+		{500, 193, 97},
+		{500, 1000, 500},
+		{1000, 227, 227},
+		{250, 4, 1},
+		{0, 1000, 0},
+	}
+
+	for _, tt := range tests {
+		got := NewFeePolicy(tt.satsPerKB).Fee(tt.size)
+		if got != tt.want {
+			t.Errorf("%v sat/KB, %v bytes : got %v, want %v",
+				tt.satsPerKB, tt.size, got, tt.want)
+		}
+	}
+}
+
+func TestEstimateSize(t *testing.T) {
+	address, err := GetAddressFromString("18chgevayKE8fQDDVsopokEnVSugjFRJGL",
+		&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p2pkh := TxOutput{
+		Type:    OutputTypeP2PK,
+		Address: address,
+	}
+
+	opReturn := TxOutput{
+		Type: OutputTypeReturn,
+		Data: make([]byte, 100),
+	}
+
+	tests := []struct {
+		name    string
+		inputs  int
+		outputs []TxOutput
+		want    int
+	}{
+		{
+			// version + counts + lock time, and nothing else
+			name: "empty",
+			want: 10,
+		},
+		{
+			// 149 byte input, 34 byte output
+			name:    "p2pkh",
+			inputs:  1,
+			outputs: []TxOutput{p2pkh},
+			want:    193,
+		},
+		{
+			// 8 value, 1 script length, OP_RETURN, OP_PUSHDATA1 100, and
+			// the data
+			name:    "op_return",
+			inputs:  2,
+			outputs: []TxOutput{p2pkh, opReturn},
+			want:    10 + 2*149 + 34 + 112,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EstimateSize(tt.inputs, tt.outputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildUnsignedWithTxOuts_fee(t *testing.T) {
+	recipient, err := GetAddressFromString("18chgevayKE8fQDDVsopokEnVSugjFRJGL",
+		&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	outputs := []TxOutput{
+		TxOutput{
+			Address: recipient,
+			Value:   546,
+			Type:    OutputTypeP2PK,
+		},
+	}
+
+	tests := []struct {
+		name      string
+		satsPerKB uint64
+		input     uint64
+		wantFee   uint64
+		wantOuts  int
+	}{
+		{
+			// 227 bytes with change
+			name:      "change",
+			satsPerKB: 500,
+			input:     10000,
+			wantFee:   114,
+			wantOuts:  2,
+		},
+		{
+			name:      "higher rate",
+			satsPerKB: 1000,
+			input:     10000,
+			wantFee:   227,
+			wantOuts:  2,
+		},
+		{
+			// change would be dust, so it is left to the miner
+			name:      "dust change",
+			satsPerKB: 500,
+			input:     1100,
+			wantFee:   554,
+			wantOuts:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash := chainhash.Hash{1}
+
+			spendableTxOuts := []*TxOutput{
+				&TxOutput{
+					TransactionHash: hash.CloneBytes(),
+					Index:           0,
+					Value:           tt.input,
+				},
+			}
+
+			tx, _, err := BuildUnsignedWithTxOuts(outputs,
+				spendableTxOuts,
+				recipient,
+				NewFeePolicy(tt.satsPerKB),
+				nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(tx.MsgTx.TxOut) != tt.wantOuts {
+				t.Fatalf("got %v outputs, want %v", len(tx.MsgTx.TxOut), tt.wantOuts)
+			}
+
+			var out uint64
+			for _, o := range tx.MsgTx.TxOut {
+				out += uint64(o.Value)
+			}
+
+			if got := tt.input - out; got != tt.wantFee {
+				t.Errorf("got fee %v, want %v", got, tt.wantFee)
+			}
+		})
+	}
+}
+
+func TestTxBuilder_Build_fee(t *testing.T) {
+	wif, err := btcutil.DecodeWIF("5JhvsapkHeHjy2FiUQYwXh1d74evuMd3rGcKGnifCdFR5G8e6nH")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	address, err := btcutil.NewAddressPubKeyHash(
+		btcutil.Hash160(wif.PrivKey.PubKey().SerializeCompressed()),
+		&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recipient, err := GetAddressFromString("18chgevayKE8fQDDVsopokEnVSugjFRJGL",
+		&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pkScript, err := txscript.PayToAddrScript(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	utxos := UTXOs{
+		NewUTXO(chainhash.Hash{1}, 0, pkScript, 10000),
+	}
+
+	outs := []PayAddress{
+		NewPayAddress(recipient, 1000),
+	}
+
+	// OP_RETURN with a 20 byte push
+	payload := append([]byte{0x6a, 0x14}, make([]byte, 20)...)
+
+	builder := NewTxBuilder(wif.PrivKey, DefaultFeePolicy())
+
+	override := NewFeePolicy(1000)
+
+	tests := []struct {
+		name   string
+		policy *FeePolicy
+		rate   uint64
+	}{
+		{
+			name: "default",
+			rate: DefaultSatsPerKB,
+		},
+		{
+			name:   "override",
+			policy: &override,
+			rate:   1000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, report, err := builder.Build(utxos, outs, address, payload, tt.policy)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// recipient, change and OP_RETURN
+			if len(tx.TxOut) != 3 {
+				t.Fatalf("got %v outputs, want 3", len(tx.TxOut))
+			}
+
+			var out uint64
+			for _, o := range tx.TxOut {
+				out += uint64(o.Value)
+			}
+
+			// 10 + 149 + 34 + 34 + 31
+			want := NewFeePolicy(tt.rate).Fee(258)
+
+			if got := uint64(10000) - out; got != want {
+				t.Errorf("got fee %v, want %v", got, want)
+			}
+
+			if report.Fee != want || report.InputValue != 10000 {
+				t.Errorf("got report %v, want fee %v", report, want)
+			}
+
+			// the estimate never underpays the signed tx
+			if size := tx.SerializeSize(); size > 258 {
+				t.Errorf("got signed size %v, want <= 258", size)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package txbuilder
+
+import (
+	"github.com/tokenized/smart-contract/pkg/txscript"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+)
+
+// MultiSigScript returns an m-of-n multisig script, where required of the
+// public keys must sign. It can be used as a bare multisig locking script,
+// or as a P2SH redeem script.
+func MultiSigScript(pubKeys []*btcec.PublicKey,
+	required int,
+	params *chaincfg.Params) ([]byte, error) {
+
+	addresses := []*btcutil.AddressPubKey{}
+
+	for _, pub := range pubKeys {
+		a, err := btcutil.NewAddressPubKey(pub.SerializeCompressed(), params)
+		if err != nil {
+			return nil, err
+		}
+
+		addresses = append(addresses, a)
+	}
+
+	return txscript.MultiSigScript(addresses, required)
+}
+
+// ScriptAddress returns the P2SH address of a script.
+//
+// A bare multisig output has no address of its own, so it is identified by
+// the P2SH address of its script. Both forms of a multisig are then the same
+// authority.
+func ScriptAddress(script []byte, params *chaincfg.Params) (btcutil.Address, error) {
+	return btcutil.NewAddressScriptHash(script, params)
+}
+
+// NewP2SHOutput returns a TxOutput paying to the hash of a redeem script.
+func NewP2SHOutput(redeemScript []byte,
+	value uint64,
+	params *chaincfg.Params) (TxOutput, error) {
+
+	address, err := ScriptAddress(redeemScript, params)
+	if err != nil {
+		return TxOutput{}, err
+	}
+
+	return TxOutput{
+		Type:         OutputTypeP2SH,
+		Address:      address,
+		RedeemScript: redeemScript,
+		Value:        value,
+	}, nil
+}
+
+// NewMultiSigOutput returns a TxOutput paying to a bare multisig script.
+func NewMultiSigOutput(script []byte, value uint64) TxOutput {
+	return TxOutput{
+		Type:     OutputTypeMultiSig,
+		PkScript: script,
+		Value:    value,
+	}
+}
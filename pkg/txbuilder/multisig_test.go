@@ -0,0 +1,140 @@
+package txbuilder
+
+import (
+	"testing"
+
+	"github.com/tokenized/smart-contract/pkg/wire"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+func newTestMultiSigScript(t *testing.T) ([]byte, []*btcec.PrivateKey) {
+	keys := []*btcec.PrivateKey{
+		newTestKey(t, 1),
+		newTestKey(t, 2),
+		newTestKey(t, 3),
+	}
+
+	pubKeys := []*btcec.PublicKey{}
+	for _, key := range keys {
+		pubKeys = append(pubKeys, key.PubKey())
+	}
+
+	script, err := MultiSigScript(pubKeys, 2, &chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return script, keys
+}
+
+func TestUTXO_PublicAddress_multiSig(t *testing.T) {
+	params := &chaincfg.MainNetParams
+
+	script, _ := newTestMultiSigScript(t)
+
+	p2sh, err := NewP2SHOutput(script, 1000, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := wire.NewMsgTx(2)
+	for _, o := range []TxOutput{p2sh, NewMultiSigOutput(script, 1000)} {
+		txOut, err := buildTxOut(o)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tx.AddTxOut(txOut)
+	}
+
+	utxos := UTXOs{
+		NewUTXOFromTX(*tx, 0),
+		NewUTXOFromTX(*tx, 1),
+	}
+
+	// both forms of the multisig are the same authority
+	for i, u := range utxos {
+		address, err := u.PublicAddress(params)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if address.EncodeAddress() != p2sh.Address.EncodeAddress() {
+			t.Errorf("utxo %v : got %v, want %v", i, address, p2sh.Address)
+		}
+	}
+
+	filtered, err := utxos.ForAddress(p2sh.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(filtered) != 2 {
+		t.Errorf("got %v utxos, want 2", len(filtered))
+	}
+
+	if AddressOutputType(p2sh.Address) != OutputTypeP2SH {
+		t.Errorf("got %v, want %v", AddressOutputType(p2sh.Address), OutputTypeP2SH)
+	}
+}
+
+func TestPartialTx_Sign_p2sh(t *testing.T) {
+	params := &chaincfg.MainNetParams
+
+	script, keys := newTestMultiSigScript(t)
+
+	p2sh, err := NewP2SHOutput(script, 5000, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	txOut, err := buildTxOut(p2sh)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spent := []*TxOutput{
+		&TxOutput{
+			PkScript:     txOut.PkScript,
+			Value:        p2sh.Value,
+			RedeemScript: script,
+		},
+	}
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{1}}, nil))
+	tx.AddTxOut(wire.NewTxOut(4000, txOut.PkScript))
+
+	first, err := NewPartialTx(tx, spent)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := NewPartialTx(tx, spent)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := first.Sign(params, keys[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	if first.IsComplete(params) {
+		t.Fatalf("got complete with 1 of 2 signatures, want incomplete")
+	}
+
+	if _, err := second.Sign(params, keys[2]); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := first.Merge(params, second); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := first.Finalize(params); err != nil {
+		t.Fatal(err)
+	}
+}
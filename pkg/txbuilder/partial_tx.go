@@ -0,0 +1,431 @@
+package txbuilder
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/tokenized/smart-contract/pkg/txscript"
+	"github.com/tokenized/smart-contract/pkg/wire"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
+)
+
+const (
+	// partialTxVersion is the version of the serialized PartialTx format.
+	partialTxVersion = 1
+
+	// maxPartialScriptSize is the largest script read when deserializing a
+	// PartialTx.
+	maxPartialScriptSize = 10000
+)
+
+var (
+	// ErrIncompleteTx is returned when a PartialTx is finalised before all
+	// inputs are signed.
+	ErrIncompleteTx = errors.New("tx is not fully signed")
+
+	// ErrPartialTxMismatch is returned when merging PartialTxs that are not
+	// for the same tx.
+	ErrPartialTxMismatch = errors.New("partial txs do not match")
+)
+
+// PartialInput is the output spent by an input of a PartialTx.
+type PartialInput struct {
+	PkScript []byte
+	Value    uint64
+
+	// RedeemScript is the script for a pay-to-script-hash PkScript.
+	RedeemScript []byte
+}
+
+// PartialTx is a tx that is signed by more than one party.
+//
+// It carries the outputs spent by each input, so each party can sign the
+// inputs they hold keys for without access to the outputs being spent. The
+// signatures of each party are merged, and the tx is finalised once every
+// input is signed.
+type PartialTx struct {
+	MsgTx  *wire.MsgTx
+	Inputs []PartialInput
+}
+
+// NewPartialTx returns a PartialTx for tx, spending the given outputs. There
+// must be one output for each input, in the same order.
+func NewPartialTx(tx *wire.MsgTx, spent []*TxOutput) (*PartialTx, error) {
+	if len(tx.TxIn) != len(spent) {
+		return nil, errors.New("spent outputs do not match inputs")
+	}
+
+	inputs := make([]PartialInput, len(spent))
+
+	for i, o := range spent {
+		inputs[i] = PartialInput{
+			PkScript:     o.PkScript,
+			Value:        o.Value,
+			RedeemScript: o.RedeemScript,
+		}
+	}
+
+	return &PartialTx{
+		MsgTx:  tx.Copy(),
+		Inputs: inputs,
+	}, nil
+}
+
+// PartialTx returns a PartialTx for a Tx built by BuildUnsignedWithTxOuts,
+// so it can be passed to each party to sign.
+func (t Tx) PartialTx() (*PartialTx, error) {
+	if t.Selection == nil {
+		return nil, errors.New("missing spent outputs")
+	}
+
+	return NewPartialTx(t.MsgTx, t.Selection.Inputs)
+}
+
+// Sign signs every input that can be signed with the keys, merging the
+// signatures with any that are already present. The number of inputs signed
+// is returned.
+func (p *PartialTx) Sign(params *chaincfg.Params,
+	keys ...*btcec.PrivateKey) (int, error) {
+
+	kdb := newKeyDB(keys)
+
+	signed := 0
+
+	for i, in := range p.Inputs {
+		if !p.canSign(params, kdb, in) {
+			continue
+		}
+
+		sdb := txscript.ScriptClosure(func(btcutil.Address) ([]byte, error) {
+			if len(in.RedeemScript) == 0 {
+				return nil, errors.New("missing redeem script")
+			}
+
+			return in.RedeemScript, nil
+		})
+
+		script, err := txscript.SignTxOutput(params,
+			p.MsgTx,
+			i,
+			in.PkScript,
+			txscript.SigHashAll+SigHashForkID,
+			kdb,
+			sdb,
+			p.MsgTx.TxIn[i].SignatureScript,
+			int64(in.Value))
+		if err != nil {
+			return signed, err
+		}
+
+		p.MsgTx.TxIn[i].SignatureScript = script
+		signed++
+	}
+
+	return signed, nil
+}
+
+// SignWith signs every unsigned P2PKH input that pays to the key of the
+// Signer. The number of inputs signed is returned.
+//
+// Unlike Sign, the key is not needed, so only inputs the Signer can sign
+// alone are signed.
+func (p *PartialTx) SignWith(params *chaincfg.Params, signer Signer) (int, error) {
+	pkHash := btcutil.Hash160(signer.PublicKey().SerializeCompressed())
+
+	signed := 0
+
+	for i, in := range p.Inputs {
+		if len(p.MsgTx.TxIn[i].SignatureScript) > 0 {
+			continue
+		}
+
+		class, addresses, _, err := txscript.ExtractPkScriptAddrs(in.PkScript, params)
+		if err != nil || class != txscript.PubKeyHashTy ||
+			!bytes.Equal(addresses[0].ScriptAddress(), pkHash) {
+			continue
+		}
+
+		script, err := signatureScript(signer, p.MsgTx, i, in.PkScript, in.Value)
+		if err != nil {
+			return signed, err
+		}
+
+		p.MsgTx.TxIn[i].SignatureScript = script
+		signed++
+	}
+
+	return signed, nil
+}
+
+// Merge adds the signatures from another PartialTx for the same tx.
+func (p *PartialTx) Merge(params *chaincfg.Params, other *PartialTx) error {
+	if len(p.Inputs) != len(other.Inputs) ||
+		unsignedHash(p.MsgTx) != unsignedHash(other.MsgTx) {
+		return ErrPartialTxMismatch
+	}
+
+	for i, in := range p.Inputs {
+		if !bytes.Equal(in.PkScript, other.Inputs[i].PkScript) ||
+			in.Value != other.Inputs[i].Value {
+			return ErrPartialTxMismatch
+		}
+
+		script, err := txscript.MergeScripts(params,
+			p.MsgTx,
+			i,
+			in.PkScript,
+			other.MsgTx.TxIn[i].SignatureScript,
+			p.MsgTx.TxIn[i].SignatureScript,
+			int64(in.Value))
+		if err != nil {
+			return err
+		}
+
+		p.MsgTx.TxIn[i].SignatureScript = script
+
+		if len(in.RedeemScript) == 0 {
+			p.Inputs[i].RedeemScript = other.Inputs[i].RedeemScript
+		}
+	}
+
+	return nil
+}
+
+// IsComplete returns true if every input has all the signatures it needs.
+//
+// The signatures are counted, not verified.
+func (p *PartialTx) IsComplete(params *chaincfg.Params) bool {
+	for i, in := range p.Inputs {
+		if !isSigned(params, in.PkScript, p.MsgTx.TxIn[i].SignatureScript) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Finalize returns the signed tx, or ErrIncompleteTx if any input is
+// missing signatures.
+func (p *PartialTx) Finalize(params *chaincfg.Params) (*wire.MsgTx, error) {
+	if !p.IsComplete(params) {
+		return nil, ErrIncompleteTx
+	}
+
+	return p.MsgTx.Copy(), nil
+}
+
+// Serialize writes the PartialTx, so it can be passed to another party.
+func (p *PartialTx) Serialize(w io.Writer) error {
+	if _, err := w.Write([]byte{partialTxVersion}); err != nil {
+		return err
+	}
+
+	if err := p.MsgTx.Serialize(w); err != nil {
+		return err
+	}
+
+	if err := wire.WriteVarInt(w, 0, uint64(len(p.Inputs))); err != nil {
+		return err
+	}
+
+	for _, in := range p.Inputs {
+		if err := binary.Write(w, binary.LittleEndian, in.Value); err != nil {
+			return err
+		}
+
+		if err := wire.WriteVarBytes(w, 0, in.PkScript); err != nil {
+			return err
+		}
+
+		if err := wire.WriteVarBytes(w, 0, in.RedeemScript); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Deserialize reads a PartialTx written by Serialize.
+func (p *PartialTx) Deserialize(r io.Reader) error {
+	version := make([]byte, 1)
+	if _, err := io.ReadFull(r, version); err != nil {
+		return err
+	}
+
+	if version[0] != partialTxVersion {
+		return errors.New("unknown partial tx version")
+	}
+
+	tx := wire.MsgTx{}
+	if err := tx.Deserialize(r); err != nil {
+		return err
+	}
+
+	count, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return err
+	}
+
+	if count != uint64(len(tx.TxIn)) {
+		return errors.New("spent outputs do not match inputs")
+	}
+
+	inputs := make([]PartialInput, count)
+
+	for i := range inputs {
+		if err := binary.Read(r, binary.LittleEndian, &inputs[i].Value); err != nil {
+			return err
+		}
+
+		inputs[i].PkScript, err = wire.ReadVarBytes(r, 0, maxPartialScriptSize, "pkScript")
+		if err != nil {
+			return err
+		}
+
+		inputs[i].RedeemScript, err = wire.ReadVarBytes(r, 0, maxPartialScriptSize, "redeemScript")
+		if err != nil {
+			return err
+		}
+	}
+
+	p.MsgTx = &tx
+	p.Inputs = inputs
+
+	return nil
+}
+
+// canSign returns true if any of the keys can sign the input.
+func (p *PartialTx) canSign(params *chaincfg.Params,
+	kdb txscript.KeyClosure,
+	in PartialInput) bool {
+
+	script := in.PkScript
+	if txscript.GetScriptClass(script) == txscript.ScriptHashTy {
+		script = in.RedeemScript
+	}
+
+	_, addresses, _, err := txscript.ExtractPkScriptAddrs(script, params)
+	if err != nil {
+		return false
+	}
+
+	for _, a := range addresses {
+		if _, _, err := kdb.GetKey(a); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newKeyDB returns a KeyDB that finds the key for an address from the
+// given keys.
+func newKeyDB(keys []*btcec.PrivateKey) txscript.KeyClosure {
+	return func(a btcutil.Address) (*btcec.PrivateKey, bool, error) {
+		for _, key := range keys {
+			pub := key.PubKey()
+
+			switch addr := a.(type) {
+			case *btcutil.AddressPubKeyHash:
+				h := addr.Hash160()
+				if bytes.Equal(btcutil.Hash160(pub.SerializeCompressed()), h[:]) {
+					return key, true, nil
+				}
+				if bytes.Equal(btcutil.Hash160(pub.SerializeUncompressed()), h[:]) {
+					return key, false, nil
+				}
+
+			case *btcutil.AddressPubKey:
+				if addr.PubKey().IsEqual(pub) {
+					return key, addr.Format() == btcutil.PKFCompressed, nil
+				}
+			}
+		}
+
+		return nil, false, errors.New("no key for address")
+	}
+}
+
+// isSigned returns true if the signature script has all the signatures
+// needed to spend the pkScript.
+func isSigned(params *chaincfg.Params, pkScript, sigScript []byte) bool {
+	pushes, err := txscript.PushedData(sigScript)
+	if err != nil {
+		return false
+	}
+
+	class, _, required, err := txscript.ExtractPkScriptAddrs(pkScript, params)
+	if err != nil {
+		return false
+	}
+
+	switch class {
+	case txscript.PubKeyTy:
+		return len(pushes) == 1 && len(pushes[0]) > 0
+
+	case txscript.PubKeyHashTy:
+		return len(pushes) == 2 && len(pushes[0]) > 0 && len(pushes[1]) > 0
+
+	case txscript.MultiSigTy:
+		sigs := 0
+		for _, push := range pushes {
+			if len(push) > 0 {
+				sigs++
+			}
+		}
+
+		return sigs >= required
+
+	case txscript.ScriptHashTy:
+		if len(pushes) == 0 {
+			return false
+		}
+
+		// the redeem script is the last push
+		redeemScript := pushes[len(pushes)-1]
+		if !bytes.Equal(btcutil.Hash160(redeemScript), pkScriptHash(pkScript)) {
+			return false
+		}
+
+		builder := txscript.NewScriptBuilder()
+		for _, push := range pushes[:len(pushes)-1] {
+			builder.AddData(push)
+		}
+
+		script, err := builder.Script()
+		if err != nil {
+			return false
+		}
+
+		return isSigned(params, redeemScript, script)
+	}
+
+	return false
+}
+
+// pkScriptHash returns the script hash of a pay-to-script-hash pkScript.
+func pkScriptHash(pkScript []byte) []byte {
+	pushes, err := txscript.PushedData(pkScript)
+	if err != nil || len(pushes) != 1 {
+		return nil
+	}
+
+	return pushes[0]
+}
+
+// unsignedHash returns the hash of a tx without its signature scripts.
+func unsignedHash(tx *wire.MsgTx) chainhash.Hash {
+	c := tx.Copy()
+
+	for _, in := range c.TxIn {
+		in.SignatureScript = nil
+	}
+
+	return c.TxHash()
+}
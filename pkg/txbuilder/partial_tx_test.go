@@ -0,0 +1,182 @@
+package txbuilder
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/tokenized/smart-contract/pkg/txscript"
+	"github.com/tokenized/smart-contract/pkg/wire"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
+)
+
+func newTestKey(t *testing.T, b byte) *btcec.PrivateKey {
+	key, _ := btcec.PrivKeyFromBytes(btcec.S256(), bytes.Repeat([]byte{b}, 32))
+
+	return key
+}
+
+func newTestP2PKHScript(t *testing.T, key *btcec.PrivateKey) []byte {
+	address, err := btcutil.NewAddressPubKeyHash(
+		btcutil.Hash160(key.PubKey().SerializeCompressed()),
+		&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	script, err := txscript.PayToAddrScript(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return script
+}
+
+func newTestPartialTx(t *testing.T, spent []*TxOutput) *PartialTx {
+	tx := wire.NewMsgTx(2)
+
+	for i := range spent {
+		tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{byte(i + 1)}}, nil))
+	}
+
+	tx.AddTxOut(wire.NewTxOut(1000, spent[0].PkScript))
+
+	p, err := NewPartialTx(tx, spent)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return p
+}
+
+func TestPartialTx_Merge(t *testing.T) {
+	params := &chaincfg.MainNetParams
+
+	alice := newTestKey(t, 1)
+	bob := newTestKey(t, 2)
+
+	// an exchange, with an input from each party
+	spent := []*TxOutput{
+		&TxOutput{PkScript: newTestP2PKHScript(t, alice), Value: 2000},
+		&TxOutput{PkScript: newTestP2PKHScript(t, bob), Value: 3000},
+	}
+
+	p := newTestPartialTx(t, spent)
+
+	// pass the tx to bob, without any keys
+	var buf bytes.Buffer
+	if err := p.Serialize(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	bobs := &PartialTx{}
+	if err := bobs.Deserialize(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	if n, err := p.Sign(params, alice); err != nil || n != 1 {
+		t.Fatalf("got %v signed, err %v, want 1 signed", n, err)
+	}
+
+	if p.IsComplete(params) {
+		t.Fatalf("got complete, want incomplete")
+	}
+
+	if _, err := p.Finalize(params); err != ErrIncompleteTx {
+		t.Fatalf("got err %v, want %v", err, ErrIncompleteTx)
+	}
+
+	if n, err := bobs.Sign(params, bob); err != nil || n != 1 {
+		t.Fatalf("got %v signed, err %v, want 1 signed", n, err)
+	}
+
+	if err := p.Merge(params, bobs); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err := p.Finalize(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// signatures are deterministic, so the merged tx is the same as one
+	// signed with both keys.
+	both := newTestPartialTx(t, spent)
+	if _, err := both.Sign(params, alice, bob); err != nil {
+		t.Fatal(err)
+	}
+
+	if tx.TxHash() != both.MsgTx.TxHash() {
+		t.Errorf("got tx %s, want %s", tx.TxHash(), both.MsgTx.TxHash())
+	}
+
+	// a different tx
+	other := newTestPartialTx(t, spent)
+	other.MsgTx.TxOut[0].Value = 999
+
+	if err := p.Merge(params, other); err != ErrPartialTxMismatch {
+		t.Errorf("got err %v, want %v", err, ErrPartialTxMismatch)
+	}
+}
+
+func TestPartialTx_Merge_multiSig(t *testing.T) {
+	params := &chaincfg.MainNetParams
+
+	alice := newTestKey(t, 1)
+	bob := newTestKey(t, 2)
+
+	addresses := []*btcutil.AddressPubKey{}
+	for _, key := range []*btcec.PrivateKey{alice, bob} {
+		a, err := btcutil.NewAddressPubKey(key.PubKey().SerializeCompressed(), params)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		addresses = append(addresses, a)
+	}
+
+	script, err := txscript.MultiSigScript(addresses, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spent := []*TxOutput{
+		&TxOutput{PkScript: script, Value: 5000},
+	}
+
+	p := newTestPartialTx(t, spent)
+	bobs := newTestPartialTx(t, spent)
+
+	if _, err := p.Sign(params, alice); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := bobs.Sign(params, bob); err != nil {
+		t.Fatal(err)
+	}
+
+	if p.IsComplete(params) || bobs.IsComplete(params) {
+		t.Fatalf("got complete with one signature, want incomplete")
+	}
+
+	if err := p.Merge(params, bobs); err != nil {
+		t.Fatal(err)
+	}
+
+	if !p.IsComplete(params) {
+		t.Fatalf("got incomplete, want complete")
+	}
+
+	pushes, err := txscript.PushedData(p.MsgTx.TxIn[0].SignatureScript)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// OP_FALSE and a signature from each key
+	if len(pushes) != 3 {
+		t.Errorf("got %v pushes, want 3", len(pushes))
+	}
+}
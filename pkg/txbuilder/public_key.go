@@ -3,8 +3,9 @@ package txbuilder
 import (
 	"fmt"
 
-	"github.com/btcsuite/btcutil"
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
 )
 
 func GetPublicKey(pkBytes []byte) PublicKey {
@@ -32,6 +33,6 @@ func (k PublicKey) GetSerializedString() string {
 	return fmt.Sprintf("%x", k.GetSerialized())
 }
 
-func (k PublicKey) GetAddress() (btcutil.Address, error) {
-	return GetAddress(k.GetSerialized())
+func (k PublicKey) GetAddress(params *chaincfg.Params) (btcutil.Address, error) {
+	return GetAddress(k.GetSerialized(), params)
 }
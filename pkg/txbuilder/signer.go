@@ -0,0 +1,70 @@
+package txbuilder
+
+import (
+	"github.com/tokenized/smart-contract/pkg/txscript"
+	"github.com/tokenized/smart-contract/pkg/wire"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+// Signer signs the inputs of a tx with a key that the caller does not need
+// to hold.
+type Signer interface {
+	// PublicKey returns the public key of the signing key.
+	PublicKey() *btcec.PublicKey
+
+	// SignInput returns the signature of an input of the tx, which spends
+	// an output with the pkScript and value. The hash type is appended to
+	// the signature.
+	SignInput(tx *wire.MsgTx,
+		index int,
+		pkScript []byte,
+		value uint64,
+		hashType txscript.SigHashType) ([]byte, error)
+}
+
+// KeySigner is a Signer for a key held in memory.
+type KeySigner struct {
+	key *btcec.PrivateKey
+}
+
+// NewKeySigner returns a KeySigner for the key.
+func NewKeySigner(key *btcec.PrivateKey) KeySigner {
+	return KeySigner{
+		key: key,
+	}
+}
+
+// PublicKey implements the Signer interface.
+func (s KeySigner) PublicKey() *btcec.PublicKey {
+	return s.key.PubKey()
+}
+
+// SignInput implements the Signer interface.
+func (s KeySigner) SignInput(tx *wire.MsgTx,
+	index int,
+	pkScript []byte,
+	value uint64,
+	hashType txscript.SigHashType) ([]byte, error) {
+
+	return txscript.RawTxInSignature(tx, index, pkScript, hashType, s.key, int64(value))
+}
+
+// signatureScript returns the script that spends the P2PKH output with the
+// pkScript and value to the input of the tx.
+func signatureScript(signer Signer,
+	tx *wire.MsgTx,
+	index int,
+	pkScript []byte,
+	value uint64) ([]byte, error) {
+
+	sig, err := signer.SignInput(tx, index, pkScript, value, txscript.SigHashAll+SigHashForkID)
+	if err != nil {
+		return nil, err
+	}
+
+	return txscript.NewScriptBuilder().
+		AddData(sig).
+		AddData(signer.PublicKey().SerializeCompressed()).
+		Script()
+}
@@ -9,6 +9,12 @@ type Tx struct {
 	SelfPkHash []byte
 	MsgTx      *wire.MsgTx
 	Inputs     []*TxInput
+
+	// Selection is how the inputs were chosen.
+	Selection *Selection
+
+	// Report describes how the tx was paid for.
+	Report *BuildReport
 }
 
 type TxOutSortByValue []*TxOutput
@@ -4,11 +4,15 @@ import (
 	"bytes"
 	"encoding/hex"
 	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
 )
 
 func TestBuildUnsigned(t *testing.T) {
 	recipientAddress := "18chgevayKE8fQDDVsopokEnVSugjFRJGL"
-	recipient, err := GetAddressFromString(recipientAddress)
+	recipient, err := GetAddressFromString(recipientAddress, &chaincfg.MainNetParams)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -43,7 +47,7 @@ func TestBuildUnsigned(t *testing.T) {
 		},
 	}
 
-	tx, _, err := BuildUnsignedWithTxOuts(outputs, spendableTxOuts, recipient)
+	tx, _, err := BuildUnsignedWithTxOuts(outputs, spendableTxOuts, recipient, DefaultFeePolicy(), nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -63,3 +67,146 @@ func TestBuildUnsigned(t *testing.T) {
 		t.Errorf("got\n%s\nwant\n%s", string(got), want)
 	}
 }
+
+func TestTxBuilder_Build_change(t *testing.T) {
+	key := newTestKey(t, 1)
+	pkScript := newTestP2PKHScript(t, key)
+
+	party, err := btcutil.NewAddressPubKeyHash(
+		btcutil.Hash160(newTestKey(t, 2).PubKey().SerializeCompressed()),
+		&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	utxos := UTXOs{
+		NewUTXO(chainhash.Hash{1}, 0, pkScript, 10000),
+	}
+
+	// the change address is also paid by the first output
+	outs := []PayAddress{
+		NewPayAddress(party, 546),
+		NewPayAddress(decodeAddress("18chgevayKE8fQDDVsopokEnVSugjFRJGL"), 546),
+	}
+
+	payload := append([]byte{0x6a, 0x14}, make([]byte, 20)...)
+
+	tx, report, err := NewTxBuilder(key, DefaultFeePolicy()).Build(utxos, outs, party, payload, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// outputs, change and OP_RETURN
+	if len(tx.TxOut) != 4 {
+		t.Fatalf("got %d outputs, want 4", len(tx.TxOut))
+	}
+
+	for i, o := range outs {
+		if tx.TxOut[i].Value != int64(o.Value) {
+			t.Errorf("output %d : got value %d, want %d", i, tx.TxOut[i].Value, o.Value)
+		}
+	}
+
+	if tx.TxOut[2].Value != int64(report.Change) || report.Change == 0 {
+		t.Errorf("got change %d, want %d", tx.TxOut[2].Value, report.Change)
+	}
+
+	if !bytes.Equal(tx.TxOut[2].PkScript, tx.TxOut[0].PkScript) {
+		t.Errorf("change not paid to the change address")
+	}
+}
+
+func TestTxBuilder_Build_payload(t *testing.T) {
+	key := newTestKey(t, 1)
+	pkScript := newTestP2PKHScript(t, key)
+
+	utxos := UTXOs{
+		NewUTXO(chainhash.Hash{1}, 0, pkScript, 10000),
+	}
+
+	change := decodeAddress("18chgevayKE8fQDDVsopokEnVSugjFRJGL")
+	data := bytes.Repeat([]byte{0x01}, 20)
+
+	tests := []struct {
+		name    string
+		payload []byte
+		wantErr bool
+	}{
+		{
+			name:    "OP_RETURN",
+			payload: append([]byte{0x6a, 0x14}, data...),
+		},
+		{
+			name:    "OP_FALSE OP_RETURN",
+			payload: append([]byte{0x00, 0x6a, 0x14}, data...),
+		},
+		{
+			name:    "OP_PUSHDATA2",
+			payload: append([]byte{0x6a, 0x4d, 0x14, 0x00}, data...),
+		},
+		{
+			name:    "truncated",
+			payload: append([]byte{0x6a, 0x4c, 0x20}, data...),
+			wantErr: true,
+		},
+		{
+			name:    "not OP_RETURN",
+			payload: pkScript,
+			wantErr: true,
+		},
+		{
+			name:    "empty",
+			payload: []byte{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, _, err := NewTxBuilder(key, DefaultFeePolicy()).Build(utxos, nil, change, tt.payload, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("built tx from a malformed payload")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// the OP_RETURN is always written as a single push
+			got := tx.TxOut[len(tx.TxOut)-1].PkScript
+			want := append([]byte{0x6a, 0x14}, data...)
+
+			if !bytes.Equal(got, want) {
+				t.Errorf("got %x, want %x", got, want)
+			}
+		})
+	}
+}
+
+func TestTxBuilder_Build_noCoinSelector(t *testing.T) {
+	key := newTestKey(t, 1)
+
+	utxos := UTXOs{
+		NewUTXO(chainhash.Hash{1}, 0, newTestP2PKHScript(t, key), 10000),
+	}
+
+	change := decodeAddress("18chgevayKE8fQDDVsopokEnVSugjFRJGL")
+	payload := append([]byte{0x6a, 0x14}, make([]byte, 20)...)
+
+	b := TxBuilder{
+		Signer:    NewKeySigner(key),
+		FeePolicy: DefaultFeePolicy(),
+	}
+
+	tx, _, err := b.Build(utxos, nil, change, payload, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tx.TxIn) != 1 {
+		t.Errorf("got %d inputs, want 1", len(tx.TxIn))
+	}
+}
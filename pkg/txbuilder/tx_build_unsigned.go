@@ -8,11 +8,11 @@ import (
 
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
-	"github.com/btcsuite/btcutil"
 )
 
 // buildUnsignedHardCoded builds an unsigned TX, using hard coded values.
-func buildUnsignedHardCoded() ([]byte, error) {
+// The recipient is decoded for the network of params.
+func buildUnsignedHardCoded(params *chaincfg.Params) ([]byte, error) {
 	tx := wire.MsgTx{}
 	tx.Version = 0x02
 
@@ -49,7 +49,7 @@ func buildUnsignedHardCoded() ([]byte, error) {
 
 	recipient := "18chgevayKE8fQDDVsopokEnVSugjFRJGL"
 
-	destinationAddress, err := btcutil.DecodeAddress(recipient, &chaincfg.MainNetParams)
+	destinationAddress, err := GetAddressFromString(recipient, params)
 	if err != nil {
 		return nil, err
 	}
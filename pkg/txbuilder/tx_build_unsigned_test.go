@@ -3,10 +3,12 @@ package txbuilder
 import (
 	"encoding/hex"
 	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
 )
 
 func TestBuildUnsignedHardCoded(t *testing.T) {
-	b, err := buildUnsignedHardCoded()
+	b, err := buildUnsignedHardCoded(&chaincfg.MainNetParams)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1,30 +1,51 @@
 package txbuilder
 
 import (
+	"github.com/tokenized/smart-contract/pkg/txscript"
 	"github.com/tokenized/smart-contract/pkg/wire"
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcutil"
 )
 
-const (
-	satsPerByte = 1.25
-)
-
 type TxBuilder struct {
-	PrivateKey *btcec.PrivateKey
+	Signer       Signer
+	FeePolicy    FeePolicy
+	CoinSelector CoinSelector
+}
+
+// NewTxBuilder returns a TxBuilder that signs with the key, and chooses
+// inputs with the DefaultCoinSelector. Set CoinSelector to use another
+// strategy.
+func NewTxBuilder(privateKey *btcec.PrivateKey, feePolicy FeePolicy) TxBuilder {
+	return NewTxBuilderWithSigner(NewKeySigner(privateKey), feePolicy)
 }
 
-func NewTxBuilder(privateKey *btcec.PrivateKey) TxBuilder {
+// NewTxBuilderWithSigner returns a TxBuilder that signs with the Signer,
+// so the key does not need to be held by the caller.
+func NewTxBuilderWithSigner(signer Signer, feePolicy FeePolicy) TxBuilder {
 	return TxBuilder{
-		PrivateKey: privateKey,
+		Signer:       signer,
+		FeePolicy:    feePolicy,
+		CoinSelector: DefaultCoinSelector(),
 	}
 }
 
+// Build returns a signed tx paying to the outs, with the OP_RETURN payload
+// last, and a BuildReport of how it was paid for.
+//
+// The fee is set by the FeePolicy of the TxBuilder, unless feePolicy is
+// not nil.
 func (s TxBuilder) Build(utxos UTXOs,
 	outs []PayAddress,
 	changeAddress btcutil.Address,
-	opReturnPayload []byte) (*wire.MsgTx, error) {
+	opReturnPayload []byte,
+	feePolicy *FeePolicy) (*wire.MsgTx, *BuildReport, error) {
+
+	policy := s.FeePolicy
+	if feePolicy != nil {
+		policy = *feePolicy
+	}
 
 	// gather the spendable output details
 	spendableTxOuts := make([]*TxOutput, len(utxos), len(utxos))
@@ -52,19 +73,18 @@ func (s TxBuilder) Build(utxos UTXOs,
 		out := TxOutput{
 			Address: o.Address,
 			Value:   o.Value,
-			Type:    OutputTypeP2PK,
+			Type:    AddressOutputType(o.Address),
 		}
 
 		outputs = append(outputs, out)
 	}
 
 	// get the actual payload from the OP_RETURN
-	startIndex := 3
-	if opReturnPayload[1] < 0x4c {
-		startIndex = 2
+	data, err := txscript.NullDataPayload(opReturnPayload)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	data := opReturnPayload[startIndex:]
 	opReturn := TxOutput{
 		Type: OutputTypeReturn,
 		Data: data,
@@ -74,10 +94,10 @@ func (s TxBuilder) Build(utxos UTXOs,
 	//
 	// The OP_RETURN will be added at the end of all outputs, including any
 	// change that will be calculated.
-	tx, err := build(spendableTxOuts, outputs, s.PrivateKey, changeAddress, opReturn)
+	tx, err := build(spendableTxOuts, outputs, s.Signer, changeAddress, opReturn, policy, s.CoinSelector)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return tx.MsgTx, nil
+	return tx.MsgTx, tx.Report, nil
 }
@@ -0,0 +1,122 @@
+package txbuilder
+
+import (
+	"container/list"
+	"context"
+	"sync"
+
+	"github.com/tokenized/smart-contract/pkg/wire"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// DefaultTxCacheSize is the number of txs held by a TxCache if no size is
+// given.
+const DefaultTxCacheSize = 10000
+
+// TxCache holds recently seen txs, so the outputs they create can be found
+// without asking the network for them again.
+//
+// TxCache implements NetInterface, and only calls the Network for txs it
+// does not hold. When full, the least recently used tx is dropped.
+type TxCache struct {
+	Network NetInterface
+	size    int
+	mu      *sync.Mutex
+	order   *list.List
+	entries map[chainhash.Hash]*list.Element
+}
+
+// NewTxCache returns a TxCache holding up to size txs, that fetches
+// missing txs from the network.
+func NewTxCache(network NetInterface, size int) *TxCache {
+	if size <= 0 {
+		size = DefaultTxCacheSize
+	}
+
+	return &TxCache{
+		Network: network,
+		size:    size,
+		mu:      &sync.Mutex{},
+		order:   list.New(),
+		entries: map[chainhash.Hash]*list.Element{},
+	}
+}
+
+// Add puts a tx in the cache.
+func (c *TxCache) Add(tx *wire.MsgTx) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.add(tx.TxHash(), tx)
+}
+
+// Get returns the tx with the hash, if it is in the cache.
+func (c *TxCache) Get(hash chainhash.Hash) (*wire.MsgTx, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[hash]
+	if !ok {
+		return nil, false
+	}
+
+	c.order.MoveToFront(e)
+
+	return e.Value.(*txCacheEntry).tx, true
+}
+
+// Len returns the number of txs in the cache.
+func (c *TxCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+// GetTX returns the tx with the hash from the cache, or from the network
+// if it is not held. A tx fetched from the network is added to the cache.
+func (c *TxCache) GetTX(ctx context.Context,
+	hash *chainhash.Hash) (*wire.MsgTx, error) {
+
+	if tx, ok := c.Get(*hash); ok {
+		return tx, nil
+	}
+
+	tx, err := c.Network.GetTX(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.add(*hash, tx)
+
+	return tx, nil
+}
+
+// add puts a tx at the front of the cache, dropping the oldest tx if the
+// cache is full. The caller must hold the lock.
+func (c *TxCache) add(hash chainhash.Hash, tx *wire.MsgTx) {
+	if e, ok := c.entries[hash]; ok {
+		c.order.MoveToFront(e)
+		return
+	}
+
+	c.entries[hash] = c.order.PushFront(&txCacheEntry{
+		hash: hash,
+		tx:   tx,
+	})
+
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*txCacheEntry).hash)
+	}
+}
+
+type txCacheEntry struct {
+	hash chainhash.Hash
+	tx   *wire.MsgTx
+}
@@ -0,0 +1,124 @@
+package txbuilder
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/tokenized/smart-contract/pkg/wire"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// testNetwork is a NetInterface that counts the txs fetched from it.
+type testNetwork struct {
+	txs   map[chainhash.Hash]*wire.MsgTx
+	calls int
+}
+
+func newTestNetwork(txs ...*wire.MsgTx) *testNetwork {
+	n := &testNetwork{
+		txs: map[chainhash.Hash]*wire.MsgTx{},
+	}
+
+	for _, tx := range txs {
+		n.txs[tx.TxHash()] = tx
+	}
+
+	return n
+}
+
+func (n *testNetwork) GetTX(ctx context.Context,
+	hash *chainhash.Hash) (*wire.MsgTx, error) {
+
+	n.calls++
+
+	tx, ok := n.txs[*hash]
+	if !ok {
+		return nil, errors.New("tx not found")
+	}
+
+	return tx, nil
+}
+
+func newTestCacheTx(lockTime uint32) *wire.MsgTx {
+	tx := wire.NewMsgTx(2)
+	tx.AddTxOut(wire.NewTxOut(1000, []byte{0x51}))
+	tx.LockTime = lockTime
+
+	return tx
+}
+
+func TestTxCache_GetTX(t *testing.T) {
+	ctx := context.Background()
+
+	a := newTestCacheTx(1)
+	b := newTestCacheTx(2)
+	c := newTestCacheTx(3)
+
+	network := newTestNetwork(a, b, c)
+	cache := NewTxCache(network, 2)
+
+	for i := 0; i < 3; i++ {
+		hash := a.TxHash()
+		if _, err := cache.GetTX(ctx, &hash); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if network.calls != 1 {
+		t.Fatalf("got %d calls to the network, want 1", network.calls)
+	}
+
+	// a tx seen on the network is never fetched
+	cache.Add(b)
+
+	hash := b.TxHash()
+	if _, err := cache.GetTX(ctx, &hash); err != nil {
+		t.Fatal(err)
+	}
+
+	if network.calls != 1 {
+		t.Fatalf("got %d calls to the network, want 1", network.calls)
+	}
+
+	missing := chainhash.Hash{1}
+	if _, err := cache.GetTX(ctx, &missing); err == nil {
+		t.Fatal("found missing tx")
+	}
+
+	if cache.Len() != 2 {
+		t.Fatalf("got %d txs in cache, want 2", cache.Len())
+	}
+}
+
+func TestTxCache_evict(t *testing.T) {
+	a := newTestCacheTx(1)
+	b := newTestCacheTx(2)
+	c := newTestCacheTx(3)
+
+	cache := NewTxCache(newTestNetwork(), 2)
+	cache.Add(a)
+	cache.Add(b)
+
+	// using a makes b the least recently used
+	if _, ok := cache.Get(a.TxHash()); !ok {
+		t.Fatal("a not in cache")
+	}
+
+	cache.Add(c)
+
+	if cache.Len() != 2 {
+		t.Fatalf("got %d txs in cache, want 2", cache.Len())
+	}
+
+	if _, ok := cache.Get(b.TxHash()); ok {
+		t.Error("b was not evicted")
+	}
+
+	for _, tx := range []*wire.MsgTx{a, c} {
+		if _, ok := cache.Get(tx.TxHash()); !ok {
+			t.Errorf("%s was evicted", tx.TxHash())
+		}
+	}
+}
@@ -16,6 +16,9 @@ type TxOutput struct {
 	Value           uint64
 	TransactionHash []byte
 	Data            []byte
+
+	// RedeemScript is the script hashed by a P2SH output.
+	RedeemScript []byte
 }
 
 func getHashString(txHash []byte, index uint32) string {
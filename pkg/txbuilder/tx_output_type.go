@@ -1,15 +1,23 @@
 package txbuilder
 
+import (
+	"github.com/btcsuite/btcutil"
+)
+
 type TxOutputType uint
 
 const (
-	OutputTypeP2PK    TxOutputType = iota
+	OutputTypeP2PK TxOutputType = iota
 	OutputTypeReturn
+	OutputTypeP2SH
+	OutputTypeMultiSig
 )
 
 const (
-	StringP2pk              = "p2pk"
-	StringReturn            = "return"
+	StringP2pk     = "p2pk"
+	StringReturn   = "return"
+	StringP2sh     = "p2sh"
+	StringMultiSig = "multisig"
 )
 
 func (s TxOutputType) String() string {
@@ -18,7 +26,20 @@ func (s TxOutputType) String() string {
 		return StringP2pk
 	case OutputTypeReturn:
 		return StringReturn
+	case OutputTypeP2SH:
+		return StringP2sh
+	case OutputTypeMultiSig:
+		return StringMultiSig
 	default:
 		return "unknown"
 	}
 }
+
+// AddressOutputType returns the type of output that pays to an address.
+func AddressOutputType(address btcutil.Address) TxOutputType {
+	if _, ok := address.(*btcutil.AddressScriptHash); ok {
+		return OutputTypeP2SH
+	}
+
+	return OutputTypeP2PK
+}
@@ -39,18 +39,26 @@ func NewUTXOFromTX(tx wire.MsgTx, n uint32) UTXO {
 	}
 }
 
-// PublicAddress returns the public address from a P2PKH script.
+// PublicAddress returns the address that can spend the UTXO.
 //
-// The script will look something like this
-//
-// OP_DUP OP_HASH160 <address hash> OP_EQUALVERIFY OP_CHECKSIG
+// P2PKH and P2SH scripts hold their address. A bare multisig script has no
+// address, so the P2SH address of the script is used, which stands for the
+// same set of keys.
 func (u UTXO) PublicAddress(params *chaincfg.Params) (btcutil.Address, error) {
-	if len(u.PkScript) != 25 &&
-		u.PkScript[0] != txscript.OP_DUP &&
-		u.PkScript[1] != txscript.OP_HASH160 {
+	switch txscript.GetScriptClass(u.PkScript) {
+	case txscript.PubKeyHashTy, txscript.ScriptHashTy:
+		_, addresses, _, err := txscript.ExtractPkScriptAddrs(u.PkScript, params)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(addresses) == 1 {
+			return addresses[0], nil
+		}
 
-		return nil, fmt.Errorf("Invalid pkScript %s : %v", u.Hash, u.PkScript)
+	case txscript.MultiSigTy:
+		return btcutil.NewAddressScriptHash(u.PkScript, params)
 	}
 
-	return btcutil.NewAddressPubKeyHash(u.PkScript[3:23], params)
+	return nil, fmt.Errorf("Invalid pkScript %s : %v", u.Hash, u.PkScript)
 }
@@ -1,9 +1,12 @@
 package txbuilder
 
 import (
+	"bytes"
+
 	"github.com/tokenized/smart-contract/pkg/wire"
 
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcutil"
 )
 
@@ -36,11 +39,11 @@ func (u UTXOs) Value() uint64 {
 }
 
 // Addresses returns all addresses from the UTXO's, in order.
-func (u UTXOs) Addresses() ([]btcutil.Address, error) {
+func (u UTXOs) Addresses(params *chaincfg.Params) ([]btcutil.Address, error) {
 	addresses := []btcutil.Address{}
 
 	for _, utxo := range u {
-		a, err := utxo.PublicAddress(&chaincfg.MainNetParams)
+		a, err := utxo.PublicAddress(params)
 		if err != nil {
 			return nil, err
 		}
@@ -52,8 +55,8 @@ func (u UTXOs) Addresses() ([]btcutil.Address, error) {
 }
 
 // UniqueAddresses returns the unique addresses from the UTXO's, in order.
-func (u UTXOs) UniqueAddresses() ([]btcutil.Address, error) {
-	addresses, err := u.Addresses()
+func (u UTXOs) UniqueAddresses(params *chaincfg.Params) ([]btcutil.Address, error) {
+	addresses, err := u.Addresses(params)
 	if err != nil {
 		return nil, err
 	}
@@ -63,18 +66,23 @@ func (u UTXOs) UniqueAddresses() ([]btcutil.Address, error) {
 }
 
 // ForAddress returns UTXOs that match the given Address.
+//
+// UTXOs are matched on the locking script of the Address, so the network
+// of the Address does not matter. A P2SH Address also matches bare multisig
+// UTXOs with the same script.
 func (u UTXOs) ForAddress(address btcutil.Address) (UTXOs, error) {
 	filtered := UTXOs{}
 
-	s := address.String()
+	pkScript, err := txscript.PayToAddrScript(address)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, utxo := range u {
-		publicAddress, err := utxo.PublicAddress(&chaincfg.MainNetParams)
-		if err != nil {
-			return nil, err
-		}
+	_, isScriptHash := address.(*btcutil.AddressScriptHash)
 
-		if publicAddress.String() != s {
+	for _, utxo := range u {
+		if !bytes.Equal(utxo.PkScript, pkScript) &&
+			!(isScriptHash && isMultiSigFor(utxo.PkScript, address)) {
 			continue
 		}
 
@@ -83,3 +91,13 @@ func (u UTXOs) ForAddress(address btcutil.Address) (UTXOs, error) {
 
 	return filtered, nil
 }
+
+// isMultiSigFor returns true if the pkScript is a bare multisig script with
+// the same hash as the P2SH address.
+func isMultiSigFor(pkScript []byte, address btcutil.Address) bool {
+	if txscript.GetScriptClass(pkScript) != txscript.MultiSigTy {
+		return false
+	}
+
+	return bytes.Equal(btcutil.Hash160(pkScript), address.ScriptAddress())
+}
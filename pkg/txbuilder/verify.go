@@ -0,0 +1,59 @@
+package txbuilder
+
+import (
+	"fmt"
+
+	"github.com/tokenized/smart-contract/pkg/txscript"
+	"github.com/tokenized/smart-contract/pkg/wire"
+)
+
+// VerifyFlags are the script flags a tx is verified with. Signatures must
+// use SIGHASH_FORKID.
+const VerifyFlags = txscript.StandardVerifyFlags
+
+// DefaultSigCacheSize is the number of signatures held by the SigCache
+// used for verification if no size is given.
+const DefaultSigCacheSize = 50000
+
+// Verify executes the script of every input of a tx against the output it
+// spends, and returns a VerifyError for the first input that fails.
+//
+// The spent outputs must be in the same order as the inputs, as returned
+// by UTXOSetBuilder.Build.
+func Verify(tx *wire.MsgTx, spent UTXOs) error {
+	return VerifyWithCache(tx, spent, nil)
+}
+
+// VerifyWithCache is Verify, skipping the check of any signature already
+// held by the SigCache. Signatures that pass are added to the SigCache, so
+// a tx that is seen again only pays for the checks once.
+func VerifyWithCache(tx *wire.MsgTx, spent UTXOs, sigCache *txscript.SigCache) error {
+	if len(spent) != len(tx.TxIn) {
+		return fmt.Errorf("got %d spent outputs for %d inputs",
+			len(spent), len(tx.TxIn))
+	}
+
+	sigHashes := txscript.NewTxSigHashes(tx)
+
+	for i, utxo := range spent {
+		pop := tx.TxIn[i].PreviousOutPoint
+		if pop.Hash != utxo.Hash || pop.Index != utxo.Index {
+			return &VerifyError{
+				Index: i,
+				Err:   fmt.Errorf("spent output %s:%d does not match %v", utxo.Hash, utxo.Index, pop),
+			}
+		}
+
+		vm, err := txscript.NewEngine(utxo.PkScript, tx, i, VerifyFlags, sigCache,
+			sigHashes, int64(utxo.Value))
+		if err != nil {
+			return &VerifyError{Index: i, Err: err}
+		}
+
+		if err := vm.Execute(); err != nil {
+			return &VerifyError{Index: i, Err: err}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package txbuilder
+
+import (
+	"testing"
+
+	"github.com/tokenized/smart-contract/pkg/wire"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
+)
+
+func TestVerify(t *testing.T) {
+	key := newTestKey(t, 1)
+	pkScript := newTestP2PKHScript(t, key)
+
+	recipient, err := btcutil.NewAddressPubKeyHash(
+		btcutil.Hash160(newTestKey(t, 2).PubKey().SerializeCompressed()),
+		&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	utxos := UTXOs{
+		NewUTXO(chainhash.Hash{1}, 0, pkScript, 10000),
+		NewUTXO(chainhash.Hash{2}, 1, pkScript, 2000),
+	}
+
+	outs := []PayAddress{
+		NewPayAddress(recipient, 11000),
+	}
+
+	// OP_RETURN with a 20 byte push
+	payload := append([]byte{0x6a, 0x14}, make([]byte, 20)...)
+
+	builder := NewTxBuilder(key, DefaultFeePolicy())
+
+	tx, _, err := builder.Build(utxos, outs, recipient, payload, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the builder may spend the inputs in any order
+	spent := UTXOs{}
+	for _, in := range tx.TxIn {
+		for _, u := range utxos {
+			if u.Hash == in.PreviousOutPoint.Hash {
+				spent = append(spent, u)
+			}
+		}
+	}
+
+	if err := Verify(tx, spent); err != nil {
+		t.Fatal(err)
+	}
+
+	// the signatures commit to the value of the outputs spent
+	wrongValue := append(UTXOs{}, spent...)
+	wrongValue[1].Value++
+
+	if err, ok := Verify(tx, wrongValue).(*VerifyError); !ok || err.Index != 1 {
+		t.Errorf("got err %v, want failure of input 1", err)
+	}
+
+	// and to the outputs of the tx
+	changed := tx.Copy()
+	changed.TxOut[0].Value--
+
+	if _, ok := Verify(changed, spent).(*VerifyError); !ok {
+		t.Errorf("changed output passed verification")
+	}
+
+	unsigned := tx.Copy()
+	for _, in := range unsigned.TxIn {
+		in.SignatureScript = nil
+	}
+
+	if _, ok := Verify(unsigned, spent).(*VerifyError); !ok {
+		t.Errorf("unsigned tx passed verification")
+	}
+}
+
+func TestVerify_p2sh(t *testing.T) {
+	params := &chaincfg.MainNetParams
+
+	script, keys := newTestMultiSigScript(t)
+
+	p2sh, err := NewP2SHOutput(script, 5000, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	txOut, err := buildTxOut(p2sh)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spent := []*TxOutput{
+		&TxOutput{
+			PkScript:     txOut.PkScript,
+			Value:        p2sh.Value,
+			RedeemScript: script,
+		},
+	}
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{1}}, nil))
+	tx.AddTxOut(wire.NewTxOut(4000, txOut.PkScript))
+
+	p, err := NewPartialTx(tx, spent)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := p.Sign(params, keys[0], keys[2]); err != nil {
+		t.Fatal(err)
+	}
+
+	signed, err := p.Finalize(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	utxos := UTXOs{
+		NewUTXO(chainhash.Hash{1}, 0, txOut.PkScript, p2sh.Value),
+	}
+
+	if err := Verify(signed, utxos); err != nil {
+		t.Fatal(err)
+	}
+}
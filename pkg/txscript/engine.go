@@ -74,6 +74,11 @@ const (
 	// ScriptVerifyStrictEncoding defines that signature scripts and
 	// public keys must follow the strict encoding requirements.
 	ScriptVerifyStrictEncoding
+
+	// ScriptEnableSighashForkID defines that signatures must use
+	// SIGHASH_FORKID, and are checked against the BIP0143 signature hash,
+	// which commits to the value of the output being spent.
+	ScriptEnableSighashForkID
 )
 
 const (
@@ -104,6 +109,8 @@ type Engine struct {
 	sigCache        *SigCache
 	bip16           bool     // treat execution as pay-to-script-hash
 	savedFirstStack [][]byte // stack from first script for bip16 scripts
+	sigHashes       *TxSigHashes
+	inputAmount     int64
 }
 
 // hasFlag returns whether the script engine instance has the passed flag set.
@@ -399,6 +406,25 @@ func (vm *Engine) subScript() []parsedOpcode {
 	return vm.scripts[vm.scriptIdx][vm.lastCodeSep:]
 }
 
+// calcSignatureHash returns the hash signed by a signature with the given
+// hash type, for the script being executed.
+func (vm *Engine) calcSignatureHash(script []parsedOpcode,
+	hashType SigHashType) ([]byte, error) {
+
+	if !vm.hasFlag(ScriptEnableSighashForkID) {
+		return calcSignatureHash(script, hashType, &vm.tx, vm.txIdx), nil
+	}
+
+	if hashType&SigHashForkID == 0 {
+		str := fmt.Sprintf("hash type 0x%x does not use SIGHASH_FORKID",
+			hashType)
+		return nil, scriptError(ErrInvalidSigHashType, str)
+	}
+
+	return calcBip143SignatureHash(script, vm.sigHashes, hashType, &vm.tx,
+		vm.txIdx, vm.inputAmount), nil
+}
+
 // checkHashTypeEncoding returns whether or not the passed hashtype adheres to
 // the strict encoding requirements if enabled.
 func (vm *Engine) checkHashTypeEncoding(hashType SigHashType) error {
@@ -632,7 +658,11 @@ func (vm *Engine) SetAltStack(data [][]byte) {
 // NewEngine returns a new script engine for the provided public key script,
 // transaction, and input index.  The flags modify the behavior of the script
 // engine according to the description provided by each flag.
-func NewEngine(scriptPubKey []byte, tx *wire.MsgTx, txIdx int, flags ScriptFlags, sigCache *SigCache) (*Engine, error) {
+//
+// The sigHashes and inputAmount are used for signatures using
+// SIGHASH_FORKID. If sigHashes is nil they are calculated from the tx.
+func NewEngine(scriptPubKey []byte, tx *wire.MsgTx, txIdx int, flags ScriptFlags,
+	sigCache *SigCache, sigHashes *TxSigHashes, inputAmount int64) (*Engine, error) {
 	// The provided transaction input index must refer to a valid input.
 	if txIdx < 0 || txIdx >= len(tx.TxIn) {
 		str := fmt.Sprintf("transaction input index %d is negative or "+
@@ -713,5 +743,14 @@ func NewEngine(scriptPubKey []byte, tx *wire.MsgTx, txIdx int, flags ScriptFlags
 	vm.tx = *tx
 	vm.txIdx = txIdx
 
+	if vm.hasFlag(ScriptEnableSighashForkID) {
+		if sigHashes == nil {
+			sigHashes = NewTxSigHashes(tx)
+		}
+
+		vm.sigHashes = sigHashes
+		vm.inputAmount = inputAmount
+	}
+
 	return &vm, nil
 }
@@ -227,6 +227,11 @@ const (
 	// reached.
 	ErrUnsatisfiedLockTime
 
+	// ErrNotNullData is returned when a script was expected to be a null
+	// data script, starting with OP_RETURN or OP_FALSE OP_RETURN and
+	// followed only by data pushes, but is not.
+	ErrNotNullData
+
 	// numErrorCodes is the maximum error code number used in tests.  This
 	// entry MUST be the last entry in the enum.
 	numErrorCodes
@@ -275,6 +280,7 @@ var errorCodeStrings = map[ErrorCode]string{
 	ErrDiscourageUpgradableNOPs: "ErrDiscourageUpgradableNOPs",
 	ErrNegativeLockTime:         "ErrNegativeLockTime",
 	ErrUnsatisfiedLockTime:      "ErrUnsatisfiedLockTime",
+	ErrNotNullData:              "ErrNotNullData",
 }
 
 // String returns the ErrorCode as a human-readable name.
@@ -2046,11 +2046,16 @@ func opcodeCheckSig(op *parsedOpcode, vm *Engine) error {
 	subScript := vm.subScript()
 
 	// Remove the signature since there is no way for a signature to sign
-	// itself.
-	subScript = removeOpcodeByData(subScript, fullSigBytes)
+	// itself. The BIP0143 signature hash does not need this.
+	if !vm.hasFlag(ScriptEnableSighashForkID) {
+		subScript = removeOpcodeByData(subScript, fullSigBytes)
+	}
 
 	// Generate the signature hash based on the signature hash type.
-	hash := calcSignatureHash(subScript, hashType, &vm.tx, vm.txIdx)
+	hash, err := vm.calcSignatureHash(subScript, hashType)
+	if err != nil {
+		return err
+	}
 
 	pubKey, err := btcec.ParsePubKey(pkBytes, btcec.S256())
 	if err != nil {
@@ -2217,9 +2222,11 @@ func opcodeCheckMultiSig(op *parsedOpcode, vm *Engine) error {
 	script := vm.subScript()
 
 	// Remove any of the signatures since there is no way for a signature to
-	// sign itself.
-	for _, sigInfo := range signatures {
-		script = removeOpcodeByData(script, sigInfo.signature)
+	// sign itself. The BIP0143 signature hash does not need this.
+	if !vm.hasFlag(ScriptEnableSighashForkID) {
+		for _, sigInfo := range signatures {
+			script = removeOpcodeByData(script, sigInfo.signature)
+		}
 	}
 
 	success := true
@@ -2301,7 +2308,10 @@ func opcodeCheckMultiSig(op *parsedOpcode, vm *Engine) error {
 		}
 
 		// Generate the signature hash based on the signature hash type.
-		hash := calcSignatureHash(script, hashType, &vm.tx, vm.txIdx)
+		hash, err := vm.calcSignatureHash(script, hashType)
+		if err != nil {
+			return err
+		}
 
 		var valid bool
 		if vm.sigCache != nil {
@@ -168,7 +168,7 @@ func sign(chainParams *chaincfg.Params, tx *wire.MsgTx, idx int,
 // an error and results in undefined behaviour.
 func mergeScripts(chainParams *chaincfg.Params, tx *wire.MsgTx, idx int,
 	pkScript []byte, class ScriptClass, addresses []btcutil.Address,
-	nRequired int, sigScript, prevScript []byte) []byte {
+	nRequired int, sigScript, prevScript []byte, amt int64) []byte {
 
 	// TODO: the scripthash and multisig paths here are overly
 	// inefficient in that they will recompute already known data.
@@ -201,7 +201,7 @@ func mergeScripts(chainParams *chaincfg.Params, tx *wire.MsgTx, idx int,
 
 		// Merge
 		mergedScript := mergeScripts(chainParams, tx, idx, script,
-			class, addresses, nrequired, sigScript, prevScript)
+			class, addresses, nrequired, sigScript, prevScript, amt)
 
 		// Reappend the script and return the result.
 		builder := NewScriptBuilder()
@@ -211,7 +211,7 @@ func mergeScripts(chainParams *chaincfg.Params, tx *wire.MsgTx, idx int,
 		return finalScript
 	case MultiSigTy:
 		return mergeMultiSig(tx, idx, addresses, nRequired, pkScript,
-			sigScript, prevScript)
+			sigScript, prevScript, amt)
 
 	// It doesn't actually make sense to merge anything other than multiig
 	// and scripthash (because it could contain multisig). Everything else
@@ -234,7 +234,7 @@ func mergeScripts(chainParams *chaincfg.Params, tx *wire.MsgTx, idx int,
 // have come from other functions internally and thus are all consistent with
 // each other, behaviour is undefined if this contract is broken.
 func mergeMultiSig(tx *wire.MsgTx, idx int, addresses []btcutil.Address,
-	nRequired int, pkScript, sigScript, prevScript []byte) []byte {
+	nRequired int, pkScript, sigScript, prevScript []byte, amt int64) []byte {
 
 	// This is an internal only function and we already parsed this script
 	// as ok for multisig (this is how we got here), so if this fails then
@@ -293,7 +293,8 @@ sigLoop:
 		// however, assume no sigs etc are in the script since that
 		// would make the transaction nonstandard and thus not
 		// MultiSigTy, so we just need to hash the full thing.
-		hash := calcSignatureHash(pkPops, hashType, tx, idx)
+		hash := calcBip143SignatureHash(pkPops, NewTxSigHashes(tx), hashType,
+			tx, idx, amt)
 
 		for _, addr := range addresses {
 			// All multisig addresses should be pubkey addresses
@@ -407,6 +408,23 @@ func SignTxOutput(chainParams *chaincfg.Params, tx *wire.MsgTx, idx int,
 
 	// Merge scripts. with any previous data, if any.
 	mergedScript := mergeScripts(chainParams, tx, idx, pkScript, class,
-		addresses, nrequired, sigScript, previousScript)
+		addresses, nrequired, sigScript, previousScript, amt)
 	return mergedScript, nil
 }
+
+// MergeScripts merges two signature scripts that are both partial solutions
+// for pkScript, spending output idx of tx with a value of amt. For
+// pay-to-script-hash, the redeem script must be the last push of both
+// scripts.
+func MergeScripts(chainParams *chaincfg.Params, tx *wire.MsgTx, idx int,
+	pkScript, sigScript, prevScript []byte, amt int64) ([]byte, error) {
+
+	class, addresses, nrequired, err := ExtractPkScriptAddrs(pkScript,
+		chainParams)
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeScripts(chainParams, tx, idx, pkScript, class, addresses,
+		nrequired, sigScript, prevScript, amt), nil
+}
@@ -36,7 +36,8 @@ const (
 		ScriptVerifyNullFail |
 		ScriptVerifyCheckLockTimeVerify |
 		ScriptVerifyCheckSequenceVerify |
-		ScriptVerifyLowS
+		ScriptVerifyLowS |
+		ScriptEnableSighashForkID
 )
 
 // ScriptClass is an enumeration for the list of standard types of script.
@@ -376,6 +377,43 @@ func NullDataScript(data []byte) ([]byte, error) {
 	return NewScriptBuilder().AddOp(OP_RETURN).AddData(data).Script()
 }
 
+// NullDataPayload returns the data pushed by a null data script. The script
+// must start with OP_RETURN, or OP_FALSE OP_RETURN, and be followed only by
+// data pushes of any size. Data split across several pushes is joined in
+// order.
+//
+// An Error with the error code ErrNotNullData is returned if the script is
+// not a null data script, and ErrMalformedPush if a push runs past the end
+// of the script.
+func NullDataPayload(script []byte) ([]byte, error) {
+	pops, err := parseScript(script)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pops) > 0 && pops[0].opcode.value == OP_FALSE {
+		pops = pops[1:]
+	}
+
+	if len(pops) == 0 || pops[0].opcode.value != OP_RETURN {
+		return nil, scriptError(ErrNotNullData,
+			"script does not start with OP_RETURN")
+	}
+
+	var payload []byte
+	for _, pop := range pops[1:] {
+		if pop.opcode.value > OP_PUSHDATA4 {
+			str := fmt.Sprintf("null data script contains %s",
+				pop.opcode.name)
+			return nil, scriptError(ErrNotNullData, str)
+		}
+
+		payload = append(payload, pop.data...)
+	}
+
+	return payload, nil
+}
+
 // MultiSigScript returns a valid script for a multisignature redemption where
 // nrequired of the keys in pubkeys are required to have signed the transaction
 // for success.  An Error with the error code ErrTooManyRequiredSigs will be